@@ -0,0 +1,71 @@
+package fyne
+
+// AccessibilityRole describes the semantic role a canvas object exposes to
+// assistive technology such as screen readers.
+//
+// Since: 2.6
+type AccessibilityRole string
+
+// The accessibility roles currently described by toolkit widgets.
+//
+// Since: 2.6
+const (
+	AccessibilityRoleButton   AccessibilityRole = "button"
+	AccessibilityRoleCheckBox AccessibilityRole = "checkbox"
+	AccessibilityRoleList     AccessibilityRole = "list"
+	AccessibilityRoleListItem AccessibilityRole = "listitem"
+	AccessibilityRoleText     AccessibilityRole = "text"
+)
+
+// Accessibility carries the semantics of a canvas object for assistive technology.
+// Only the fields that apply to the object's role are set.
+//
+// Since: 2.6
+type Accessibility struct {
+	// Role is the semantic role of the object.
+	Role AccessibilityRole
+	// Label is a short human readable description of the object.
+	Label string
+	// Index is the 1 based position of an item within its parent collection,
+	// or zero when the object is not part of a collection.
+	Index int
+	// Count is the number of items in the collection this object belongs to or
+	// represents, or zero when not applicable.
+	Count int
+	// Selected reports whether a selectable item is currently selected.
+	Selected bool
+}
+
+// Accessible is implemented by canvas objects that describe themselves to
+// assistive technology. Drivers forward this information to the platform
+// accessibility services where they are supported.
+//
+// Since: 2.6
+type Accessible interface {
+	// AccessibilityInfo returns the current semantics of this object.
+	AccessibilityInfo() Accessibility
+}
+
+// AccessibilityAnnouncer is implemented by drivers that can pass a spoken
+// announcement to the platform screen reader.
+//
+// Since: 2.6
+type AccessibilityAnnouncer interface {
+	// Announce asks the screen reader to speak the given message.
+	Announce(message string)
+}
+
+// Announce passes the given message to the platform screen reader, if the
+// current driver supports announcements. On other platforms it does nothing.
+//
+// Since: 2.6
+func Announce(message string) {
+	app := CurrentApp()
+	if app == nil {
+		return
+	}
+
+	if a, ok := app.Driver().(AccessibilityAnnouncer); ok {
+		a.Announce(message)
+	}
+}
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/painter"
+)
+
+// ColorBlindness identifies a color vision deficiency that rendering can
+// simulate with SimulateColorBlindness.
+//
+// Since: 2.6
+type ColorBlindness int
+
+const (
+	// ColorBlindnessNone renders colors unchanged.
+	//
+	// Since: 2.6
+	ColorBlindnessNone ColorBlindness = iota
+
+	// ColorBlindnessProtanopia simulates missing red cones.
+	//
+	// Since: 2.6
+	ColorBlindnessProtanopia
+
+	// ColorBlindnessDeuteranopia simulates missing green cones.
+	//
+	// Since: 2.6
+	ColorBlindnessDeuteranopia
+
+	// ColorBlindnessTritanopia simulates missing blue cones.
+	//
+	// Since: 2.6
+	ColorBlindnessTritanopia
+)
+
+// SimulateColorBlindness transforms all rendering of the current application
+// so designers can verify their interface as viewers with the given color
+// vision deficiency would perceive it. Pass ColorBlindnessNone to render
+// normally again. This is a development aid and should not be enabled in
+// released applications.
+//
+// Since: 2.6
+func SimulateColorBlindness(c ColorBlindness) {
+	painter.SetColorBlindness(painter.ColorBlindness(c))
+
+	app := fyne.CurrentApp()
+	if app == nil || app.Driver() == nil {
+		return
+	}
+	for _, w := range app.Driver().AllWindows() {
+		if content := w.Canvas().Content(); content != nil {
+			content.Refresh()
+		}
+	}
+}
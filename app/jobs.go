@@ -0,0 +1,145 @@
+package app
+
+import (
+	"sync"
+)
+
+// Job is a handle to one long-running background operation registered with
+// the Jobs queue. Its methods are safe to call from any goroutine.
+//
+// Since: 2.6
+type Job struct {
+	name   string
+	cancel func()
+	queue  *JobQueue
+
+	mu       sync.Mutex
+	progress float64
+	done     bool
+}
+
+// Name returns the user visible name the job was registered with.
+func (j *Job) Name() string {
+	return j.name
+}
+
+// Progress returns the fractional completion of the job, in the range 0.0 to 1.0.
+func (j *Job) Progress() float64 {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.progress
+}
+
+// SetProgress reports fractional completion of the job, in the range 0.0 to
+// 1.0, notifying any panels displaying the queue.
+func (j *Job) SetProgress(p float64) {
+	if p < 0 {
+		p = 0
+	} else if p > 1 {
+		p = 1
+	}
+
+	j.mu.Lock()
+	j.progress = p
+	j.mu.Unlock()
+
+	j.queue.notify()
+}
+
+// Done marks the job as finished and removes it from the queue.
+// Calling it again has no effect.
+func (j *Job) Done() {
+	j.mu.Lock()
+	if j.done {
+		j.mu.Unlock()
+		return
+	}
+	j.done = true
+	j.mu.Unlock()
+
+	j.queue.remove(j)
+}
+
+// Cancel invokes the cancel function the job was registered with, if any,
+// and removes the job from the queue.
+func (j *Job) Cancel() {
+	if f := j.cancel; f != nil {
+		f()
+	}
+	j.Done()
+}
+
+// JobQueue tracks the background jobs of the running app and notifies
+// listeners when they change, so panels can display them.
+// Jobs formalize the progress and cancellation patterns of helpers like
+// fyne.DoWithProgress into one place apps and toolkit UI can share.
+//
+// Since: 2.6
+type JobQueue struct {
+	mu        sync.Mutex
+	jobs      []*Job
+	listeners []func()
+}
+
+var jobQueue = &JobQueue{}
+
+// Jobs returns the queue of long-running operations registered by the app,
+// shared by all windows.
+//
+// Since: 2.6
+func Jobs() *JobQueue {
+	return jobQueue
+}
+
+// Add registers a background job with the given user visible name, returning
+// a handle used to report progress and completion. The cancel function is
+// invoked if the user cancels the job from a panel, pass nil if the job
+// cannot be cancelled.
+func (q *JobQueue) Add(name string, cancel func()) *Job {
+	j := &Job{name: name, cancel: cancel, queue: q}
+
+	q.mu.Lock()
+	q.jobs = append(q.jobs, j)
+	q.mu.Unlock()
+
+	q.notify()
+	return j
+}
+
+// All returns the currently running jobs in the order they were registered.
+func (q *JobQueue) All() []*Job {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return append([]*Job{}, q.jobs...)
+}
+
+// OnChanged registers a function called whenever jobs are added, removed or
+// report progress.
+func (q *JobQueue) OnChanged(f func()) {
+	q.mu.Lock()
+	q.listeners = append(q.listeners, f)
+	q.mu.Unlock()
+}
+
+func (q *JobQueue) notify() {
+	q.mu.Lock()
+	listeners := append([]func(){}, q.listeners...)
+	q.mu.Unlock()
+
+	for _, f := range listeners {
+		f()
+	}
+}
+
+func (q *JobQueue) remove(j *Job) {
+	q.mu.Lock()
+	for i, job := range q.jobs {
+		if job == j {
+			q.jobs = append(q.jobs[:i], q.jobs[i+1:]...)
+			break
+		}
+	}
+	q.mu.Unlock()
+
+	q.notify()
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/cache"
+	"fyne.io/fyne/v2/widget"
+)
+
+func TestJobQueue(t *testing.T) {
+	queue := &JobQueue{}
+	changes := 0
+	queue.OnChanged(func() {
+		changes++
+	})
+
+	cancelled := false
+	job := queue.Add("Syncing", func() {
+		cancelled = true
+	})
+	require.Len(t, queue.All(), 1)
+	assert.Equal(t, "Syncing", job.Name())
+	assert.Equal(t, 1, changes)
+
+	job.SetProgress(1.5)
+	assert.Equal(t, 1.0, job.Progress(), "progress clamps to the valid range")
+	assert.Equal(t, 2, changes)
+
+	other := queue.Add("Uploading", nil)
+	other.Done()
+	assert.Len(t, queue.All(), 1)
+	other.Done() // finishing twice has no effect
+	assert.Len(t, queue.All(), 1)
+
+	job.Cancel()
+	assert.True(t, cancelled)
+	assert.Empty(t, queue.All())
+}
+
+func TestNewJobsPanel(t *testing.T) {
+	a := NewWithID("io.fyne.test")
+
+	panel := NewJobsPanel().(*jobsPanel)
+	w := a.NewWindow("jobs")
+	defer w.Close()
+	w.SetContent(panel)
+
+	r := cache.Renderer(panel).(*jobsPanelRenderer)
+	require.Len(t, r.box.Objects, 1)
+	assert.Equal(t, r.empty, r.box.Objects[0])
+
+	job := Jobs().Add("Indexing", func() {})
+	defer job.Done()
+	require.Len(t, r.box.Objects, 1)
+	row := r.box.Objects[0].(*fyne.Container)
+	assert.Equal(t, "Indexing", row.Objects[0].(*widget.Label).Text)
+
+	job.SetProgress(0.5) // the rows are rebuilt on refresh, fetch the current one
+	row = r.box.Objects[0].(*fyne.Container)
+	bar := row.Objects[1].(*fyne.Container).Objects[1].(*widget.ProgressBar)
+	assert.Equal(t, 0.5, bar.Value)
+}
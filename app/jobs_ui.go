@@ -0,0 +1,92 @@
+package app
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// NewJobsPanel returns a widget listing the jobs registered with Jobs, with a
+// progress bar and cancel button for each, similar to a browser's download
+// panel. The panel refreshes itself as jobs are added, progress and complete,
+// it can be placed in a popup, a drop-down or docked in a window.
+//
+// Since: 2.6
+func NewJobsPanel() fyne.CanvasObject {
+	p := &jobsPanel{queue: Jobs()}
+	p.ExtendBaseWidget(p)
+	p.queue.OnChanged(p.Refresh)
+	return p
+}
+
+type jobsPanel struct {
+	widget.BaseWidget
+
+	queue *JobQueue
+}
+
+func (p *jobsPanel) CreateRenderer() fyne.WidgetRenderer {
+	r := &jobsPanelRenderer{panel: p,
+		box:   &fyne.Container{Layout: layout.NewVBoxLayout()},
+		empty: widget.NewLabel("No jobs running")}
+	r.Refresh()
+	return r
+}
+
+var _ fyne.WidgetRenderer = (*jobsPanelRenderer)(nil)
+
+type jobsPanelRenderer struct {
+	panel *jobsPanel
+	box   *fyne.Container
+	empty *widget.Label
+}
+
+func (r *jobsPanelRenderer) Destroy() {
+}
+
+func (r *jobsPanelRenderer) Layout(size fyne.Size) {
+	r.box.Resize(size)
+}
+
+func (r *jobsPanelRenderer) MinSize() fyne.Size {
+	return r.box.MinSize()
+}
+
+func (r *jobsPanelRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.box}
+}
+
+func (r *jobsPanelRenderer) Refresh() {
+	jobs := r.panel.queue.All()
+
+	rows := make([]fyne.CanvasObject, 0, len(jobs))
+	for _, job := range jobs {
+		rows = append(rows, r.jobRow(job))
+	}
+	if len(rows) == 0 {
+		rows = append(rows, r.empty)
+	}
+
+	r.box.Objects = rows
+	r.box.Refresh()
+}
+
+// jobRow builds the name, progress bar and cancel button of one queued job.
+func (r *jobsPanelRenderer) jobRow(job *Job) fyne.CanvasObject {
+	bar := widget.NewProgressBar()
+	bar.SetValue(job.Progress())
+
+	var trailing fyne.CanvasObject
+	progress := fyne.CanvasObject(bar)
+	if job.cancel != nil {
+		cancel := widget.NewButtonWithIcon("", theme.CancelIcon(), job.Cancel)
+		cancel.Importance = widget.LowImportance
+		trailing = cancel
+		progress = &fyne.Container{Layout: layout.NewBorderLayout(nil, nil, nil, trailing),
+			Objects: []fyne.CanvasObject{trailing, bar}}
+	}
+
+	return &fyne.Container{Layout: layout.NewVBoxLayout(),
+		Objects: []fyne.CanvasObject{widget.NewLabel(job.Name()), progress}}
+}
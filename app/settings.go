@@ -40,11 +40,22 @@ type settings struct {
 	variant        fyne.ThemeVariant
 
 	changeListeners sync.Map // map[chan fyne.Settings]bool
-	watcher         any      // normally *fsnotify.Watcher or nil - avoid import in this file
+	aspectListeners sync.Map // map[chan fyne.SettingsChange]bool
+	lastNotified    settingsSnapshot
+	watcher         any // normally *fsnotify.Watcher or nil - avoid import in this file
 
 	schema SettingsSchema
 }
 
+// settingsSnapshot captures the settings values used to work out which aspects changed.
+type settingsSnapshot struct {
+	theme        fyne.Theme
+	variant      fyne.ThemeVariant
+	scale        float32
+	primaryColor string
+	animations   bool
+}
+
 func (s *settings) BuildType() fyne.BuildType {
 	return build.Mode
 }
@@ -110,7 +121,13 @@ func (s *settings) AddChangeListener(listener chan fyne.Settings) {
 	s.changeListeners.Store(listener, true) // the boolean is just a dummy value here.
 }
 
+func (s *settings) AddChangeListenerWithAspects(listener chan fyne.SettingsChange) {
+	s.aspectListeners.Store(listener, true) // the boolean is just a dummy value here.
+}
+
 func (s *settings) apply() {
+	aspects := s.changedAspects()
+
 	s.changeListeners.Range(func(key, _ any) bool {
 		listener := key.(chan fyne.Settings)
 		select {
@@ -121,6 +138,50 @@ func (s *settings) apply() {
 		}
 		return true
 	})
+
+	change := fyne.SettingsChange{Settings: s, Aspects: aspects}
+	s.aspectListeners.Range(func(key, _ any) bool {
+		listener := key.(chan fyne.SettingsChange)
+		select {
+		case listener <- change:
+		default:
+			l := listener
+			go func() { l <- change }()
+		}
+		return true
+	})
+}
+
+// changedAspects works out which aspects of the settings differ from the last notification.
+// If no tracked value differs we report all aspects, guaranteeing delivery for changes
+// that cannot be identified, such as a theme reloaded in place.
+func (s *settings) changedAspects() fyne.SettingsAspect {
+	current := settingsSnapshot{theme: s.theme, variant: s.variant, scale: s.schema.Scale,
+		primaryColor: s.schema.PrimaryColor, animations: !s.schema.DisableAnimations}
+	previous := s.lastNotified
+	s.lastNotified = current
+
+	var aspects fyne.SettingsAspect
+	if previous.theme != current.theme {
+		aspects |= fyne.SettingsAspectTheme
+	}
+	if previous.variant != current.variant {
+		aspects |= fyne.SettingsAspectThemeVariant
+	}
+	if previous.scale != current.scale {
+		aspects |= fyne.SettingsAspectScale
+	}
+	if previous.primaryColor != current.primaryColor {
+		aspects |= fyne.SettingsAspectPrimaryColor
+	}
+	if previous.animations != current.animations {
+		aspects |= fyne.SettingsAspectReducedMotion
+	}
+
+	if aspects == 0 {
+		aspects = fyne.SettingsAspectAll
+	}
+	return aspects
 }
 
 func (s *settings) fileChanged() {
@@ -23,6 +23,23 @@ func TestSettingsBuildType(t *testing.T) {
 	assert.Equal(t, build.Mode, set.BuildType()) // when testing this package only it could be debug or release
 }
 
+func TestSettingsChangedAspects(t *testing.T) {
+	s := &settings{}
+	s.theme = theme.DefaultTheme()
+	s.changedAspects() // capture the initial state
+
+	s.variant = theme.VariantLight
+	s.schema.Scale = 1.5
+	aspects := s.changedAspects()
+	assert.Equal(t, fyne.SettingsAspectThemeVariant|fyne.SettingsAspectScale, aspects)
+
+	s.schema.PrimaryColor = theme.ColorRed
+	assert.Equal(t, fyne.SettingsAspectPrimaryColor, s.changedAspects())
+
+	// an apply with nothing identifiable reports all aspects so no change is lost
+	assert.Equal(t, fyne.SettingsAspectAll, s.changedAspects())
+}
+
 func TestSettingsLoad(t *testing.T) {
 	settings := &settings{}
 
@@ -0,0 +1,204 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// SettingsSection describes one page of the settings window opened by
+// ShowSettings, along with the terms that find it through the search box.
+//
+// Since: 2.6
+type SettingsSection struct {
+	// Title is shown in the navigation sidebar and above the page.
+	Title string
+	// Icon is optionally shown beside the title in the sidebar.
+	Icon fyne.Resource
+	// Content is the body of the page.
+	Content fyne.CanvasObject
+	// Keywords are extra search terms that match this section besides its title.
+	Keywords []string
+}
+
+// matches returns whether the section should be listed for the given query,
+// which must already be lower cased.
+func (s *SettingsSection) matches(query string) bool {
+	if query == "" {
+		return true
+	}
+	if strings.Contains(strings.ToLower(s.Title), query) {
+		return true
+	}
+	for _, word := range s.Keywords {
+		if strings.Contains(strings.ToLower(word), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterSettingsSections returns the indexes of the sections listed for the
+// given search box content.
+func filterSettingsSections(sections []SettingsSection, query string) []int {
+	query = strings.ToLower(strings.TrimSpace(query))
+	matched := make([]int, 0, len(sections))
+	for i := range sections {
+		if sections[i].matches(query) {
+			matched = append(matched, i)
+		}
+	}
+	return matched
+}
+
+// ShowSettings opens a window presenting the given preference sections with a
+// navigation sidebar and a search box that filters across every section. The
+// standard appearance section is appended after the sections passed in, so
+// apps get a consistent preferences UI with minimal code.
+//
+// Since: 2.6
+func ShowSettings(sections ...SettingsSection) fyne.Window {
+	sections = append(sections, NewAppearanceSection())
+
+	w := fyne.CurrentApp().NewWindow("Settings")
+	title := widget.NewLabel("")
+	title.TextStyle = fyne.TextStyle{Bold: true}
+	page := container.NewStack()
+
+	filtered := filterSettingsSections(sections, "")
+	list := widget.NewList(
+		func() int { return len(filtered) },
+		func() fyne.CanvasObject {
+			return container.NewHBox(widget.NewIcon(theme.SettingsIcon()), widget.NewLabel("Section"))
+		},
+		func(id widget.ListItemID, item fyne.CanvasObject) {
+			section := &sections[filtered[id]]
+			row := item.(*fyne.Container)
+			icon := row.Objects[0].(*widget.Icon)
+			if section.Icon == nil {
+				icon.Hide()
+			} else {
+				icon.SetResource(section.Icon)
+				icon.Show()
+			}
+			row.Objects[1].(*widget.Label).SetText(section.Title)
+		})
+	list.OnSelected = func(id widget.ListItemID) {
+		section := &sections[filtered[id]]
+		title.SetText(section.Title)
+		page.Objects = []fyne.CanvasObject{section.Content}
+		page.Refresh()
+	}
+
+	search := widget.NewEntry()
+	search.PlaceHolder = "Search settings"
+	search.OnChanged = func(query string) {
+		filtered = filterSettingsSections(sections, query)
+		list.Refresh()
+		if len(filtered) > 0 {
+			list.Select(0)
+		}
+	}
+
+	sidebar := container.NewBorder(search, nil, nil, nil, list)
+	body := container.NewBorder(title, nil, nil, nil, container.NewVScroll(page))
+	split := container.NewHSplit(sidebar, body)
+	split.SetOffset(0.3)
+
+	w.SetContent(split)
+	w.Resize(fyne.NewSize(640, 420))
+	list.Select(0)
+	w.Show()
+	return w
+}
+
+// NewAppearanceSection returns the standard appearance page shown by
+// ShowSettings, controlling the theme variant, scale and animation settings
+// shared by every Fyne application on this system.
+//
+// Since: 2.6
+func NewAppearanceSection() SettingsSection {
+	schema := SettingsSchema{Scale: 1}
+	if current, ok := fyne.CurrentApp().Settings().(*settings); ok {
+		current.propertyLock.RLock()
+		schema = current.schema
+		current.propertyLock.RUnlock()
+	}
+
+	variant := widget.NewSelect([]string{"System default", "Light", "Dark"}, nil)
+	switch schema.ThemeName {
+	case "light":
+		variant.Selected = "Light"
+	case "dark":
+		variant.Selected = "Dark"
+	default:
+		variant.Selected = "System default"
+	}
+	variant.OnChanged = func(string) {
+		names := []string{"", "light", "dark"}
+		applyGlobalSetting(func(sc *SettingsSchema) {
+			sc.ThemeName = names[variant.SelectedIndex()]
+		})
+	}
+
+	scale := widget.NewSlider(0.5, 2.5)
+	scale.Step = 0.1
+	scale.Value = 1
+	if schema.Scale > 0 {
+		scale.Value = float64(schema.Scale)
+	}
+	scale.OnChangeEnded = func(value float64) {
+		applyGlobalSetting(func(sc *SettingsSchema) {
+			sc.Scale = float32(value)
+		})
+	}
+
+	animations := widget.NewCheck("Disable animations", func(off bool) {
+		applyGlobalSetting(func(sc *SettingsSchema) {
+			sc.DisableAnimations = off
+		})
+	})
+	animations.Checked = schema.DisableAnimations
+
+	content := widget.NewForm(
+		widget.NewFormItem("Theme", variant),
+		widget.NewFormItem("Scale", scale),
+		widget.NewFormItem("Animations", animations))
+	return SettingsSection{Title: "Appearance", Icon: theme.ColorPaletteIcon(), Content: content,
+		Keywords: []string{"theme", "dark", "light", "scale", "animations"}}
+}
+
+// applyGlobalSetting mutates the global settings schema, saving it for other
+// applications and applying it to the running one.
+func applyGlobalSetting(mutate func(*SettingsSchema)) {
+	s, ok := fyne.CurrentApp().Settings().(*settings)
+	if !ok {
+		return // running with a custom Settings implementation, such as in tests
+	}
+
+	s.propertyLock.Lock()
+	mutate(&s.schema)
+	schema := s.schema
+	s.propertyLock.Unlock()
+
+	data, err := json.Marshal(&schema)
+	if err != nil {
+		fyne.LogError("Failed to encode global settings", err)
+		return
+	}
+	path := schema.StoragePath()
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err == nil {
+		err = os.WriteFile(path, data, 0644)
+	}
+	if err != nil {
+		fyne.LogError("Failed to save global settings", err)
+	}
+
+	s.setupTheme() // re-apply and notify every listener of the change
+}
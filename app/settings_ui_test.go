@@ -0,0 +1,59 @@
+package app
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+func TestFilterSettingsSections(t *testing.T) {
+	sections := []SettingsSection{
+		{Title: "Account", Keywords: []string{"login", "password"}},
+		{Title: "Network"},
+		{Title: "Appearance", Keywords: []string{"theme"}},
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, filterSettingsSections(sections, ""))
+	assert.Equal(t, []int{1}, filterSettingsSections(sections, "net"))
+	assert.Equal(t, []int{0}, filterSettingsSections(sections, " PassWord "))
+	assert.Empty(t, filterSettingsSections(sections, "missing"))
+}
+
+func TestShowSettings(t *testing.T) {
+	NewWithID("io.fyne.test")
+
+	w := ShowSettings(SettingsSection{Title: "General", Content: widget.NewLabel("body")})
+	defer w.Close()
+
+	_, ok := w.Canvas().Content().(*container.Split)
+	assert.True(t, ok, "the settings window splits a sidebar from the page body")
+}
+
+func TestNewAppearanceSection(t *testing.T) {
+	a := NewWithID("io.fyne.test")
+	w := a.NewWindow("Test") // widget refreshes need a canvas
+	defer w.Close()
+
+	section := NewAppearanceSection()
+	assert.Equal(t, "Appearance", section.Title)
+	assert.True(t, section.matches("dark"))
+
+	form := section.Content.(*widget.Form)
+	require.Len(t, form.Items, 3)
+	check := form.Items[2].Widget.(*widget.Check)
+
+	check.SetChecked(false) // normalise whatever state an earlier run left behind
+	path := (&SettingsSchema{}).StoragePath()
+	os.Remove(path)
+	check.SetChecked(true)
+	defer check.SetChecked(false) // restore the shared test settings file
+
+	_, err := os.Stat(path)
+	assert.NoError(t, err, "the change should be saved to the global settings file")
+	assert.False(t, a.Settings().ShowAnimations())
+}
@@ -0,0 +1,157 @@
+package app
+
+import (
+	"errors"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// SpeechEngine speaks text aloud using the text-to-speech facilities of the
+// current platform. Use the Speech function to obtain an instance.
+//
+// Since: 2.6
+type SpeechEngine struct{}
+
+// SpeechOptions configures how text is spoken by a SpeechEngine.
+//
+// Since: 2.6
+type SpeechOptions struct {
+	// Voice selects a platform voice by name. The default voice is used when empty.
+	Voice string
+	// Rate adjusts the speaking speed relative to the platform default,
+	// where 1.0 (or 0, the zero value) is the default speed.
+	Rate float32
+}
+
+// Speech returns the text-to-speech engine for this device. Speaking requires a
+// platform speech facility - the built-in engines on macOS and Windows, or
+// speech-dispatcher/espeak on Linux and BSD systems.
+//
+// Since: 2.6
+func Speech() *SpeechEngine {
+	return &SpeechEngine{}
+}
+
+// CanListen reports whether dictation (speech-to-text) is available on this
+// device. No platform recogniser is bridged yet, so this currently returns
+// false everywhere - check it before calling Listen so apps degrade gracefully
+// once support arrives for some platforms only.
+//
+// Since: 2.6
+func (e *SpeechEngine) CanListen() bool {
+	return false
+}
+
+// Listen captures dictated text from the microphone, calling onText with each
+// recognised phrase - typically inserted into the focused Entry. Dictation is
+// not yet bridged to any platform recogniser, so this returns an error on
+// every platform; use CanListen to check for support before offering it.
+//
+// Since: 2.6
+func (e *SpeechEngine) Listen(onText func(text string)) error {
+	return errors.New("speech recognition is not available on this platform")
+}
+
+// Speak reads the given text aloud, blocking until the utterance has finished.
+// Pass nil opts to use the default voice and speed.
+// An error is returned if no speech engine is available on this system.
+func (e *SpeechEngine) Speak(text string, opts *SpeechOptions) error {
+	if opts == nil {
+		opts = &SpeechOptions{}
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		var args []string
+		if opts.Voice != "" {
+			args = append(args, "-v", opts.Voice)
+		}
+		if r := opts.Rate; r > 0 && r != 1 {
+			args = append(args, "-r", strconv.Itoa(wordsPerMinute(r)))
+		}
+		return runSpeechCommand("say", append(args, text)...)
+	case "windows":
+		script := "Add-Type -AssemblyName System.Speech; " +
+			"$speech = New-Object System.Speech.Synthesis.SpeechSynthesizer; "
+		if opts.Voice != "" {
+			script += "$speech.SelectVoice(" + powerShellQuote(opts.Voice) + "); "
+		}
+		if r := opts.Rate; r > 0 && r != 1 {
+			script += "$speech.Rate = " + strconv.Itoa(sapiRate(r)) + "; "
+		}
+		script += "$speech.Speak(" + powerShellQuote(text) + ")"
+		return runSpeechCommand("PowerShell", "-NoProfile", "-Command", script)
+	default:
+		for _, tool := range []string{"spd-say", "espeak-ng", "espeak"} {
+			if _, err := exec.LookPath(tool); err == nil {
+				return runSpeechCommand(tool, speechToolArgs(tool, text, opts)...)
+			}
+		}
+		return errors.New("no speech engine found - install speech-dispatcher or espeak")
+	}
+}
+
+func runSpeechCommand(name string, args ...string) error {
+	return exec.Command(name, args...).Run()
+}
+
+// powerShellQuote wraps text in a PowerShell single-quoted literal.
+func powerShellQuote(text string) string {
+	return "'" + strings.ReplaceAll(text, "'", "''") + "'"
+}
+
+// sapiRate converts a relative speaking rate to the -10 to 10 range used by
+// the Windows speech API, where 0 is the default speed.
+func sapiRate(rate float32) int {
+	r := int((rate - 1) * 10)
+	if r < -10 {
+		return -10
+	}
+	if r > 10 {
+		return 10
+	}
+	return r
+}
+
+// spdRate converts a relative speaking rate to the -100 to 100 range used by
+// speech-dispatcher, where 0 is the default speed.
+func spdRate(rate float32) int {
+	r := int((rate - 1) * 100)
+	if r < -100 {
+		return -100
+	}
+	if r > 100 {
+		return 100
+	}
+	return r
+}
+
+// speechToolArgs assembles the command line for one of the Linux speech tools.
+func speechToolArgs(tool, text string, opts *SpeechOptions) []string {
+	var args []string
+	if tool == "spd-say" {
+		args = append(args, "--wait")
+		if opts.Voice != "" {
+			args = append(args, "-y", opts.Voice)
+		}
+		if r := opts.Rate; r > 0 && r != 1 {
+			args = append(args, "-r", strconv.Itoa(spdRate(r)))
+		}
+	} else { // espeak and espeak-ng
+		if opts.Voice != "" {
+			args = append(args, "-v", opts.Voice)
+		}
+		if r := opts.Rate; r > 0 && r != 1 {
+			args = append(args, "-s", strconv.Itoa(wordsPerMinute(r)))
+		}
+	}
+	return append(args, text)
+}
+
+// wordsPerMinute converts a relative speaking rate to the words per minute
+// figure used by say and espeak, which both default to roughly 175.
+func wordsPerMinute(rate float32) int {
+	return int(rate*175 + 0.5)
+}
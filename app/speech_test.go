@@ -0,0 +1,39 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpeech(t *testing.T) {
+	assert.NotNil(t, Speech())
+}
+
+func TestSpeech_Listen(t *testing.T) {
+	e := Speech()
+	assert.False(t, e.CanListen())
+	assert.Error(t, e.Listen(func(string) {}))
+}
+
+func TestSpeechToolArgs(t *testing.T) {
+	opts := &SpeechOptions{Voice: "en", Rate: 2}
+	assert.Equal(t, []string{"--wait", "-y", "en", "-r", "100", "hi"}, speechToolArgs("spd-say", "hi", opts))
+	assert.Equal(t, []string{"-v", "en", "-s", "350", "hi"}, speechToolArgs("espeak", "hi", opts))
+	assert.Equal(t, []string{"--wait", "hi"}, speechToolArgs("spd-say", "hi", &SpeechOptions{}))
+}
+
+func TestSpeechRates(t *testing.T) {
+	assert.Equal(t, 0, sapiRate(1))
+	assert.Equal(t, 5, sapiRate(1.5))
+	assert.Equal(t, -10, sapiRate(0))
+	assert.Equal(t, 10, sapiRate(5))
+	assert.Equal(t, -100, spdRate(0))
+	assert.Equal(t, 50, spdRate(1.5))
+	assert.Equal(t, 175, wordsPerMinute(1))
+}
+
+func TestPowerShellQuote(t *testing.T) {
+	assert.Equal(t, "'hello'", powerShellQuote("hello"))
+	assert.Equal(t, "'it''s'", powerShellQuote("it's"))
+}
@@ -1,9 +1,67 @@
 package canvas
 
-import "fyne.io/fyne/v2"
+import (
+	"sync"
+
+	"fyne.io/fyne/v2"
+)
+
+var (
+	batchLock    sync.Mutex
+	batchDepth   int
+	batchPending []fyne.CanvasObject
+)
 
 // Refresh instructs the containing canvas to refresh the specified obj.
 func Refresh(obj fyne.CanvasObject) {
+	batchLock.Lock()
+	if batchDepth > 0 {
+		batchPending = append(batchPending, obj)
+		batchLock.Unlock()
+		return
+	}
+	batchLock.Unlock()
+
+	refreshNow(obj)
+}
+
+// BatchUpdates runs the given function with refresh propagation suspended, then
+// refreshes each changed object once when it returns. Use this when building or
+// updating many objects at once to avoid triggering a full layout and paint for
+// every individual change. Calls may be nested - the consolidated refresh happens
+// when the outermost batch completes.
+//
+// Since: 2.6
+func BatchUpdates(fn func()) {
+	batchLock.Lock()
+	batchDepth++
+	batchLock.Unlock()
+
+	// flush in a defer so a panic in fn cannot leave refreshes suspended forever
+	defer func() {
+		batchLock.Lock()
+		batchDepth--
+		var pending []fyne.CanvasObject
+		if batchDepth == 0 {
+			pending = batchPending
+			batchPending = nil
+		}
+		batchLock.Unlock()
+
+		refreshed := make(map[fyne.CanvasObject]struct{}, len(pending))
+		for _, obj := range pending {
+			if _, done := refreshed[obj]; done {
+				continue
+			}
+			refreshed[obj] = struct{}{}
+			refreshNow(obj)
+		}
+	}()
+
+	fn()
+}
+
+func refreshNow(obj fyne.CanvasObject) {
 	app := fyne.CurrentApp()
 	if app == nil || app.Driver() == nil {
 		return
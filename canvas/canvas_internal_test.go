@@ -0,0 +1,63 @@
+package canvas
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchUpdates(t *testing.T) {
+	obj := NewRectangle(color.Black)
+	other := NewRectangle(color.White)
+	BatchUpdates(func() {
+		Refresh(obj)
+		Refresh(obj)
+		Refresh(other)
+
+		batchLock.Lock()
+		assert.Len(t, batchPending, 3)
+		batchLock.Unlock()
+	})
+
+	batchLock.Lock()
+	defer batchLock.Unlock()
+	assert.Zero(t, batchDepth)
+	assert.Empty(t, batchPending)
+}
+
+func TestBatchUpdates_Panic(t *testing.T) {
+	obj := NewRectangle(color.Black)
+	assert.Panics(t, func() {
+		BatchUpdates(func() {
+			Refresh(obj)
+			panic("boom")
+		})
+	})
+
+	// a panicking callback must not leave refreshes suspended
+	batchLock.Lock()
+	defer batchLock.Unlock()
+	assert.Zero(t, batchDepth)
+	assert.Empty(t, batchPending)
+}
+
+func TestBatchUpdates_Nested(t *testing.T) {
+	obj := NewRectangle(color.Black)
+	BatchUpdates(func() {
+		Refresh(obj)
+		BatchUpdates(func() {
+			Refresh(obj)
+		})
+
+		// the inner batch must not flush while the outer one is still open
+		batchLock.Lock()
+		assert.Len(t, batchPending, 2)
+		batchLock.Unlock()
+	})
+
+	batchLock.Lock()
+	defer batchLock.Unlock()
+	assert.Zero(t, batchDepth)
+	assert.Empty(t, batchPending)
+}
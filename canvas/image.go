@@ -4,15 +4,19 @@ import (
 	"bytes"
 	"errors"
 	"image"
-	_ "image/jpeg" // avoid users having to import when using image widget
+	_ "image/gif"  // avoid users having to import when using image widget
+	_ "image/jpeg" // avoid the same for JPEG images
 	_ "image/png"  // avoid the same for PNG images
 	"io"
 	"os"
 	"path/filepath"
 	"sync"
 
+	_ "golang.org/x/image/webp" // avoid the same for WebP images
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/internal/cache"
+	"fyne.io/fyne/v2/internal/exif"
 	"fyne.io/fyne/v2/internal/scale"
 	"fyne.io/fyne/v2/internal/svg"
 	"fyne.io/fyne/v2/storage"
@@ -157,11 +161,19 @@ func (i *Image) Refresh() {
 				return
 			}
 
-			img, _, err := image.Decode(rc)
+			data, err := io.ReadAll(rc)
+			if err != nil {
+				fyne.LogError("Failed to read image", err)
+				return
+			}
+			img, _, err := image.Decode(bytes.NewReader(data))
 			if err != nil {
 				fyne.LogError("Failed to render image", err)
 				return
 			}
+			if o := exif.Orientation(bytes.NewReader(data)); o > 1 {
+				img = exif.Apply(img, o)
+			}
 			i.Image = img
 		}
 	}
@@ -342,6 +354,9 @@ func (i *Image) imageDetailsFromReader(source io.Reader) (reader io.Reader, widt
 			return nil, 0, 0, 0, err
 		}
 		width, height = config.Width, config.Height
+		if o := exif.Orientation(bytes.NewReader(buf.Bytes())); o >= 5 { // transposed orientations swap the axes
+			width, height = height, width
+		}
 		aspect = float32(width) / float32(height)
 	}
 	return
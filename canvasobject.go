@@ -75,6 +75,29 @@ type Focusable interface {
 	TypedKey(*KeyEvent)
 }
 
+// HitTestable describes any CanvasObject with a non-rectangular interactive
+// area, such as a circular button or an image that ignores transparent pixels.
+// Drivers consult HitTest when routing tap, hover and drag events, an object
+// returning false for a position inside its bounding box lets the event reach
+// the objects beneath it instead.
+//
+// Since: 2.6
+type HitTestable interface {
+	// HitTest returns whether the given position, relative to the top left
+	// corner of the object, is inside its interactive area.
+	HitTest(Position) bool
+}
+
+// ScaleChangeable describes any CanvasObject that wishes to be informed when
+// the scale (pixel density) of its canvas changes, for example after the
+// window moves to a monitor with a different DPI.
+//
+// Since: 2.6
+type ScaleChangeable interface {
+	// ScaleChanged is called after the canvas scale has changed.
+	ScaleChanged(scale float32)
+}
+
 // Scrollable describes any CanvasObject that can also be scrolled.
 // This is mostly used to implement the widget.ScrollContainer.
 type Scrollable interface {
@@ -18,6 +18,8 @@ const fileHeader = "// auto-generated\n" + // to exclude this file in goreportca
 
 var filenameRegex = regexp.MustCompile("[^a-zA-Z0-9]+")
 
+var scaledVariantRegex = regexp.MustCompile(`@([0-9])x(\.[^.]+)?$`)
+
 // Bundle returns the vendor cli command
 func Bundle() *cli.Command {
 	b := &Bundler{}
@@ -190,6 +192,9 @@ func (b *Bundler) dirBundle(dirpath string, out *os.File) error {
 		if path.Ext(filename) == ".go" {
 			continue
 		}
+		if scaledVariantRegex.MatchString(filename) {
+			continue // bundled together with their base asset
+		}
 
 		b.name = ""
 
@@ -215,9 +220,31 @@ func (b *Bundler) doBundle(filepath string, out *os.File) {
 	if b.name == "" {
 		b.name = sanitiseName(path.Base(filepath), b.prefix)
 	}
+
+	variants := scaledVariantsOf(filepath)
+	if len(variants) > 0 {
+		writeScaledResource(filepath, variants, b.name, out)
+		return
+	}
 	writeResource(filepath, b.name, out)
 }
 
+// scaledVariantsOf looks for pixel density siblings of the given file, such as
+// "icon@2x.png" next to "icon.png", returning their paths by multiplier.
+func scaledVariantsOf(filepath string) map[int]string {
+	ext := path.Ext(filepath)
+	base := strings.TrimSuffix(filepath, ext)
+
+	variants := map[int]string{}
+	for _, mult := range []int{2, 3} {
+		variant := fmt.Sprintf("%s@%dx%s", base, mult, ext)
+		if _, err := os.Stat(variant); err == nil {
+			variants[mult] = variant
+		}
+	}
+	return variants
+}
+
 func openOutputFile(filePath string, noheader bool) (file *os.File, close func() error, err error) {
 	fileModes := os.O_RDWR | os.O_CREATE | os.O_TRUNC
 	if noheader {
@@ -269,3 +296,35 @@ func writeResource(file, name string, f *os.File) {
 		fyne.LogError("Unable to write to bundled file", err)
 	}
 }
+
+func writeScaledResource(file string, variants map[int]string, name string, f *os.File) {
+	res, err := fyne.LoadResourceFromPath(file)
+	if err != nil {
+		fyne.LogError("Unable to load file "+file, err)
+		return
+	}
+
+	const baseFormat = "var %s = &fyne.ScaledResource{\n\tBase: &fyne.StaticResource{\n\t\tStaticName: %q,\n\t\tStaticContent: []byte(\n\t\t\t%q),\n\t},\n\tVariants: map[int]fyne.Resource{\n"
+	_, err = fmt.Fprintf(f, baseFormat, name, res.Name(), res.Content())
+	if err != nil {
+		fyne.LogError("Unable to write to bundled file", err)
+		return
+	}
+
+	const variantFormat = "\t\t%d: &fyne.StaticResource{\n\t\t\tStaticName: %q,\n\t\t\tStaticContent: []byte(\n\t\t\t\t%q),\n\t\t},\n"
+	for _, mult := range []int{2, 3} {
+		variant, ok := variants[mult]
+		if !ok {
+			continue
+		}
+
+		vres, err := fyne.LoadResourceFromPath(variant)
+		if err != nil {
+			fyne.LogError("Unable to load file "+variant, err)
+			continue
+		}
+		fmt.Fprintf(f, variantFormat, mult, vres.Name(), vres.Content())
+	}
+
+	f.WriteString("\t},\n}\n")
+}
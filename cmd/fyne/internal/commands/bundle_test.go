@@ -74,3 +74,43 @@ func BenchmarkWriteResource(b *testing.B) {
 		b.StartTimer()
 	}
 }
+
+func TestScaledVariantsOf(t *testing.T) {
+	variants := scaledVariantsOf("testdata/bundle/sharp.txt")
+	assert.Equal(t, map[int]string{2: "testdata/bundle/sharp@2x.txt"}, variants)
+
+	assert.Empty(t, scaledVariantsOf("testdata/bundle/content.txt"))
+}
+
+func TestWriteScaledResource(t *testing.T) {
+	f, err := os.CreateTemp("", "*.go")
+	if err != nil {
+		t.Fatal("Unable to create temp file:", err)
+	}
+	defer func() {
+		f.Close()
+		os.Remove(f.Name())
+	}()
+
+	writeScaledResource("testdata/bundle/sharp.txt",
+		map[int]string{2: "testdata/bundle/sharp@2x.txt"}, "sharpTxt", f)
+
+	const expected = "var sharpTxt = &fyne.ScaledResource{\n" +
+		"\tBase: &fyne.StaticResource{\n\t\tStaticName: \"sharp.txt\",\n\t\tStaticContent: []byte(\n\t\t\t\"I am crisp\"),\n\t},\n" +
+		"\tVariants: map[int]fyne.Resource{\n" +
+		"\t\t2: &fyne.StaticResource{\n\t\t\tStaticName: \"sharp@2x.txt\",\n\t\t\tStaticContent: []byte(\n\t\t\t\t\"I am crisper\"),\n\t\t},\n" +
+		"\t},\n}\n"
+
+	// Seek file to start so we can read the written data.
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		t.Fatal("Unable to seek temp file:", err)
+	}
+
+	content, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatal("Unable to read temp file:", err)
+	}
+
+	assert.Equal(t, expected, string(content))
+}
@@ -0,0 +1,155 @@
+package container
+
+import (
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+var (
+	busyLock   sync.Mutex
+	busyStates = map[*fyne.Container]*busyState{}
+)
+
+// busyState remembers what a container looked like before SetBusy wrapped it.
+type busyState struct {
+	layout  fyne.Layout
+	blocker *busyBlocker
+}
+
+// SetBusy marks the given container as busy, dimming its content underneath a
+// spinner and blocking pointer input to it while the rest of the window stays
+// interactive. Passing false restores the container to its previous state.
+// This allows partial-page loading states without restructuring layouts
+// around Stack containers.
+//
+// Since: 2.6
+func SetBusy(c *fyne.Container, busy bool) {
+	busyLock.Lock()
+	defer busyLock.Unlock()
+
+	state, wasBusy := busyStates[c]
+	if busy == wasBusy {
+		return // already in the requested state
+	}
+
+	if !busy {
+		inner := c.Objects[0].(*fyne.Container)
+		state.blocker.spinner.Stop()
+		c.Layout = state.layout
+		c.Objects = inner.Objects
+		delete(busyStates, c)
+		c.Refresh()
+		return
+	}
+
+	inner := &fyne.Container{Layout: c.Layout, Objects: c.Objects}
+	blocker := newBusyBlocker()
+	busyStates[c] = &busyState{layout: c.Layout, blocker: blocker}
+	c.Layout = layout.NewStackLayout()
+	c.Objects = []fyne.CanvasObject{inner, blocker}
+	blocker.spinner.Start()
+	c.Refresh()
+}
+
+// Declare conformity with the interfaces that route pointer input, so events
+// over a busy container stop at the blocker instead of its content.
+var _ fyne.Widget = (*busyBlocker)(nil)
+var _ fyne.Tappable = (*busyBlocker)(nil)
+var _ fyne.SecondaryTappable = (*busyBlocker)(nil)
+var _ fyne.DoubleTappable = (*busyBlocker)(nil)
+var _ fyne.Scrollable = (*busyBlocker)(nil)
+var _ desktop.Hoverable = (*busyBlocker)(nil)
+var _ desktop.Mouseable = (*busyBlocker)(nil)
+
+// busyBlocker dims the content of a busy container, shows an activity spinner
+// and swallows any pointer input aimed at the content.
+type busyBlocker struct {
+	widget.BaseWidget
+
+	spinner *widget.Activity
+}
+
+func newBusyBlocker() *busyBlocker {
+	b := &busyBlocker{spinner: widget.NewActivity()}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (b *busyBlocker) CreateRenderer() fyne.WidgetRenderer {
+	dim := canvas.NewRectangle(theme.ShadowColor())
+	return &busyBlockerRenderer{dim: dim, spinner: b.spinner}
+}
+
+// DoubleTapped catches double-tap events over the busy content. It does nothing.
+func (b *busyBlocker) DoubleTapped(*fyne.PointEvent) {
+}
+
+// MouseDown catches mouse-down events over the busy content. It does nothing.
+func (b *busyBlocker) MouseDown(*desktop.MouseEvent) {
+}
+
+// MouseIn catches mouse-in events over the busy content. It does nothing.
+func (b *busyBlocker) MouseIn(*desktop.MouseEvent) {
+}
+
+// MouseMoved catches mouse-moved events over the busy content. It does nothing.
+func (b *busyBlocker) MouseMoved(*desktop.MouseEvent) {
+}
+
+// MouseOut catches mouse-out events over the busy content. It does nothing.
+func (b *busyBlocker) MouseOut() {
+}
+
+// MouseUp catches mouse-up events over the busy content. It does nothing.
+func (b *busyBlocker) MouseUp(*desktop.MouseEvent) {
+}
+
+// Scrolled catches scroll events over the busy content. It does nothing.
+func (b *busyBlocker) Scrolled(*fyne.ScrollEvent) {
+}
+
+// Tapped catches tap events over the busy content. It does nothing.
+func (b *busyBlocker) Tapped(*fyne.PointEvent) {
+}
+
+// TappedSecondary catches secondary tap events over the busy content. It does nothing.
+func (b *busyBlocker) TappedSecondary(*fyne.PointEvent) {
+}
+
+var _ fyne.WidgetRenderer = (*busyBlockerRenderer)(nil)
+
+type busyBlockerRenderer struct {
+	dim     *canvas.Rectangle
+	spinner *widget.Activity
+}
+
+func (r *busyBlockerRenderer) Destroy() {
+}
+
+func (r *busyBlockerRenderer) Layout(size fyne.Size) {
+	r.dim.Resize(size)
+
+	min := r.spinner.MinSize()
+	r.spinner.Resize(min)
+	r.spinner.Move(fyne.NewPos((size.Width-min.Width)/2, (size.Height-min.Height)/2))
+}
+
+func (r *busyBlockerRenderer) MinSize() fyne.Size {
+	return r.spinner.MinSize()
+}
+
+func (r *busyBlockerRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.dim, r.spinner}
+}
+
+func (r *busyBlockerRenderer) Refresh() {
+	r.dim.FillColor = theme.ShadowColor()
+	r.dim.Refresh()
+}
@@ -0,0 +1,30 @@
+package container
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetBusy(t *testing.T) {
+	label := widget.NewLabel("Loading soon")
+	content := NewVBox(label)
+
+	SetBusy(content, true)
+	require.Len(t, content.Objects, 2)
+	_, ok := content.Objects[1].(*busyBlocker)
+	assert.True(t, ok, "the blocker sits above the wrapped content")
+
+	SetBusy(content, true) // setting the same state again is a no-op
+	assert.Len(t, content.Objects, 2)
+
+	SetBusy(content, false)
+	require.Len(t, content.Objects, 1)
+	assert.Equal(t, label, content.Objects[0], "the original content is restored")
+
+	SetBusy(content, false) // clearing a container that is not busy is a no-op
+	assert.Len(t, content.Objects, 1)
+}
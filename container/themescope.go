@@ -0,0 +1,64 @@
+package container
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/cache"
+	"fyne.io/fyne/v2/widget"
+)
+
+// ThemeScope is a container that themes its content subtree with the specified theme.
+// Unlike ThemeOverride, scopes nest predictably - when scopes are nested the innermost
+// scope wins for the objects inside it, and refreshing an outer scope will not disturb
+// the theme of a nested one. The subtree can be re-themed dynamically with SetTheme.
+//
+// Since: 2.6
+type ThemeScope struct {
+	widget.BaseWidget
+
+	Content fyne.CanvasObject
+
+	holder *fyne.Container
+	th     fyne.Theme
+}
+
+// NewThemeScope returns a container where the content subtree is themed by the given theme.
+// If the content is a container and items are later added to it or any sub-containers,
+// call Refresh on this scope to ensure the new items pick up the theme.
+//
+// Since: 2.6
+func NewThemeScope(th fyne.Theme, content fyne.CanvasObject) *ThemeScope {
+	s := &ThemeScope{Content: content, th: th, holder: NewStack(content)}
+	s.ExtendBaseWidget(s)
+
+	cache.OverrideThemeScoped(content, th)
+	content.Refresh() // required as the widgets passed in could have been initially rendered with default theme
+	return s
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (s *ThemeScope) CreateRenderer() fyne.WidgetRenderer {
+	cache.OverrideThemeScoped(s.Content, s.th)
+
+	return widget.NewSimpleRenderer(s.holder)
+}
+
+// Refresh re-applies the scope theme to the content subtree and redraws it.
+func (s *ThemeScope) Refresh() {
+	if s.holder.Objects[0] != s.Content {
+		s.holder.Objects[0] = s.Content
+		s.holder.Refresh()
+	}
+
+	cache.OverrideThemeScoped(s.Content, s.th)
+	s.BaseWidget.Refresh()
+}
+
+// SetTheme re-themes the content subtree with a new theme.
+func (s *ThemeScope) SetTheme(th fyne.Theme) {
+	if th == s.th {
+		return
+	}
+
+	s.th = th
+	s.Refresh()
+}
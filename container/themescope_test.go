@@ -0,0 +1,51 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2/internal/cache"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+)
+
+func TestThemeScope_Nesting(t *testing.T) {
+	inner := widget.NewLabel("Inner")
+	innerTheme := test.NewTheme()
+	innerScope := NewThemeScope(innerTheme, inner)
+
+	outer := widget.NewLabel("Outer")
+	group := NewVBox(outer, innerScope)
+	outerScope := NewThemeScope(test.Theme(), group)
+
+	assert.Equal(t, test.Theme(), cache.WidgetTheme(outer))
+	assert.Equal(t, innerTheme, cache.WidgetTheme(inner))
+
+	// refreshing the outer scope must not disturb the nested scope
+	outerScope.Refresh()
+	assert.Equal(t, innerTheme, cache.WidgetTheme(inner))
+}
+
+func TestThemeScope_SetTheme(t *testing.T) {
+	label := widget.NewLabel("Test")
+	scope := NewThemeScope(test.Theme(), label)
+	assert.Equal(t, test.Theme(), cache.WidgetTheme(label))
+
+	other := test.NewTheme()
+	scope.SetTheme(other)
+	assert.Equal(t, other, cache.WidgetTheme(label))
+}
+
+func TestThemeScope_AddChild(t *testing.T) {
+	b := widget.NewButton("Test", func() {})
+	group := NewHBox(b)
+	scope := NewThemeScope(test.Theme(), group)
+
+	child := widget.NewLabel("Added")
+	assert.NotEqual(t, cache.WidgetTheme(b), cache.WidgetTheme(child))
+
+	group.Add(child)
+	scope.Refresh()
+	assert.Equal(t, cache.WidgetTheme(b), cache.WidgetTheme(child))
+}
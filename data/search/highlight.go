@@ -0,0 +1,45 @@
+package search
+
+// Span is a run of text that either matched a query or sits between matches,
+// see Spans.
+//
+// Since: 2.6
+type Span struct {
+	// Text is the content of this run.
+	Text string
+	// Matched is true when this run was part of a match.
+	Matched bool
+}
+
+// Spans splits text into runs along the matched ranges, in order, so a widget
+// can present each matched region with an emphasised style, such as bold
+// RichText segments. Ranges are clamped to the text and empty runs are omitted.
+//
+// Since: 2.6
+func Spans(text string, ranges []Range) []Span {
+	runes := []rune(text)
+	spans := make([]Span, 0, len(ranges)*2+1)
+	at := 0
+	for _, r := range ranges {
+		start, end := r.Start, r.End
+		if start < at {
+			start = at
+		}
+		if end > len(runes) {
+			end = len(runes)
+		}
+		if end <= start {
+			continue
+		}
+
+		if start > at {
+			spans = append(spans, Span{Text: string(runes[at:start])})
+		}
+		spans = append(spans, Span{Text: string(runes[start:end]), Matched: true})
+		at = end
+	}
+	if at < len(runes) {
+		spans = append(spans, Span{Text: string(runes[at:])})
+	}
+	return spans
+}
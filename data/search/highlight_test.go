@@ -0,0 +1,31 @@
+package search_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/data/search"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpans(t *testing.T) {
+	m, ok := search.Fuzzy("fooBar", "fbr")
+	require.True(t, ok)
+
+	assert.Equal(t, []search.Span{
+		{Text: "f", Matched: true},
+		{Text: "oo"},
+		{Text: "B", Matched: true},
+		{Text: "a"},
+		{Text: "r", Matched: true},
+	}, search.Spans("fooBar", m.Ranges))
+
+	assert.Equal(t, []search.Span{{Text: "abc"}}, search.Spans("abc", nil))
+
+	// ranges beyond the text are clamped
+	assert.Equal(t, []search.Span{
+		{Text: "ab"},
+		{Text: "c", Matched: true},
+	}, search.Spans("abc", []search.Range{{Start: 2, End: 9}}))
+}
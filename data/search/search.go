@@ -0,0 +1,124 @@
+// Package search provides text matchers for filtering a list of candidates as
+// the user types, ranking the results and describing the matched regions so
+// widgets can highlight them consistently.
+package search
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Range marks a matched region of a candidate as [Start, End) rune offsets.
+//
+// Since: 2.6
+type Range struct {
+	Start, End int
+}
+
+// Match describes how well a candidate matched a query.
+//
+// Since: 2.6
+type Match struct {
+	// Index is the position of the candidate in the list passed to Rank.
+	Index int
+	// Score ranks the quality of this match against others, higher is better.
+	Score int
+	// Ranges holds the matched regions of the candidate in ascending order.
+	Ranges []Range
+}
+
+// Matcher reports whether text matches query, describing the match.
+// Fuzzy and Substring are the built-in matchers.
+//
+// Since: 2.6
+type Matcher func(text, query string) (Match, bool)
+
+// Fuzzy reports whether text contains the runes of query in order, ignoring
+// case. Matches at the start of the text and tightly grouped matches score
+// higher, so for the query "fbr" the candidate "fooBar" outranks "falsebanner".
+// An empty query matches with no ranges.
+//
+// Since: 2.6
+func Fuzzy(text, query string) (Match, bool) {
+	m := Match{}
+	textRunes := []rune(strings.ToLower(text))
+	index, last := 0, -1
+	for _, q := range []rune(strings.ToLower(query)) {
+		found := false
+		for ; index < len(textRunes); index++ {
+			if textRunes[index] != q {
+				continue
+			}
+			m.Score += 2
+			if index == 0 {
+				m.Score += 2 // matching the start of the text
+			}
+			if last >= 0 {
+				m.Score -= index - last - 1 // penalise gaps between matched runes
+			}
+			m.Ranges = appendOffset(m.Ranges, index)
+			last = index
+			index++
+			found = true
+			break
+		}
+		if !found {
+			return Match{}, false
+		}
+	}
+	return m, true
+}
+
+// Substring reports whether text contains query as a contiguous substring,
+// ignoring case. Matches at the start of the text score higher. An empty
+// query matches with no ranges.
+//
+// Since: 2.6
+func Substring(text, query string) (Match, bool) {
+	if query == "" {
+		return Match{}, true
+	}
+
+	lower := strings.ToLower(text)
+	at := strings.Index(lower, strings.ToLower(query))
+	if at < 0 {
+		return Match{}, false
+	}
+
+	start := utf8.RuneCountInString(lower[:at])
+	count := utf8.RuneCountInString(query)
+	m := Match{Score: count * 2, Ranges: []Range{{Start: start, End: start + count}}}
+	if start == 0 {
+		m.Score += 2
+	}
+	return m, true
+}
+
+// Rank returns the candidates that match query using the given matcher, best
+// first. The Index of each match refers back into the candidates slice, and
+// candidates with equal scores keep their relative order.
+//
+// Since: 2.6
+func Rank(candidates []string, query string, match Matcher) []Match {
+	matches := make([]Match, 0, len(candidates))
+	for i, text := range candidates {
+		if m, ok := match(text, query); ok {
+			m.Index = i
+			matches = append(matches, m)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	return matches
+}
+
+// appendOffset extends the last range when the given rune offset adjoins it,
+// keeping the matched regions compact.
+func appendOffset(ranges []Range, index int) []Range {
+	if n := len(ranges); n > 0 && ranges[n-1].End == index {
+		ranges[n-1].End++
+		return ranges
+	}
+	return append(ranges, Range{Start: index, End: index + 1})
+}
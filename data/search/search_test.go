@@ -0,0 +1,57 @@
+package search_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/data/search"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuzzy(t *testing.T) {
+	m, ok := search.Fuzzy("fooBar", "fbr")
+	require.True(t, ok)
+	assert.Equal(t, []search.Range{{Start: 0, End: 1}, {Start: 3, End: 4}, {Start: 5, End: 6}}, m.Ranges)
+
+	_, ok = search.Fuzzy("other", "fbr")
+	assert.False(t, ok)
+
+	m, ok = search.Fuzzy("abc", "")
+	require.True(t, ok)
+	assert.Empty(t, m.Ranges, "an empty query matches everything")
+
+	m, ok = search.Fuzzy("aabb", "ab")
+	require.True(t, ok)
+	assert.Equal(t, []search.Range{{Start: 0, End: 1}, {Start: 2, End: 3}}, m.Ranges)
+
+	m, ok = search.Fuzzy("Hello", "hello")
+	require.True(t, ok)
+	assert.Equal(t, []search.Range{{Start: 0, End: 5}}, m.Ranges, "adjoining matches merge into one range")
+}
+
+func TestSubstring(t *testing.T) {
+	m, ok := search.Substring("Hello World", "lo wo")
+	require.True(t, ok)
+	assert.Equal(t, []search.Range{{Start: 3, End: 8}}, m.Ranges)
+
+	_, ok = search.Substring("Hello World", "planet")
+	assert.False(t, ok)
+
+	prefix, _ := search.Substring("Hello", "hel")
+	later, _ := search.Substring("A Hello", "hel")
+	assert.Greater(t, prefix.Score, later.Score, "matching the start of the text scores higher")
+}
+
+func TestRank(t *testing.T) {
+	candidates := []string{"falsebanner", "fooBar", "other"}
+
+	matches := search.Rank(candidates, "fbr", search.Fuzzy)
+	require.Len(t, matches, 2)
+	assert.Equal(t, 1, matches[0].Index, "tightly grouped matches rank higher")
+	assert.Equal(t, 0, matches[1].Index)
+
+	matches = search.Rank(candidates, "", search.Fuzzy)
+	assert.Len(t, matches, 3, "no query keeps every candidate in order")
+	assert.Equal(t, 0, matches[0].Index)
+}
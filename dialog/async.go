@@ -0,0 +1,118 @@
+package dialog
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+// FileResult carries the outcome of an asynchronous file open dialog.
+// The reader is nil if the user cancelled.
+//
+// Since: 2.6
+type FileResult struct {
+	Reader fyne.URIReadCloser
+	Err    error
+}
+
+// FileSaveResult carries the outcome of an asynchronous file save dialog.
+// The writer is nil if the user cancelled.
+//
+// Since: 2.6
+type FileSaveResult struct {
+	Writer fyne.URIWriteCloser
+	Err    error
+}
+
+// FolderResult carries the outcome of an asynchronous folder open dialog.
+// The folder is nil if the user cancelled.
+//
+// Since: 2.6
+type FolderResult struct {
+	Folder fyne.ListableURI
+	Err    error
+}
+
+// FileOpenAsync shows a file open dialog over the specified window and returns
+// a channel that delivers the result once, when the user picks a file or
+// cancels. The channel is buffered so the dialog never blocks on it, allowing
+// select and context based flows instead of nested callbacks.
+//
+// Since: 2.6
+func FileOpenAsync(parent fyne.Window) <-chan FileResult {
+	ch := make(chan FileResult, 1)
+	ShowFileOpen(func(reader fyne.URIReadCloser, err error) {
+		ch <- FileResult{Reader: reader, Err: err}
+		close(ch)
+	}, parent)
+	return ch
+}
+
+// FileSaveAsync shows a file save dialog over the specified window and returns
+// a channel that delivers the result once, when the user chooses a location or
+// cancels.
+//
+// Since: 2.6
+func FileSaveAsync(parent fyne.Window) <-chan FileSaveResult {
+	ch := make(chan FileSaveResult, 1)
+	ShowFileSave(func(writer fyne.URIWriteCloser, err error) {
+		ch <- FileSaveResult{Writer: writer, Err: err}
+		close(ch)
+	}, parent)
+	return ch
+}
+
+// FolderOpenAsync shows a folder open dialog over the specified window and
+// returns a channel that delivers the result once, when the user picks a
+// folder or cancels.
+//
+// Since: 2.6
+func FolderOpenAsync(parent fyne.Window) <-chan FolderResult {
+	ch := make(chan FolderResult, 1)
+	ShowFolderOpen(func(folder fyne.ListableURI, err error) {
+		ch <- FolderResult{Folder: folder, Err: err}
+		close(ch)
+	}, parent)
+	return ch
+}
+
+// ConfirmAsync shows a confirmation dialog over the specified window and
+// returns a channel that delivers the decision once, true when confirmed and
+// false when dismissed.
+//
+// Since: 2.6
+func ConfirmAsync(title, message string, parent fyne.Window) <-chan bool {
+	ch := make(chan bool, 1)
+	ShowConfirm(title, message, func(response bool) {
+		ch <- response
+		close(ch)
+	}, parent)
+	return ch
+}
+
+// CustomConfirmAsync shows a custom confirmation dialog over the specified
+// window and returns a channel that delivers the decision once.
+//
+// Since: 2.6
+func CustomConfirmAsync(title, confirm, dismiss string, content fyne.CanvasObject, parent fyne.Window) <-chan bool {
+	ch := make(chan bool, 1)
+	ShowCustomConfirm(title, confirm, dismiss, content, func(response bool) {
+		ch <- response
+		close(ch)
+	}, parent)
+	return ch
+}
+
+// FormAsync shows a form dialog over the specified window and returns a
+// channel that delivers the decision once, true when the form was submitted
+// and false when it was cancelled. The submitted values are read from the
+// item widgets as usual.
+//
+// Since: 2.6
+func FormAsync(title, confirm, dismiss string, items []*widget.FormItem, parent fyne.Window) <-chan bool {
+	ch := make(chan bool, 1)
+	ShowForm(title, confirm, dismiss, items, func(response bool) {
+		ch <- response
+		close(ch)
+	}, parent)
+	return ch
+}
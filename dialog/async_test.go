@@ -0,0 +1,70 @@
+package dialog
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// findAsyncButton walks the object tree of a shown dialog to find the button
+// with the given label.
+func findAsyncButton(o fyne.CanvasObject, label string) *widget.Button {
+	switch c := o.(type) {
+	case *widget.Button:
+		if c.Text == label {
+			return c
+		}
+	case *widget.PopUp:
+		return findAsyncButton(c.Content, label)
+	case *fyne.Container:
+		for _, child := range c.Objects {
+			if b := findAsyncButton(child, label); b != nil {
+				return b
+			}
+		}
+	}
+	return nil
+}
+
+func TestConfirmAsync(t *testing.T) {
+	win := test.NewTempWindow(t, widget.NewLabel("Content"))
+
+	ch := ConfirmAsync("Title", "Are you sure?", win)
+	popup := win.Canvas().Overlays().Top()
+	require.NotNil(t, popup)
+
+	confirm := findAsyncButton(popup, "Yes")
+	require.NotNil(t, confirm)
+	test.Tap(confirm)
+
+	select {
+	case response, ok := <-ch:
+		assert.True(t, ok)
+		assert.True(t, response)
+	default:
+		t.Error("the result should be buffered before it is received")
+	}
+	_, ok := <-ch
+	assert.False(t, ok, "the channel is closed after the single result")
+}
+
+func TestFileOpenAsync_Cancel(t *testing.T) {
+	win := test.NewTempWindow(t, widget.NewLabel("Content"))
+
+	ch := FileOpenAsync(win)
+	popup := win.Canvas().Overlays().Top()
+	require.NotNil(t, popup)
+
+	cancel := findAsyncButton(popup, "Cancel")
+	require.NotNil(t, cancel)
+	test.Tap(cancel)
+
+	result := <-ch
+	assert.Nil(t, result.Reader)
+	assert.NoError(t, result.Err)
+}
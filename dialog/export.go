@@ -0,0 +1,69 @@
+package dialog
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+)
+
+var exportExtensions = []string{".csv", ".tsv", ".json", ".xlsx"}
+
+// ShowTableExport shows a file save dialog that writes the data of the given
+// table to the chosen file, picking the export format from its extension.
+//
+// Since: 2.6
+func ShowTableExport(table *widget.Table, parent fyne.Window) {
+	showExport(parent, "table.csv", func(format widget.ExportFormat, w fyne.URIWriteCloser) error {
+		return widget.ExportTable(table, format, w)
+	})
+}
+
+// ShowListExport shows a file save dialog that writes the data of the given
+// list to the chosen file, picking the export format from its extension.
+//
+// Since: 2.6
+func ShowListExport(list *widget.List, parent fyne.Window) {
+	showExport(parent, "list.csv", func(format widget.ExportFormat, w fyne.URIWriteCloser) error {
+		return widget.ExportList(list, format, w)
+	})
+}
+
+// NewTableExportMenuItem returns an "Export…" menu item that shows the table
+// export save dialog, for use in application or context menus.
+//
+// Since: 2.6
+func NewTableExportMenuItem(table *widget.Table, parent fyne.Window) *fyne.MenuItem {
+	return fyne.NewMenuItem("Export…", func() {
+		ShowTableExport(table, parent)
+	})
+}
+
+// NewListExportMenuItem returns an "Export…" menu item that shows the list
+// export save dialog, for use in application or context menus.
+//
+// Since: 2.6
+func NewListExportMenuItem(list *widget.List, parent fyne.Window) *fyne.MenuItem {
+	return fyne.NewMenuItem("Export…", func() {
+		ShowListExport(list, parent)
+	})
+}
+
+func showExport(parent fyne.Window, fileName string, write func(widget.ExportFormat, fyne.URIWriteCloser) error) {
+	d := NewFileSave(func(w fyne.URIWriteCloser, err error) {
+		if err != nil {
+			ShowError(err, parent)
+			return
+		}
+		if w == nil {
+			return // cancelled
+		}
+		defer w.Close()
+
+		if err := write(widget.ExportFormatForName(w.URI().Name()), w); err != nil {
+			ShowError(err, parent)
+		}
+	}, parent)
+	d.SetFileName(fileName)
+	d.SetFilter(storage.NewExtensionFileFilter(exportExtensions))
+	d.Show()
+}
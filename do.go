@@ -0,0 +1,43 @@
+package fyne
+
+// DoWithProgress runs fn on a new goroutine so that slow work does not block the
+// user interface, returning immediately. The progress function passed to fn reports
+// fractional completion in the range 0.0 to 1.0 and may be called from any goroutine.
+//
+// Progress reports are forwarded to onProgress and, when fn returns, onDone is called
+// with any error it returned. The two callbacks are marshalled onto a single goroutine
+// so they never run concurrently with each other, making it safe to drive a
+// ProgressBar, a bound value or a dialog from them without further synchronisation.
+// Either callback may be nil. The progress function must not be called after fn returns.
+//
+// Since: 2.6
+func DoWithProgress(fn func(progress func(float64)) error, onProgress func(float64), onDone func(error)) {
+	events := make(chan func(), 16)
+	go func() {
+		for f := range events {
+			f()
+		}
+	}()
+
+	go func() {
+		report := func(p float64) {
+			if onProgress == nil {
+				return
+			}
+			if p < 0 {
+				p = 0
+			} else if p > 1 {
+				p = 1
+			}
+			events <- func() { onProgress(p) }
+		}
+
+		err := fn(report)
+		events <- func() {
+			if onDone != nil {
+				onDone(err)
+			}
+		}
+		close(events)
+	}()
+}
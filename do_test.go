@@ -0,0 +1,51 @@
+package fyne
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoWithProgress(t *testing.T) {
+	var reported []float64
+	done := make(chan error, 1)
+
+	DoWithProgress(func(progress func(float64)) error {
+		progress(0.25)
+		progress(0.5)
+		progress(2.0) // clamped to 1.0
+		return nil
+	}, func(p float64) {
+		reported = append(reported, p)
+	}, func(err error) {
+		done <- err
+	})
+
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for completion")
+	}
+	assert.Equal(t, []float64{0.25, 0.5, 1.0}, reported)
+}
+
+func TestDoWithProgress_Error(t *testing.T) {
+	wrong := errors.New("failed")
+	done := make(chan error, 1)
+
+	DoWithProgress(func(progress func(float64)) error {
+		return wrong
+	}, nil, func(err error) {
+		done <- err
+	})
+
+	select {
+	case err := <-done:
+		assert.Equal(t, wrong, err)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for completion")
+	}
+}
@@ -0,0 +1,73 @@
+package fyne
+
+// DragPayload describes the data carried by an in-progress drag from a drag
+// source widget, such as a list row being dragged out. At least one of the
+// fields should be set by the source.
+//
+// Since: 2.6
+type DragPayload struct {
+	// Text is a plain text representation of the dragged data.
+	Text string
+	// URI optionally identifies a resource that the dragged data refers to.
+	URI URI
+	// Data optionally carries an application defined value for in-process drops.
+	Data any
+
+	// Preview optionally replaces the default drag preview that follows the
+	// pointer while this payload is dragged, for example a snapshot of the
+	// dragged row or a stack with a count badge when several items are carried.
+	//
+	// Since: 2.6
+	Preview CanvasObject
+}
+
+// DragEffect describes what a drop of the payload being dragged would do on
+// the target currently under the pointer. The effect is reflected as a badge
+// on the drag preview so the user can see whether a drop would copy, move or
+// link the data, or not be accepted at all.
+//
+// Since: 2.6
+type DragEffect int
+
+const (
+	// DragEffectNone indicates that there is no target under the pointer that
+	// would accept the payload.
+	DragEffectNone DragEffect = iota
+	// DragEffectMove indicates that the data would be moved to the target.
+	// This is assumed for targets that do not implement DragEffecter.
+	DragEffectMove
+	// DragEffectCopy indicates that the data would be copied to the target.
+	DragEffectCopy
+	// DragEffectLink indicates that the target would keep a reference to the
+	// dragged data rather than taking a copy of it.
+	DragEffectLink
+)
+
+// DropTarget is implemented by canvas objects that can accept data dragged from
+// a drag source widget within this application.
+//
+// Since: 2.6
+type DropTarget interface {
+	CanvasObject
+
+	// AcceptsDragPayload returns whether a drop of the given payload would be accepted,
+	// allowing targets to filter the types of data they handle.
+	AcceptsDragPayload(*DragPayload) bool
+	// DragPayloadDropped is called when a drag ends over this target, with the drop
+	// position relative to the target.
+	DragPayloadDropped(Position, *DragPayload)
+}
+
+// DragEffecter is implemented by DropTarget widgets that distinguish what a
+// drop of a payload would do, such as copying instead of moving the data.
+// Targets that accept a payload without implementing this interface are
+// assumed to move it.
+//
+// Since: 2.6
+type DragEffecter interface {
+	DropTarget
+
+	// DragEffect returns the effect a drop of the payload at the given position,
+	// relative to the target, would have.
+	DragEffect(Position, *DragPayload) DragEffect
+}
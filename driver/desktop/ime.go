@@ -0,0 +1,15 @@
+package desktop
+
+import "fyne.io/fyne/v2"
+
+// InputMethodHandler describes a driver that can position the popup windows of
+// an input method editor (IME), such as the candidate list shown while
+// assembling CJK text. Widgets that accept composition text should report the
+// position of their caret whenever it moves so the windows can follow it.
+//
+// Since: 2.6
+type InputMethodHandler interface {
+	// SetInputMethodPosition tells the input method where the text caret of
+	// the focused widget is, relative to the top/left of the given canvas.
+	SetInputMethodPosition(canvas fyne.Canvas, pos fyne.Position)
+}
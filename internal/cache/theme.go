@@ -10,6 +10,7 @@ import (
 
 var (
 	overrides     = &sync.Map{} // map[fyne.Widget]*overrideScope
+	scopeRoots    = &sync.Map{} // map[fyne.CanvasObject]*overrideScope
 	overrideCount = atomic.Uint32{}
 )
 
@@ -29,6 +30,16 @@ func OverrideTheme(o fyne.CanvasObject, th fyne.Theme) {
 	overrideTheme(o, s)
 }
 
+// OverrideThemeScoped applies th to o like OverrideTheme, additionally marking o
+// as the root of a theme scope. Enclosing overrides will not descend into a scope
+// root, so nested scopes keep their own theme when an outer scope re-applies.
+func OverrideThemeScoped(o fyne.CanvasObject, th fyne.Theme) {
+	id := overrideCount.Add(1)
+	s := &overrideScope{th: th, cacheID: strconv.Itoa(int(id))}
+	scopeRoots.Store(o, s)
+	overrideTheme(o, s)
+}
+
 func OverrideThemeMatchingScope(o, parent fyne.CanvasObject) bool {
 	data, ok := overrides.Load(parent)
 	if !ok { // not overridden in parent
@@ -65,6 +76,10 @@ func overrideContainer(c *fyne.Container, s *overrideScope) {
 }
 
 func overrideTheme(o fyne.CanvasObject, s *overrideScope) {
+	if data, ok := scopeRoots.Load(o); ok && data.(*overrideScope) != s {
+		return // a nested theme scope keeps its own theme
+	}
+
 	switch c := o.(type) {
 	case fyne.Widget:
 		overrideWidget(c, s)
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
@@ -14,8 +15,12 @@ import (
 	"fyne.io/fyne/v2/internal/cache"
 	"fyne.io/fyne/v2/internal/driver"
 	"fyne.io/fyne/v2/internal/painter/gl"
+	"fyne.io/fyne/v2/metrics"
 )
 
+// firstLayoutDone tracks whether the first canvas layout pass has been timed.
+var firstLayoutDone atomic.Bool
+
 // SizeableCanvas defines a canvas with size related functions.
 type SizeableCanvas interface {
 	fyne.Canvas
@@ -87,6 +92,11 @@ func (c *Canvas) EnsureMinSize() bool {
 	if c.impl.Content() == nil {
 		return false
 	}
+	if !firstLayoutDone.Swap(true) {
+		defer func(start time.Time) {
+			metrics.RecordPhase(metrics.PhaseFirstLayout, time.Since(start))
+		}(time.Now())
+	}
 	windowNeedsMinSizeUpdate := false
 	csize := c.impl.Size()
 	min := c.impl.MinSize()
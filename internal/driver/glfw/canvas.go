@@ -10,6 +10,7 @@ import (
 	"fyne.io/fyne/v2/internal"
 	"fyne.io/fyne/v2/internal/app"
 	"fyne.io/fyne/v2/internal/build"
+	"fyne.io/fyne/v2/internal/cache"
 	"fyne.io/fyne/v2/internal/driver"
 	"fyne.io/fyne/v2/internal/driver/common"
 	"fyne.io/fyne/v2/theme"
@@ -205,7 +206,17 @@ func (c *glCanvas) reloadScale() {
 
 	c.Lock()
 	c.scale = w.calculatedScale()
+	newScale := c.scale
 	c.Unlock()
+
+	// rasterized vectors and measured text are stale at the new pixel density
+	cache.ResetThemeCaches()
+	c.WalkTrees(func(node *common.RenderCacheNode, _ fyne.Position) {
+		if s, ok := node.Obj().(fyne.ScaleChangeable); ok {
+			s.ScaleChanged(newScale)
+		}
+	}, nil)
+
 	c.SetDirty()
 
 	c.context.RescaleContext()
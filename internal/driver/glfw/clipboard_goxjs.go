@@ -1,4 +1,4 @@
-//go:build wasm || test_web_driver
+//go:build test_web_driver
 
 package glfw
 
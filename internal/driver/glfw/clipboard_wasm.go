@@ -0,0 +1,71 @@
+//go:build wasm
+
+package glfw
+
+import (
+	"syscall/js"
+
+	"fyne.io/fyne/v2"
+)
+
+// Declare conformity with Clipboard interface
+var _ fyne.Clipboard = (*clipboard)(nil)
+
+// clipboard represents the system clipboard, backed by the asynchronous browser
+// Clipboard API. Reads and writes are bridged through a cached value because the
+// fyne.Clipboard interface is synchronous - a read kicks off an async request and
+// returns the most recently known content, which is kept up to date by earlier
+// reads, writes from this app and the resolution of previous requests.
+type clipboard struct{}
+
+// clipboardContent is shared across instances as the driver creates a fresh
+// clipboard handle per request.
+var clipboardContent string
+
+// Content returns the clipboard content
+func (c *clipboard) Content() string {
+	clip := js.Global().Get("navigator").Get("clipboard")
+	if clip.IsUndefined() {
+		return clipboardContent
+	}
+
+	settlePromise(clip.Call("readText"), func(v js.Value) {
+		clipboardContent = v.String()
+	})
+	return clipboardContent
+}
+
+// SetContent sets the clipboard content
+func (c *clipboard) SetContent(content string) {
+	clipboardContent = content
+
+	clip := js.Global().Get("navigator").Get("clipboard")
+	if clip.IsUndefined() {
+		fyne.LogError("Clipboard API is not available in this browser", nil)
+		return
+	}
+	settlePromise(clip.Call("writeText", content), nil)
+}
+
+// settlePromise attaches handlers to a JavaScript promise, invoking onSuccess with
+// the resolved value and releasing the callbacks once the promise settles either way.
+// Rejections (such as denied clipboard permission) are silently ignored.
+func settlePromise(promise js.Value, onSuccess func(js.Value)) {
+	var success, failure js.Func
+	release := func() {
+		success.Release()
+		failure.Release()
+	}
+	success = js.FuncOf(func(_ js.Value, args []js.Value) any {
+		if onSuccess != nil && len(args) > 0 {
+			onSuccess(args[0])
+		}
+		release()
+		return nil
+	})
+	failure = js.FuncOf(func(_ js.Value, _ []js.Value) any {
+		release()
+		return nil
+	})
+	promise.Call("then", success, failure)
+}
@@ -12,6 +12,7 @@ import (
 	"fyne.io/fyne/v2/internal/driver/common"
 	"fyne.io/fyne/v2/internal/painter"
 	"fyne.io/fyne/v2/internal/scale"
+	"fyne.io/fyne/v2/metrics"
 )
 
 type funcData struct {
@@ -110,7 +111,9 @@ func (d *gLDriver) runGL() {
 	}
 	close(d.waitForStart) // Signal that execution can continue.
 
+	initStart := time.Now()
 	d.initGLFW()
+	metrics.RecordPhase(metrics.PhaseDriverInit, time.Since(initStart))
 	if d.trayStart != nil {
 		d.trayStart()
 	}
@@ -224,6 +227,7 @@ func (d *gLDriver) repaintWindow(w *window) {
 
 		if view != nil && visible {
 			view.SwapBuffers()
+			metrics.MarkFirstFrame()
 		}
 	})
 }
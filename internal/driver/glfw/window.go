@@ -18,6 +18,7 @@ import (
 	"fyne.io/fyne/v2/internal/driver"
 	"fyne.io/fyne/v2/internal/driver/common"
 	"fyne.io/fyne/v2/internal/scale"
+	"fyne.io/fyne/v2/metrics"
 )
 
 const (
@@ -119,6 +120,10 @@ func (w *window) SetCloseIntercept(callback func()) {
 	w.onCloseIntercepted = callback
 }
 
+func (w *window) SetCloseGuard(guard func() fyne.CloseDecision) {
+	w.closeGuard = guard
+}
+
 func (w *window) calculatedScale() float32 {
 	return calculateScale(userScale(), fyne.CurrentDevice().SystemScaleForWindow(w), w.detectScale())
 }
@@ -170,6 +175,7 @@ func (w *window) doShow() {
 				w.SetFullScreen(true)
 			}()
 		}
+		metrics.MarkWindowShown()
 	})
 
 	// show top canvas element
@@ -267,6 +273,16 @@ func (w *window) Canvas() fyne.Canvas {
 }
 
 func (w *window) processClosed() {
+	if w.closeGuard != nil {
+		w.QueueEvent(func() {
+			if w.closeGuard() == fyne.CloseAllow {
+				w.Close()
+			}
+			// CloseCancel and CloseDefer keep the window open, a deferred
+			// guard calls Close() itself once the user has decided.
+		})
+		return
+	}
 	if w.onCloseIntercepted != nil {
 		w.QueueEvent(w.onCloseIntercepted)
 		return
@@ -1023,6 +1039,7 @@ func (w *window) doShowAgain() {
 		w.viewLock.Lock()
 		w.visible = true
 		w.viewLock.Unlock()
+		metrics.MarkWindowShown()
 	})
 }
 
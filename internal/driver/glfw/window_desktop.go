@@ -99,6 +99,7 @@ type window struct {
 
 	onClosed           func()
 	onCloseIntercepted func()
+	closeGuard         func() fyne.CloseDecision
 
 	menuTogglePending       fyne.KeyName
 	menuDeactivationPending fyne.KeyName
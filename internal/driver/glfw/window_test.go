@@ -1738,6 +1738,77 @@ func TestWindow_CloseInterception(t *testing.T) {
 	})
 }
 
+func TestWindow_CloseGuard(t *testing.T) {
+	d := NewGLDriver()
+	t.Run("when the guard cancels the close", func(t *testing.T) {
+		w := d.CreateWindow("test").(*window)
+		w.create()
+		guarded := false
+		w.SetCloseGuard(func() fyne.CloseDecision {
+			guarded = true
+			return fyne.CloseCancel
+		})
+		closed := make(chan bool, 1)
+		w.SetOnClosed(func() { closed <- true })
+		w.closed(w.viewport)
+		w.WaitForEvents()
+		assert.True(t, guarded, "the close guard should have been called")
+		select {
+		case <-closed:
+			t.Error("window was unexpectedly closed")
+		case <-time.After(20 * time.Millisecond):
+			// hopefully enough time to let an unexpected asynchronous Close() finish.
+		}
+		w.destroy(d)
+	})
+
+	t.Run("when the guard allows the close", func(t *testing.T) {
+		w := d.CreateWindow("test").(*window)
+		w.create()
+		w.SetCloseGuard(func() fyne.CloseDecision { return fyne.CloseAllow })
+		closed := make(chan bool, 1)
+		w.SetOnClosed(func() { closed <- true })
+		w.closed(w.viewport)
+		w.WaitForEvents()
+		select {
+		case <-closed:
+		case <-time.After(20 * time.Millisecond):
+			t.Error("window was not closed")
+		}
+		w.destroy(d)
+	})
+
+	t.Run("when the guard defers the decision", func(t *testing.T) {
+		w := d.CreateWindow("test").(*window)
+		w.create()
+		decide := make(chan bool, 1)
+		w.SetCloseGuard(func() fyne.CloseDecision {
+			go func() {
+				<-decide
+				w.Close()
+			}()
+			return fyne.CloseDefer
+		})
+		closed := make(chan bool, 1)
+		w.SetOnClosed(func() { closed <- true })
+		w.closed(w.viewport)
+		w.WaitForEvents()
+		select {
+		case <-closed:
+			t.Error("window closed before the deferred decision")
+		case <-time.After(20 * time.Millisecond):
+		}
+		decide <- true
+		w.WaitForEvents()
+		select {
+		case <-closed:
+		case <-time.After(20 * time.Millisecond):
+			t.Error("window was not closed after the deferred decision")
+		}
+		w.destroy(d)
+	})
+}
+
 func TestWindow_ClosedBeforeShow(t *testing.T) {
 	w := createWindow("Test").(*window)
 	// viewport will be nil if window is closed before show
@@ -26,6 +26,7 @@ import (
 	"fyne.io/fyne/v2/internal/painter"
 	pgl "fyne.io/fyne/v2/internal/painter/gl"
 	"fyne.io/fyne/v2/internal/scale"
+	"fyne.io/fyne/v2/metrics"
 	"fyne.io/fyne/v2/theme"
 )
 
@@ -342,6 +343,7 @@ func (d *driver) paintWindow(window fyne.Window, size fyne.Size) {
 	}
 
 	c.WalkTrees(draw, afterDraw)
+	metrics.MarkFirstFrame()
 }
 
 func (d *driver) sendPaintEvent() {
@@ -6,6 +6,7 @@ import (
 	"fyne.io/fyne/v2/internal/cache"
 	"fyne.io/fyne/v2/internal/driver/common"
 	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/metrics"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 )
@@ -17,6 +18,7 @@ type window struct {
 	visible            bool
 	onClosed           func()
 	onCloseIntercepted func()
+	closeGuard         func() fyne.CloseDecision
 	isChild            bool
 
 	clipboard fyne.Clipboard
@@ -102,6 +104,10 @@ func (w *window) SetCloseIntercept(callback func()) {
 	w.onCloseIntercepted = callback
 }
 
+func (w *window) SetCloseGuard(guard func() fyne.CloseDecision) {
+	w.closeGuard = guard
+}
+
 func (w *window) SetOnDropped(dropped func(fyne.Position, []fyne.URI)) {
 	// not implemented yet
 }
@@ -126,6 +132,7 @@ func (w *window) Show() {
 		w.canvas.setWindowHead(container.NewHBox(menuButton))
 	}
 	w.visible = true
+	metrics.MarkWindowShown()
 
 	if w.Content() != nil {
 		w.Content().Refresh()
@@ -142,6 +149,14 @@ func (w *window) Hide() {
 }
 
 func (w *window) tryClose() {
+	if w.closeGuard != nil {
+		w.QueueEvent(func() {
+			if w.closeGuard() == fyne.CloseAllow {
+				w.Close()
+			}
+		})
+		return
+	}
 	if w.onCloseIntercepted != nil {
 		w.QueueEvent(w.onCloseIntercepted)
 		return
@@ -54,6 +54,10 @@ func FindObjectAtPositionMatching(mouse fyne.Position, matches func(object fyne.
 			return false
 		}
 
+		if h, ok := walked.(fyne.HitTestable); ok && !h.HitTest(fyne.NewPos(mouse.X-pos.X, mouse.Y-pos.Y)) {
+			return false
+		}
+
 		if matches(walked) {
 			found = walked
 			foundPos = fyne.NewPos(mouse.X-pos.X, mouse.Y-pos.Y)
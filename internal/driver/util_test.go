@@ -544,3 +544,32 @@ func (s *scrollable) Size() fyne.Size {
 func (s *scrollable) Visible() bool {
 	return true
 }
+
+type hitTestTree struct {
+	objectTree
+
+	hit func(fyne.Position) bool
+}
+
+func (h *hitTestTree) HitTest(pos fyne.Position) bool {
+	return h.hit(pos)
+}
+
+func TestFindObjectAtPositionMatching_HitTestable(t *testing.T) {
+	background := &objectTree{pos: fyne.NewPos(0, 0), size: fyne.NewSize(100, 100)}
+	round := &hitTestTree{objectTree: objectTree{pos: fyne.NewPos(10, 10), size: fyne.NewSize(20, 20)}}
+	round.hit = func(pos fyne.Position) bool { // a circular hit area centred in the object
+		dx, dy := pos.X-10, pos.Y-10
+		return dx*dx+dy*dy <= 10*10
+	}
+	root := &objectTree{children: []fyne.CanvasObject{background, round}, size: fyne.NewSize(100, 100)}
+	matchAll := func(fyne.CanvasObject) bool { return true }
+
+	o, p, _ := driver.FindObjectAtPositionMatching(fyne.NewPos(20, 20), matchAll, nil, root)
+	assert.Equal(t, round, o, "the centre of the circle is interactive")
+	assert.Equal(t, fyne.NewPos(10, 10), p)
+
+	o, p, _ = driver.FindObjectAtPositionMatching(fyne.NewPos(11, 11), matchAll, nil, root)
+	assert.Equal(t, background, o, "the corner of the bounding box falls through to the object beneath")
+	assert.Equal(t, fyne.NewPos(11, 11), p)
+}
@@ -0,0 +1,137 @@
+// Package exif provides minimal EXIF metadata handling for image loading.
+// Only the orientation tag is parsed, which is enough to display photographs
+// from phones and cameras the right way up.
+package exif
+
+import (
+	"encoding/binary"
+	"image"
+	"io"
+)
+
+const orientationTag = 0x0112
+
+// Orientation returns the EXIF orientation (1-8) of a JPEG data stream.
+// It returns 1, the upright orientation, for other formats or if no orientation
+// metadata is present.
+func Orientation(r io.Reader) int {
+	var soi [2]byte
+	if _, err := io.ReadFull(r, soi[:]); err != nil || soi[0] != 0xFF || soi[1] != 0xD8 {
+		return 1
+	}
+
+	for {
+		var marker [2]byte
+		if _, err := io.ReadFull(r, marker[:]); err != nil || marker[0] != 0xFF {
+			return 1
+		}
+		if marker[1] == 0xD9 || marker[1] == 0xDA { // end of image or start of scan
+			return 1
+		}
+
+		var lenBytes [2]byte
+		if _, err := io.ReadFull(r, lenBytes[:]); err != nil {
+			return 1
+		}
+		segLen := int(binary.BigEndian.Uint16(lenBytes[:])) - 2
+		if segLen < 0 {
+			return 1
+		}
+
+		if marker[1] == 0xE1 { // APP1 carries the EXIF payload
+			data := make([]byte, segLen)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return 1
+			}
+			return orientationFromEXIF(data)
+		}
+		if _, err := io.CopyN(io.Discard, r, int64(segLen)); err != nil {
+			return 1
+		}
+	}
+}
+
+// orientationFromEXIF extracts the orientation tag from an APP1 EXIF payload,
+// which contains a TIFF structure after the identifying header.
+func orientationFromEXIF(data []byte) int {
+	if len(data) < 14 || string(data[:6]) != "Exif\x00\x00" {
+		return 1
+	}
+
+	tiff := data[6:]
+	var order binary.ByteOrder
+	switch {
+	case tiff[0] == 'I' && tiff[1] == 'I':
+		order = binary.LittleEndian
+	case tiff[0] == 'M' && tiff[1] == 'M':
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	if order.Uint16(tiff[2:4]) != 42 {
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset < 0 || offset+2 > len(tiff) {
+		return 1
+	}
+
+	count := int(order.Uint16(tiff[offset : offset+2]))
+	entry := offset + 2
+	for i := 0; i < count; i++ {
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:entry+2]) == orientationTag {
+			value := int(order.Uint16(tiff[entry+8 : entry+10]))
+			if value >= 1 && value <= 8 {
+				return value
+			}
+			return 1
+		}
+		entry += 12
+	}
+	return 1
+}
+
+// Apply returns a copy of the image transformed so that a renderer unaware of EXIF
+// metadata displays it upright. The original image is returned unchanged for the
+// upright orientation or unknown values.
+func Apply(src image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return src
+	}
+
+	bounds := src.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	dw, dh := w, h
+	if orientation >= 5 { // transposed orientations swap the axes
+		dw, dh = h, w
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // mirrored horizontally
+				dx, dy = w-1-x, y
+			case 3: // rotated 180
+				dx, dy = w-1-x, h-1-y
+			case 4: // mirrored vertically
+				dx, dy = x, h-1-y
+			case 5: // mirrored and rotated 270 clockwise
+				dx, dy = y, x
+			case 6: // rotated 90 clockwise
+				dx, dy = h-1-y, x
+			case 7: // mirrored and rotated 90 clockwise
+				dx, dy = h-1-y, w-1-x
+			case 8: // rotated 270 clockwise
+				dx, dy = y, w-1-x
+			}
+			dst.Set(dx, dy, src.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+	return dst
+}
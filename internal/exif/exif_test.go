@@ -0,0 +1,58 @@
+package exif
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// jpegWithOrientation builds a minimal JPEG header carrying an EXIF orientation tag.
+func jpegWithOrientation(orientation byte) []byte {
+	exif := []byte{
+		'E', 'x', 'i', 'f', 0, 0,
+		'I', 'I', 42, 0, // little-endian TIFF header
+		8, 0, 0, 0, // IFD offset
+		1, 0, // entry count
+		0x12, 0x01, // orientation tag
+		3, 0, // SHORT type
+		1, 0, 0, 0, // value count
+		orientation, 0, 0, 0,
+	}
+
+	var buf bytes.Buffer
+	buf.Write([]byte{0xFF, 0xD8, 0xFF, 0xE1})
+	buf.Write([]byte{byte((len(exif) + 2) >> 8), byte(len(exif) + 2)})
+	buf.Write(exif)
+	buf.Write([]byte{0xFF, 0xD9})
+	return buf.Bytes()
+}
+
+func TestOrientation(t *testing.T) {
+	assert.Equal(t, 6, Orientation(bytes.NewReader(jpegWithOrientation(6))))
+	assert.Equal(t, 1, Orientation(bytes.NewReader(jpegWithOrientation(1))))
+	assert.Equal(t, 1, Orientation(bytes.NewReader([]byte{0xFF, 0xD8, 0xFF, 0xD9})))
+	assert.Equal(t, 1, Orientation(bytes.NewReader([]byte("not a JPEG"))))
+}
+
+func TestApply(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	red := color.NRGBA{R: 0xFF, A: 0xFF}
+	blue := color.NRGBA{B: 0xFF, A: 0xFF}
+	src.Set(0, 0, red)
+	src.Set(1, 0, blue)
+
+	rotated := Apply(src, 6) // 90 clockwise
+	assert.Equal(t, 1, rotated.Bounds().Dx())
+	assert.Equal(t, 2, rotated.Bounds().Dy())
+	assert.Equal(t, red, rotated.At(0, 0))
+	assert.Equal(t, blue, rotated.At(0, 1))
+
+	flipped := Apply(src, 2) // mirrored horizontally
+	assert.Equal(t, blue, flipped.At(0, 0))
+	assert.Equal(t, red, flipped.At(1, 0))
+
+	assert.Equal(t, src, Apply(src, 1))
+}
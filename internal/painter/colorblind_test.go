@@ -0,0 +1,30 @@
+package painter_test
+
+import (
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2/internal/painter"
+)
+
+func TestSimulateColorBlindness(t *testing.T) {
+	newRedPixel := func() *image.NRGBA {
+		img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+		copy(img.Pix, []uint8{0xff, 0x00, 0x00, 0xff})
+		return img
+	}
+
+	img := newRedPixel()
+	painter.SimulateColorBlindness(img)
+	assert.Equal(t, []uint8{0xff, 0x00, 0x00, 0xff}, img.Pix, "no simulation leaves pixels unchanged")
+
+	painter.SetColorBlindness(painter.ColorBlindnessProtanopia)
+	defer painter.SetColorBlindness(painter.ColorBlindnessNone)
+	assert.Equal(t, painter.ColorBlindnessProtanopia, painter.SimulatedColorBlindness())
+
+	img = newRedPixel()
+	painter.SimulateColorBlindness(img)
+	assert.Equal(t, []uint8{0x90, 0x8e, 0x00, 0xff}, img.Pix, "pure red loses its red channel dominance")
+}
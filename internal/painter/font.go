@@ -7,6 +7,7 @@ import (
 	"math"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/go-text/render"
 	"github.com/go-text/typesetting/di"
@@ -20,6 +21,7 @@ import (
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/internal/cache"
 	"fyne.io/fyne/v2/lang"
+	"fyne.io/fyne/v2/metrics"
 	"fyne.io/fyne/v2/theme"
 )
 
@@ -193,11 +195,13 @@ func DrawString(dst draw.Image, s string, color color.Color, f shaping.Fontmap,
 }
 
 func loadMeasureFont(data fyne.Resource) font.Face {
+	start := time.Now()
 	loaded, err := font.ParseTTF(bytes.NewReader(data.Content()))
 	if err != nil {
 		fyne.LogError("font load error", err)
 		return nil
 	}
+	metrics.RecordPhase(metrics.PhaseFontLoad, time.Since(start))
 
 	return loaded
 }
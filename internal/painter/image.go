@@ -2,10 +2,12 @@ package painter
 
 import (
 	"image"
-	_ "image/jpeg" // avoid users having to import when using image widget
+	_ "image/gif"  // avoid users having to import when using image widget
+	_ "image/jpeg" // avoid the same for JPEG images
 	_ "image/png"  // avoid the same for PNG images
 
 	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // avoid the same for WebP images
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
@@ -6,6 +6,7 @@ import (
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/internal/driver"
+	"fyne.io/fyne/v2/internal/painter"
 	"fyne.io/fyne/v2/internal/scale"
 )
 
@@ -59,5 +60,6 @@ func (*Painter) Paint(c fyne.Canvas) image.Image {
 		driver.WalkVisibleObjectTree(o, paint, nil)
 	}
 
+	painter.SimulateColorBlindness(base)
 	return base
 }
@@ -40,6 +40,52 @@ func Colorize(src []byte, clr color.Color) []byte {
 	return colorized
 }
 
+// Compose creates a new SVG by drawing the elements of the overlay SVG over
+// the base one. The overlay is scaled to size times the base viewBox and its
+// top left corner placed at x, y, both given as fractions of the base viewBox.
+func Compose(base, overlay []byte, x, y, size float64) []byte {
+	b, err := svgFromXML(bytes.NewReader(base))
+	if err != nil {
+		fyne.LogError("could not load base SVG, falling back to static content:", err)
+		return base
+	}
+	o, err := svgFromXML(bytes.NewReader(overlay))
+	if err != nil {
+		fyne.LogError("could not load overlay SVG, falling back to static content:", err)
+		return base
+	}
+
+	baseW, baseH := viewBoxSize(b)
+	overW, overH := viewBoxSize(o)
+	if baseW == 0 || baseH == 0 || overW == 0 || overH == 0 {
+		fyne.LogError("could not compose SVG, falling back to static content:",
+			errors.New("missing viewBox or size information"))
+		return base
+	}
+
+	scale := size * baseW / overW
+	if fit := size * baseH / overH; fit < scale { // keep the overlay aspect ratio
+		scale = fit
+	}
+	b.Groups = append(b.Groups, &objGroup{
+		Transform: fmt.Sprintf("translate(%s %s) scale(%s)",
+			formatFloat(x*baseW), formatFloat(y*baseH), formatFloat(scale)),
+		Paths:    o.Paths,
+		Circles:  o.Circles,
+		Ellipses: o.Ellipses,
+		Rects:    o.Rects,
+		Polygons: o.Polygons,
+		Groups:   o.Groups,
+	})
+
+	composed, err := xml.Marshal(b)
+	if err != nil {
+		fyne.LogError("could not marshal svg, falling back to static content:", err)
+		return base
+	}
+	return composed
+}
+
 type Decoder struct {
 	icon *oksvg.SvgIcon
 }
@@ -201,6 +247,7 @@ type polygonObj struct {
 type objGroup struct {
 	XMLName         xml.Name      `xml:"g"`
 	ID              string        `xml:"id,attr,omitempty"`
+	Transform       string        `xml:"transform,attr,omitempty"`
 	Fill            string        `xml:"fill,attr,omitempty"`
 	Stroke          string        `xml:"stroke,attr,omitempty"`
 	StrokeWidth     string        `xml:"stroke-width,attr,omitempty"`
@@ -298,6 +345,26 @@ func svgFromXML(reader io.Reader) (*svg, error) {
 	return &s, nil
 }
 
+// viewBoxSize returns the dimensions of the viewBox of s, falling back to its
+// width and height attributes when no viewBox is declared.
+func viewBoxSize(s *svg) (w, h float64) {
+	if fields := strings.Fields(s.ViewBox); len(fields) == 4 {
+		w, _ = strconv.ParseFloat(fields[2], 64)
+		h, _ = strconv.ParseFloat(fields[3], 64)
+		if w > 0 && h > 0 {
+			return w, h
+		}
+	}
+
+	w, _ = strconv.ParseFloat(strings.TrimSuffix(s.Width, "px"), 64)
+	h, _ = strconv.ParseFloat(strings.TrimSuffix(s.Height, "px"), 64)
+	return w, h
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
 func colorToHexAndOpacity(color color.Color) (hexStr, aStr string) {
 	r, g, b, a := col.ToNRGBA(color)
 	cBytes := []byte{byte(r), byte(g), byte(b)}
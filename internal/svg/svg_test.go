@@ -202,3 +202,31 @@ func helperDrawSVG(t *testing.T, data []byte) image.Image {
 	icon.Draw(raster, 1)
 	return img
 }
+
+func TestCompose(t *testing.T) {
+	base, err := os.ReadFile("testdata/cancel_Paths.svg")
+	require.NoError(t, err)
+	overlay, err := os.ReadFile("testdata/circles.svg")
+	require.NoError(t, err)
+
+	composed := Compose(base, overlay, 0.5, 0.5, 0.5)
+	s, err := svgFromXML(bytes.NewReader(composed))
+	require.NoError(t, err)
+	require.NotEmpty(t, s.Groups)
+
+	group := s.Groups[len(s.Groups)-1]
+	assert.Equal(t, "translate(12 12) scale(0.5)", group.Transform)
+	assert.Len(t, group.Circles, 2)
+	assert.Len(t, s.Paths, 2, "the base elements are kept")
+
+	_, err = oksvg.ReadIconStream(bytes.NewReader(composed))
+	assert.NoError(t, err, "the composed icon is still a renderable SVG")
+}
+
+func TestCompose_Invalid(t *testing.T) {
+	base, err := os.ReadFile("testdata/cancel_Paths.svg")
+	require.NoError(t, err)
+
+	assert.Equal(t, base, Compose(base, []byte("not an svg"), 0, 0, 1))
+	assert.Equal(t, []byte("not an svg"), Compose([]byte("not an svg"), base, 0, 0, 1))
+}
@@ -0,0 +1,27 @@
+package virtualizer
+
+import (
+	"sync"
+
+	"fyne.io/fyne/v2"
+)
+
+// Pool caches the recycled item widgets of a collection, handing previously
+// released items back for reuse as the visible window moves over the content.
+type Pool struct {
+	sync.Pool
+}
+
+// Obtain returns an item from the pool for use, or nil when the pool is empty.
+func (p *Pool) Obtain() (item fyne.CanvasObject) {
+	o := p.Get()
+	if o != nil {
+		item = o.(fyne.CanvasObject)
+	}
+	return
+}
+
+// Release adds an item into the pool to be used later
+func (p *Pool) Release(item fyne.CanvasObject) {
+	p.Put(item)
+}
@@ -0,0 +1,83 @@
+// Package virtualizer holds the shared arithmetic of the virtualized
+// collection widgets. List, Table, Tree and GridWrap all show a window of a
+// much larger content through a scrolling viewport and recycle the widgets
+// representing the visible items; keeping the window calculations and object
+// pooling here gives the widgets one tested implementation to lean on instead
+// of subtly different copies of the same logic.
+package virtualizer
+
+import (
+	"math"
+	"sort"
+
+	"fyne.io/fyne/v2"
+)
+
+// UniformWindow returns the window of uniformly sized rows visible through a
+// viewport, for rows of one size laid out with pad between them. It returns
+// the first and last visible row, clamped to the content length, and the
+// content offset of the first returned row.
+func UniformWindow(offset, viewport, size, pad float32, length int) (minRow, maxRow int, offY float32) {
+	stride := size + pad
+	offY = float32(math.Floor(float64(offset/stride))) * stride
+	minRow = int(math.Floor(float64(offY / stride)))
+	maxRow = int(math.Ceil(float64((offY + viewport) / stride)))
+
+	if minRow > length-1 {
+		minRow = length - 1
+	}
+	if minRow < 0 {
+		minRow = 0
+		offY = 0
+	}
+	if maxRow > length-1 {
+		maxRow = length - 1
+	}
+	return minRow, maxRow, offY
+}
+
+// OverscanWindow is the variant of UniformWindow used by grids that keep one
+// extra row of items built beyond the viewport, so a small scroll reveals
+// rows that already exist. The returned maxRow may equal rows, marking a
+// window that ends in a partially filled final row.
+func OverscanWindow(offset, viewport, size, pad float32, rows int) (minRow, maxRow int, offY float32) {
+	stride := size + pad
+	count := int(math.Ceil(float64(viewport)/float64(stride))) + 1
+	offY = offset - float32(math.Mod(float64(offset), float64(stride)))
+	minRow = int(offY / stride)
+	maxRow = int(math.Min(float64(minRow+count), float64(rows)))
+	return minRow, maxRow, offY
+}
+
+// RowAtOffset returns the index of the row containing the given content
+// offset, using a binary search over cumulative row offsets. Entry i of
+// offsets holds the content offset of row i and the final entry the total
+// content height, as built for rows of varying heights.
+func RowAtOffset(offsets []float32, y float32) int {
+	rows := len(offsets) - 1
+	if rows <= 0 {
+		return 0
+	}
+
+	row := sort.Search(rows, func(i int) bool { return offsets[i+1] > y })
+	if row == rows {
+		row = rows - 1
+	}
+	return row
+}
+
+// AdjustLength grows or shrinks a slice of recycled canvas objects to the
+// wanted length, building missing entries with build. Excess objects are
+// dropped from the tail so the surviving entries keep their state.
+func AdjustLength(objects []fyne.CanvasObject, wanted int, build func() fyne.CanvasObject) []fyne.CanvasObject {
+	if wanted <= 0 {
+		return nil
+	}
+	if len(objects) > wanted {
+		return objects[:wanted]
+	}
+	for len(objects) < wanted {
+		objects = append(objects, build())
+	}
+	return objects
+}
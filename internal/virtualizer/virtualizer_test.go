@@ -0,0 +1,86 @@
+package virtualizer_test
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/virtualizer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUniformWindow(t *testing.T) {
+	// rows of height 20 with padding 5 give a stride of 25
+	minRow, maxRow, offY := virtualizer.UniformWindow(0, 100, 20, 5, 100)
+	assert.Equal(t, 0, minRow)
+	assert.Equal(t, 4, maxRow)
+	assert.Equal(t, float32(0), offY)
+
+	minRow, maxRow, offY = virtualizer.UniformWindow(30, 100, 20, 5, 100)
+	assert.Equal(t, 1, minRow, "the row under the viewport top is included")
+	assert.Equal(t, 5, maxRow)
+	assert.Equal(t, float32(25), offY, "the window is snapped to the row grid")
+
+	minRow, maxRow, offY = virtualizer.UniformWindow(1000, 100, 20, 5, 3)
+	assert.Equal(t, 2, minRow, "the window is clamped to the content length")
+	assert.Equal(t, 2, maxRow)
+
+	minRow, maxRow, offY = virtualizer.UniformWindow(-10, 100, 20, 5, 3)
+	assert.Equal(t, 0, minRow)
+	assert.Equal(t, float32(0), offY)
+}
+
+func TestOverscanWindow(t *testing.T) {
+	minRow, maxRow, offY := virtualizer.OverscanWindow(0, 100, 20, 5, 100)
+	assert.Equal(t, 0, minRow)
+	assert.Equal(t, 5, maxRow, "one extra row beyond the viewport is prepared")
+	assert.Equal(t, float32(0), offY)
+
+	minRow, maxRow, _ = virtualizer.OverscanWindow(60, 100, 20, 5, 100)
+	assert.Equal(t, 2, minRow)
+	assert.Equal(t, 7, maxRow)
+
+	_, maxRow, _ = virtualizer.OverscanWindow(0, 100, 20, 5, 3)
+	assert.Equal(t, 3, maxRow, "the window never extends past the content")
+}
+
+func TestRowAtOffset(t *testing.T) {
+	offsets := []float32{0, 25, 50, 75}
+
+	assert.Equal(t, 0, virtualizer.RowAtOffset(offsets, 0))
+	assert.Equal(t, 0, virtualizer.RowAtOffset(offsets, 24))
+	assert.Equal(t, 1, virtualizer.RowAtOffset(offsets, 25))
+	assert.Equal(t, 2, virtualizer.RowAtOffset(offsets, 100), "offsets past the content map to the last row")
+	assert.Equal(t, 0, virtualizer.RowAtOffset(nil, 10))
+}
+
+func TestAdjustLength(t *testing.T) {
+	build := func() fyne.CanvasObject {
+		return canvas.NewRectangle(color.Black)
+	}
+
+	objects := virtualizer.AdjustLength(nil, 3, build)
+	assert.Len(t, objects, 3)
+
+	kept := objects[0]
+	objects = virtualizer.AdjustLength(objects, 5, build)
+	assert.Len(t, objects, 5)
+	assert.Same(t, kept, objects[0], "existing entries are kept on grow")
+
+	objects = virtualizer.AdjustLength(objects, 2, build)
+	assert.Len(t, objects, 2)
+	assert.Same(t, kept, objects[0], "existing entries are kept on shrink")
+
+	assert.Nil(t, virtualizer.AdjustLength(objects, 0, build))
+}
+
+func TestPool(t *testing.T) {
+	pool := &virtualizer.Pool{}
+	assert.Nil(t, pool.Obtain(), "an empty pool returns nil")
+
+	item := canvas.NewRectangle(color.Black)
+	pool.Release(item)
+	assert.Same(t, item, pool.Obtain())
+}
@@ -5,6 +5,7 @@ import (
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/internal/cache"
+	"fyne.io/fyne/v2/internal/driver"
 	"fyne.io/fyne/v2/theme"
 )
 
@@ -408,6 +409,22 @@ type Scroll struct {
 	//
 	// Since: 2.0
 	OnScrolled func(fyne.Position) `json:"-"`
+	// OnDragEnd can be set to be notified when a drag scroll gesture on this container ends.
+	//
+	// Since: 2.6
+	OnDragEnd func() `json:"-"`
+	// OnDragged can be set to handle drag gestures on desktop, where dragging
+	// does not scroll. This allows widgets wrapping a Scroll to implement
+	// gestures such as band selection over the scrolled content.
+	//
+	// Since: 2.6
+	OnDragged func(*fyne.DragEvent) `json:"-"`
+	// DisableChaining stops scroll events this container cannot consume, because
+	// it has reached its end or does not scroll in that direction, from being
+	// passed on to an enclosing scroll container.
+	//
+	// Since: 2.6
+	DisableChaining bool
 }
 
 // CreateRenderer is a private method to Fyne which links this widget to its renderer
@@ -442,17 +459,30 @@ func (s *Scroll) ScrollToTop() {
 
 // DragEnd will stop scrolling on mobile has stopped
 func (s *Scroll) DragEnd() {
+	if f := s.OnDragEnd; f != nil {
+		f()
+	}
 }
 
 // Dragged will scroll on any drag - bar or otherwise - for mobile
 func (s *Scroll) Dragged(e *fyne.DragEvent) {
 	if !fyne.CurrentDevice().IsMobile() {
+		if f := s.OnDragged; f != nil {
+			f(e)
+		}
 		return
 	}
 
+	oldX, oldY := s.Offset.X, s.Offset.Y
 	if s.updateOffset(e.Dragged.DX, e.Dragged.DY) {
 		s.refreshWithoutOffsetUpdate()
 	}
+
+	dx := e.Dragged.DX + s.Offset.X - oldX
+	dy := e.Dragged.DY + s.Offset.Y - oldY
+	if !s.DisableChaining && (dx != 0 || dy != 0) {
+		s.chainScroll(dx, dy)
+	}
 }
 
 // MinSize returns the smallest size this widget can shrink to
@@ -498,9 +528,72 @@ func (s *Scroll) refreshWithoutOffsetUpdate() {
 
 // Scrolled is called when an input device triggers a scroll event
 func (s *Scroll) Scrolled(ev *fyne.ScrollEvent) {
+	dx, dy := ev.Scrolled.DX, ev.Scrolled.DY
 	if s.Direction != ScrollNone {
-		s.scrollBy(ev.Scrolled.DX, ev.Scrolled.DY)
+		min := s.Content.MinSize()
+		size := s.Size()
+		swapped := size.Width < min.Width && size.Height >= min.Height && dx == 0
+		oldX, oldY := s.Offset.X, s.Offset.Y
+		s.scrollBy(dx, dy)
+		if swapped { // scrollBy applied dy to the horizontal axis
+			dy += s.Offset.X - oldX
+			dx = 0
+		} else {
+			dx += s.Offset.X - oldX
+			dy += s.Offset.Y - oldY
+		}
+	}
+
+	if !s.DisableChaining && (dx != 0 || dy != 0) {
+		s.chainScroll(dx, dy)
+	}
+}
+
+// chainScroll passes a scroll delta this container could not consume on to the
+// nearest enclosing scrollable container, so nested scrollers hand over once
+// the inner one reaches its end.
+func (s *Scroll) chainScroll(dx, dy float32) {
+	app := fyne.CurrentApp()
+	if app == nil {
+		return
 	}
+	c := app.Driver().CanvasForObject(s)
+	if c == nil {
+		return
+	}
+
+	roots := []fyne.CanvasObject{c.Content()}
+	roots = append(roots, c.Overlays().List()...)
+
+	var stack []fyne.Scrollable
+	var target fyne.Scrollable
+	for _, root := range roots {
+		stack = stack[:0]
+		found := driver.WalkVisibleObjectTree(root, func(obj fyne.CanvasObject, _, _ fyne.Position, _ fyne.Size) bool {
+			if obj == fyne.CanvasObject(s) {
+				if len(stack) > 0 {
+					target = stack[len(stack)-1]
+				}
+				return true
+			}
+			if scrollable, ok := obj.(fyne.Scrollable); ok {
+				stack = append(stack, scrollable)
+			}
+			return false
+		}, func(obj fyne.CanvasObject, _ fyne.Position, _ fyne.CanvasObject) {
+			if scrollable, ok := obj.(fyne.Scrollable); ok && len(stack) > 0 && stack[len(stack)-1] == scrollable {
+				stack = stack[:len(stack)-1]
+			}
+		})
+		if found {
+			break
+		}
+	}
+	if target == nil {
+		return
+	}
+
+	target.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(dx, dy)})
 }
 
 func (s *Scroll) scrollBy(dx, dy float32) {
@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
@@ -49,3 +51,56 @@ func TestScrollContainer_ThemeOverride(t *testing.T) {
 	// TODO why is this off by a 1bit RGB difference?
 	//test.AssertImageMatches(t, "scroll/theme_initial.png", w.Canvas().Capture())
 }
+
+func TestScrollContainer_Chaining(t *testing.T) {
+	innerRect := canvas.NewRectangle(color.Black)
+	innerRect.SetMinSize(fyne.NewSize(100, 200))
+	inner := widget.NewScroll(innerRect)
+	inner.SetMinSize(fyne.NewSize(100, 100))
+	filler := canvas.NewRectangle(color.White)
+	filler.SetMinSize(fyne.NewSize(100, 300))
+	outer := widget.NewScroll(container.NewVBox(inner, filler))
+
+	w := test.NewTempWindow(t, outer)
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(100, 100))
+
+	// the inner scroller consumes the delta until it reaches its end
+	inner.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, -60)})
+	assert.Equal(t, float32(60), inner.Offset.Y)
+	assert.Equal(t, float32(0), outer.Offset.Y)
+
+	// the rest of this delta runs past the end, so the outer scroller takes over
+	inner.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, -60)})
+	assert.Equal(t, float32(100), inner.Offset.Y)
+	assert.Equal(t, float32(20), outer.Offset.Y)
+
+	// scrolling back up chains the same way in reverse
+	inner.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 120)})
+	assert.Equal(t, float32(0), inner.Offset.Y)
+	assert.Equal(t, float32(0), outer.Offset.Y)
+
+	inner.DisableChaining = true
+	inner.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, -200)})
+	inner.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, -50)})
+	assert.Equal(t, float32(100), inner.Offset.Y)
+	assert.Equal(t, float32(0), outer.Offset.Y, "chaining was disabled for the inner scroller")
+}
+
+func TestScrollContainer_Chaining_ScrollNone(t *testing.T) {
+	innerRect := canvas.NewRectangle(color.Black)
+	innerRect.SetMinSize(fyne.NewSize(100, 100))
+	inner := widget.NewScroll(innerRect)
+	inner.Direction = widget.ScrollNone
+	filler := canvas.NewRectangle(color.White)
+	filler.SetMinSize(fyne.NewSize(100, 300))
+	outer := widget.NewScroll(container.NewVBox(inner, filler))
+
+	w := test.NewTempWindow(t, outer)
+	w.SetPadded(false)
+	w.Resize(fyne.NewSize(100, 100))
+
+	// a scroller that cannot scroll itself passes the whole delta on
+	inner.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, -50)})
+	assert.Equal(t, float32(50), outer.Offset.Y)
+}
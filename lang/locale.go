@@ -1,6 +1,8 @@
 package lang
 
 import (
+	"strings"
+
 	"github.com/jeandeaual/go-locale"
 	"golang.org/x/text/language"
 
@@ -25,6 +27,23 @@ func SystemLocale() fyne.Locale {
 	return localeFromTag(tag)
 }
 
+// IsRTL reports whether the primary locale on the current system is written
+// right-to-left, such as Arabic or Hebrew.
+//
+// Since: 2.6
+func IsRTL() bool {
+	lang := SystemLocale().String()
+	if pos := strings.Index(lang, "-"); pos > 0 {
+		lang = lang[:pos]
+	}
+
+	switch lang {
+	case "ar", "dv", "fa", "he", "iw", "ku", "ps", "sd", "ug", "ur", "yi":
+		return true
+	}
+	return false
+}
+
 func closestSupportedLocale(locs []string) fyne.Locale {
 	matcher := language.NewMatcher(translated)
 
@@ -5,17 +5,102 @@ import (
 	"runtime"
 )
 
-// LogError reports an error to the command line with the specified err cause,
+// LogLevel describes the severity of a message reported by the toolkit.
+//
+// Since: 2.6
+type LogLevel int
+
+const (
+	// LogLevelDebug is used for verbose diagnostics that are normally hidden.
+	//
+	// Since: 2.6
+	LogLevelDebug LogLevel = iota
+	// LogLevelInfo is used for informational messages.
+	//
+	// Since: 2.6
+	LogLevelInfo
+	// LogLevelWarning is used for recoverable problems that may need attention.
+	//
+	// Since: 2.6
+	LogLevelWarning
+	// LogLevelError is used for errors that the toolkit could not recover from.
+	//
+	// Since: 2.6
+	LogLevelError
+)
+
+// String returns a human readable name for this log level.
+//
+// Since: 2.6
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "debug"
+	case LogLevelInfo:
+		return "info"
+	case LogLevelWarning:
+		return "warning"
+	default:
+		return "error"
+	}
+}
+
+// Logger is the interface that apps can implement to route toolkit diagnostics
+// into their own logging or telemetry stack, see SetLogger.
+// The err parameter may be nil and fields carries optional structured context,
+// such as the source file and line the message was reported from.
+//
+// Since: 2.6
+type Logger interface {
+	Log(level LogLevel, msg string, err error, fields map[string]any)
+}
+
+var logger Logger = defaultLogger{}
+
+// SetLogger routes all toolkit diagnostics through the specified logger.
+// Passing nil restores the default logger, which writes to the standard log package.
+//
+// Since: 2.6
+func SetLogger(l Logger) {
+	if l == nil {
+		l = defaultLogger{}
+	}
+	logger = l
+}
+
+// Log reports a message at the given level through the current Logger.
+// The err and fields parameters may be nil.
+//
+// Since: 2.6
+func Log(level LogLevel, msg string, err error, fields map[string]any) {
+	logger.Log(level, msg, err, fields)
+}
+
+// LogError reports an error to the current logger with the specified err cause,
 // if not nil.
 // The function also reports basic information about the code location.
 func LogError(reason string, err error) {
-	log.Println("Fyne error: ", reason)
+	fields := map[string]any{}
+	_, file, line, ok := runtime.Caller(1)
+	if ok {
+		fields["file"] = file
+		fields["line"] = line
+	}
+
+	logger.Log(LogLevelError, reason, err, fields)
+}
+
+// defaultLogger writes messages to the standard log package, matching the
+// output format that LogError has always produced.
+type defaultLogger struct{}
+
+func (defaultLogger) Log(level LogLevel, msg string, err error, fields map[string]any) {
+	log.Printf("Fyne %s:  %s", level, msg)
 	if err != nil {
 		log.Println("  Cause:", err)
 	}
 
-	_, file, line, ok := runtime.Caller(1)
-	if ok {
-		log.Printf("  At: %s:%d", file, line)
+	if file, ok := fields["file"]; ok {
+		log.Printf("  At: %s:%d", file, fields["line"])
 	}
 }
@@ -46,3 +46,36 @@ func TestLogErrorNoErr(t *testing.T) {
 	assert.True(t, strings.Contains(output[0], "Testing errors"))
 	assert.True(t, strings.Contains(output[1], "At"))
 }
+
+type captureLogger struct {
+	level  LogLevel
+	msg    string
+	err    error
+	fields map[string]any
+}
+
+func (c *captureLogger) Log(level LogLevel, msg string, err error, fields map[string]any) {
+	c.level = level
+	c.msg = msg
+	c.err = err
+	c.fields = fields
+}
+
+func TestSetLogger(t *testing.T) {
+	capture := &captureLogger{}
+	SetLogger(capture)
+	defer SetLogger(nil)
+
+	err := errors.New("dummy error")
+	LogError("Testing errors", err)
+
+	assert.Equal(t, LogLevelError, capture.level)
+	assert.Equal(t, "Testing errors", capture.msg)
+	assert.Equal(t, err, capture.err)
+	assert.Contains(t, capture.fields, "file")
+	assert.Contains(t, capture.fields, "line")
+
+	Log(LogLevelWarning, "something odd", nil, nil)
+	assert.Equal(t, LogLevelWarning, capture.level)
+	assert.Nil(t, capture.err)
+}
@@ -0,0 +1,133 @@
+// Package metrics provides instrumentation of toolkit startup and lifecycle timings.
+// It records how long the main phases of application startup take, such as driver
+// initialisation, font loading and the first layout pass, and reports when the first
+// frame has been presented so that cold start performance can be measured.
+//
+// Since: 2.6
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+// Phase identifies a measured stage of application startup.
+//
+// Since: 2.6
+type Phase int
+
+const (
+	// PhaseDriverInit measures the initialisation of the rendering driver.
+	//
+	// Since: 2.6
+	PhaseDriverInit Phase = iota
+	// PhaseFontLoad measures the accumulated time spent parsing font data.
+	//
+	// Since: 2.6
+	PhaseFontLoad
+	// PhaseFirstLayout measures the first layout pass of the first canvas shown.
+	//
+	// Since: 2.6
+	PhaseFirstLayout
+)
+
+// StartupTrace records the duration of the main phases of application startup.
+// A zero duration means the phase has not (yet) been recorded.
+//
+// Since: 2.6
+type StartupTrace struct {
+	// DriverInit is how long the rendering driver took to initialise.
+	DriverInit time.Duration
+	// FontLoad is the accumulated time spent loading and parsing fonts.
+	FontLoad time.Duration
+	// FirstLayout is the duration of the first layout pass.
+	FirstLayout time.Duration
+	// TimeToFirstFrame is the time from process start until the first frame was presented.
+	TimeToFirstFrame time.Duration
+}
+
+var (
+	lock         sync.Mutex
+	startTime    = time.Now()
+	trace        StartupTrace
+	firstFrame   bool
+	onFirstFrame func(time.Duration)
+	onShown      func()
+)
+
+// Startup returns a copy of the startup trace recorded so far.
+//
+// Since: 2.6
+func Startup() StartupTrace {
+	lock.Lock()
+	defer lock.Unlock()
+	return trace
+}
+
+// SetOnFirstFrame registers a hook that is called once, when the first frame of the
+// application has been presented, passing the time elapsed since process start.
+//
+// Since: 2.6
+func SetOnFirstFrame(fn func(timeToFirstFrame time.Duration)) {
+	lock.Lock()
+	defer lock.Unlock()
+	onFirstFrame = fn
+}
+
+// SetOnWindowShown registers a hook that is called each time a window becomes visible.
+//
+// Since: 2.6
+func SetOnWindowShown(fn func()) {
+	lock.Lock()
+	defer lock.Unlock()
+	onShown = fn
+}
+
+// RecordPhase adds the specified duration to the named startup phase.
+// This is an internal function called by driver and painter code.
+func RecordPhase(p Phase, d time.Duration) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	switch p {
+	case PhaseDriverInit:
+		trace.DriverInit += d
+	case PhaseFontLoad:
+		trace.FontLoad += d
+	case PhaseFirstLayout:
+		if trace.FirstLayout == 0 {
+			trace.FirstLayout = d
+		}
+	}
+}
+
+// MarkFirstFrame records the time to first frame, the first time it is called.
+// This is an internal function called by driver code after presenting a frame.
+func MarkFirstFrame() {
+	lock.Lock()
+	if firstFrame {
+		lock.Unlock()
+		return
+	}
+	firstFrame = true
+	trace.TimeToFirstFrame = time.Since(startTime)
+	fn := onFirstFrame
+	elapsed := trace.TimeToFirstFrame
+	lock.Unlock()
+
+	if fn != nil {
+		fn(elapsed)
+	}
+}
+
+// MarkWindowShown reports that a window has become visible.
+// This is an internal function called by driver code.
+func MarkWindowShown() {
+	lock.Lock()
+	fn := onShown
+	lock.Unlock()
+
+	if fn != nil {
+		fn()
+	}
+}
@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordPhase(t *testing.T) {
+	RecordPhase(PhaseDriverInit, 5*time.Millisecond)
+	RecordPhase(PhaseFontLoad, 2*time.Millisecond)
+	RecordPhase(PhaseFontLoad, 3*time.Millisecond)
+	RecordPhase(PhaseFirstLayout, 7*time.Millisecond)
+	RecordPhase(PhaseFirstLayout, 9*time.Millisecond) // only the first layout is kept
+
+	trace := Startup()
+	assert.Equal(t, 5*time.Millisecond, trace.DriverInit)
+	assert.Equal(t, 5*time.Millisecond, trace.FontLoad)
+	assert.Equal(t, 7*time.Millisecond, trace.FirstLayout)
+}
+
+func TestMarkFirstFrame(t *testing.T) {
+	var reported time.Duration
+	SetOnFirstFrame(func(d time.Duration) { reported = d })
+	defer SetOnFirstFrame(nil)
+
+	MarkFirstFrame()
+	assert.Positive(t, reported)
+	assert.Equal(t, reported, Startup().TimeToFirstFrame)
+
+	first := reported
+	MarkFirstFrame() // only the first frame is reported
+	assert.Equal(t, first, Startup().TimeToFirstFrame)
+}
+
+func TestMarkWindowShown(t *testing.T) {
+	shown := 0
+	SetOnWindowShown(func() { shown++ })
+	defer SetOnWindowShown(nil)
+
+	MarkWindowShown()
+	MarkWindowShown()
+	assert.Equal(t, 2, shown)
+}
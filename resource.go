@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 )
 
 // Resource represents a single binary resource, such as an image or font.
@@ -55,6 +56,76 @@ func NewStaticResource(name string, content []byte) *StaticResource {
 	}
 }
 
+// ScaledResource is a resource that selects between pixel density variants of
+// an asset, such as "@2x" and "@3x" images, so bitmaps stay crisp on
+// high-DPI monitors. The fyne bundle command groups such files automatically.
+//
+// Since: 2.6
+type ScaledResource struct {
+	// Base is the resource served at normal (1x) pixel density.
+	Base Resource
+	// Variants maps a density multiplier, such as 2 for an "@2x" asset,
+	// to the resource holding that variant.
+	Variants map[int]Resource
+}
+
+// NewScaledResource returns a resource serving the base content at normal
+// pixel density and the given variants at higher densities. Variant keys are
+// density multipliers, for example 2 for an "@2x" asset.
+//
+// Since: 2.6
+func NewScaledResource(base Resource, variants map[int]Resource) *ScaledResource {
+	return &ScaledResource{Base: base, Variants: variants}
+}
+
+// Name returns the name of the base resource, shared by all of the variants.
+func (r *ScaledResource) Name() string {
+	return r.Base.Name()
+}
+
+// Content returns the bytes of the variant best matching the current
+// application scale, falling back to the base content.
+func (r *ScaledResource) Content() []byte {
+	return r.ForScale(currentAppScale()).Content()
+}
+
+// ForScale returns the variant best matching the given canvas scale - the
+// smallest variant that covers the scale, or the largest available one when
+// the scale exceeds every variant.
+func (r *ScaledResource) ForScale(scale float32) Resource {
+	if scale <= 1 || len(r.Variants) == 0 {
+		return r.Base
+	}
+
+	mults := make([]int, 0, len(r.Variants))
+	for mult := range r.Variants {
+		mults = append(mults, mult)
+	}
+	sort.Ints(mults)
+
+	for _, mult := range mults {
+		if float32(mult) >= scale {
+			return r.Variants[mult]
+		}
+	}
+	return r.Variants[mults[len(mults)-1]]
+}
+
+// currentAppScale looks up the scale of the running app's first window,
+// defaulting to 1 when no window is open yet.
+func currentAppScale() float32 {
+	app := CurrentApp()
+	if app == nil || app.Driver() == nil {
+		return 1
+	}
+
+	windows := app.Driver().AllWindows()
+	if len(windows) == 0 || windows[0].Canvas() == nil {
+		return 1
+	}
+	return windows[0].Canvas().Scale()
+}
+
 // LoadResourceFromPath creates a new StaticResource in memory using the contents of the specified file.
 func LoadResourceFromPath(path string) (Resource, error) {
 	bytes, err := os.ReadFile(filepath.Clean(path))
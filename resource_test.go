@@ -14,3 +14,20 @@ func TestNewResource(t *testing.T) {
 	assert.Equal(t, name, res.Name())
 	assert.Equal(t, content, res.Content())
 }
+
+func TestScaledResource(t *testing.T) {
+	base := NewStaticResource("sharp.png", []byte{1})
+	at2x := NewStaticResource("sharp@2x.png", []byte{2})
+	at3x := NewStaticResource("sharp@3x.png", []byte{3})
+	res := NewScaledResource(base, map[int]Resource{2: at2x, 3: at3x})
+
+	assert.Equal(t, "sharp.png", res.Name())
+	assert.Equal(t, base, res.ForScale(1))
+	assert.Equal(t, at2x, res.ForScale(1.5))
+	assert.Equal(t, at2x, res.ForScale(2))
+	assert.Equal(t, at3x, res.ForScale(2.5))
+	assert.Equal(t, at3x, res.ForScale(4), "the largest variant covers anything beyond it")
+
+	// no app is running in this test so content falls back to the base variant
+	assert.Equal(t, base.Content(), res.Content())
+}
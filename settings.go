@@ -13,6 +13,50 @@ const (
 	BuildRelease
 )
 
+// SettingsAspect is a bitmask identifying which aspects of the application settings
+// changed in a SettingsChange event.
+//
+// Since: 2.6
+type SettingsAspect uint32
+
+const (
+	// SettingsAspectTheme indicates that the current theme changed.
+	//
+	// Since: 2.6
+	SettingsAspectTheme SettingsAspect = 1 << iota
+	// SettingsAspectThemeVariant indicates that the preferred theme variant (light or dark) changed.
+	//
+	// Since: 2.6
+	SettingsAspectThemeVariant
+	// SettingsAspectScale indicates that the user interface scale changed.
+	//
+	// Since: 2.6
+	SettingsAspectScale
+	// SettingsAspectPrimaryColor indicates that the preferred primary color changed.
+	//
+	// Since: 2.6
+	SettingsAspectPrimaryColor
+	// SettingsAspectReducedMotion indicates that the animation (reduced motion) preference changed.
+	//
+	// Since: 2.6
+	SettingsAspectReducedMotion
+
+	// SettingsAspectAll matches every aspect of the settings.
+	//
+	// Since: 2.6
+	SettingsAspectAll = SettingsAspectTheme | SettingsAspectThemeVariant | SettingsAspectScale |
+		SettingsAspectPrimaryColor | SettingsAspectReducedMotion
+)
+
+// SettingsChange describes a change to the application settings, identifying
+// which aspects changed so apps can react to just the parts they care about.
+//
+// Since: 2.6
+type SettingsChange struct {
+	Settings Settings
+	Aspects  SettingsAspect
+}
+
 // Settings describes the application configuration available.
 type Settings interface {
 	Theme() Theme
@@ -28,6 +72,11 @@ type Settings interface {
 	PrimaryColor() string
 
 	AddChangeListener(chan Settings)
+	// AddChangeListenerWithAspects registers a channel that receives settings change
+	// events identifying which aspects changed, rather than an opaque notification.
+	//
+	// Since: 2.6
+	AddChangeListenerWithAspects(chan SettingsChange)
 	BuildType() BuildType
 
 	ShowAnimations() bool
@@ -226,6 +226,23 @@ type MovableRepository interface {
 	Move(fyne.URI, fyne.URI) error
 }
 
+// WatchableRepository is an extension of the Repository interface which also
+// supports notifying listeners when the resource a URI references changes,
+// for example when files are added to or removed from a directory.
+//
+// Since: 2.6
+type WatchableRepository interface {
+	Repository
+
+	// Watch will be used to implement calls to storage.Watch() for the
+	// registered scheme of this repository. The callback is invoked whenever
+	// the referenced resource changes, and the returned cancel function
+	// stops the watch and releases any associated resources.
+	//
+	// Since: 2.6
+	Watch(u fyne.URI, onChanged func()) (cancel func(), err error)
+}
+
 // Register registers a storage repository so that operations on URIs of the
 // registered scheme will use methods implemented by the relevant repository
 // implementation.
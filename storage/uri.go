@@ -529,3 +529,56 @@ func CreateListable(u fyne.URI) error {
 
 	return lrepo.CreateListable(u)
 }
+
+// CanWatch determines if the given URI could be watched for changes with
+// Watch(). Like Watch, this requires that the scheme of the URI has a
+// registered WatchableRepository instance, otherwise it will fail with a
+// repository.ErrOperationNotSupported.
+//
+// Since: 2.6
+func CanWatch(u fyne.URI) (bool, error) {
+	repo, err := repository.ForURI(u)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := repo.(repository.WatchableRepository)
+	if !ok {
+		return false, repository.ErrOperationNotSupported
+	}
+
+	return true, nil
+}
+
+// Watch registers a callback that is invoked whenever the resource referenced
+// by the given URI changes, for example when entries are added to or removed
+// from a listable URI. The returned cancel function stops the watch and must
+// be called when the notifications are no longer needed.
+//
+// This method may fail in several ways:
+//
+//   - Watching depended on a lower level operation such as network or
+//     filesystem access that has failed in some way.
+//
+//   - If the scheme of the given URI does not have a registered
+//     WatchableRepository instance, then this method will fail with a
+//     repository.ErrOperationNotSupported.
+//
+// Watch is backed by the repository system - this function either calls into a
+// scheme-specific implementation from a registered repository, or fails with a
+// URIOperationNotSupported error.
+//
+// Since: 2.6
+func Watch(u fyne.URI, onChanged func()) (cancel func(), err error) {
+	repo, err := repository.ForURI(u)
+	if err != nil {
+		return nil, err
+	}
+
+	wrepo, ok := repo.(repository.WatchableRepository)
+	if !ok {
+		return nil, repository.ErrOperationNotSupported
+	}
+
+	return wrepo.Watch(u, onChanged)
+}
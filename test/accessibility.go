@@ -0,0 +1,32 @@
+package test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// AssertAnnounced allows an app developer to assert that a screen reader announcement was made.
+// After the content of f has executed this utility will check that the specified message was announced.
+//
+// Since: 2.6
+func AssertAnnounced(t *testing.T, message string, f func()) {
+	require.NotNil(t, f, "function has to be specified")
+	require.IsType(t, &app{}, fyne.CurrentApp())
+	d := fyne.CurrentApp().(*app).driver
+	d.lastAnnouncement = ""
+
+	f()
+	if message == "" {
+		assert.Empty(t, d.lastAnnouncement)
+		return
+	} else if d.lastAnnouncement == "" {
+		t.Error("No announcement made")
+		return
+	}
+
+	assert.Equal(t, message, d.lastAnnouncement)
+}
@@ -193,6 +193,7 @@ type testSettings struct {
 	theme        fyne.Theme
 
 	changeListeners []chan fyne.Settings
+	aspectListeners []chan fyne.SettingsChange
 	propertyLock    sync.RWMutex
 }
 
@@ -202,6 +203,12 @@ func (s *testSettings) AddChangeListener(listener chan fyne.Settings) {
 	s.changeListeners = append(s.changeListeners, listener)
 }
 
+func (s *testSettings) AddChangeListenerWithAspects(listener chan fyne.SettingsChange) {
+	s.propertyLock.Lock()
+	defer s.propertyLock.Unlock()
+	s.aspectListeners = append(s.aspectListeners, listener)
+}
+
 func (s *testSettings) BuildType() fyne.BuildType {
 	return fyne.BuildStandard
 }
@@ -250,6 +257,7 @@ func (s *testSettings) Scale() float32 {
 func (s *testSettings) apply() {
 	s.propertyLock.RLock()
 	listeners := s.changeListeners
+	aspectListeners := s.aspectListeners
 	s.propertyLock.RUnlock()
 
 	for _, listener := range listeners {
@@ -260,4 +268,14 @@ func (s *testSettings) apply() {
 			go func() { l <- s }()
 		}
 	}
+
+	change := fyne.SettingsChange{Settings: s, Aspects: fyne.SettingsAspectAll}
+	for _, listener := range aspectListeners {
+		select {
+		case listener <- change:
+		default:
+			l := listener
+			go func() { l <- change }()
+		}
+	}
 }
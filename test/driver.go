@@ -23,10 +23,14 @@ type driver struct {
 	painter      SoftwarePainter
 	windows      []fyne.Window
 	windowsMutex sync.RWMutex
+
+	// user action variables
+	lastAnnouncement string
 }
 
 // Declare conformity with Driver
 var _ fyne.Driver = (*driver)(nil)
+var _ fyne.AccessibilityAnnouncer = (*driver)(nil)
 
 // NewDriver sets up and registers a new dummy driver for test purpose
 func NewDriver() fyne.Driver {
@@ -67,9 +71,18 @@ func (d *driver) AllWindows() []fyne.Window {
 	return d.windows
 }
 
+// Announce records the message passed for the screen reader so that tests can
+// assert accessibility output, see AssertAnnounced.
+func (d *driver) Announce(message string) {
+	d.lastAnnouncement = message
+}
+
 func (d *driver) CanvasForObject(fyne.CanvasObject) fyne.Canvas {
 	d.windowsMutex.RLock()
 	defer d.windowsMutex.RUnlock()
+	if len(d.windows) == 0 {
+		return nil
+	}
 	// cheating: probably the last created window is meant
 	return d.windows[len(d.windows)-1].Canvas()
 }
@@ -400,6 +400,7 @@ func knownColor(c color.Color) string {
 		nrgbaColor(theme.Color(theme.ColorNameHeaderBackground)):    "headerBackground",
 		nrgbaColor(theme.Color(theme.ColorNameHover)):               "hover",
 		nrgbaColor(theme.Color(theme.ColorNameHyperlink)):           "hyperlink",
+		nrgbaColor(theme.Color(theme.ColorNameHyperlinkVisited)):    "hyperlinkVisited",
 		nrgbaColor(theme.Color(theme.ColorNameInputBackground)):     "inputBackground",
 		nrgbaColor(theme.Color(theme.ColorNameInputBorder)):         "inputBorder",
 		nrgbaColor(theme.Color(theme.ColorNameMenuBackground)):      "menuBackground",
@@ -29,6 +29,7 @@ var knownColorNames = []fyne.ThemeColorName{
 	theme.ColorNameHeaderBackground,
 	theme.ColorNameHover,
 	theme.ColorNameHyperlink,
+	theme.ColorNameHyperlinkVisited,
 	theme.ColorNameInputBackground,
 	theme.ColorNameInputBorder,
 	theme.ColorNameMenuBackground,
@@ -103,6 +104,7 @@ func NewTheme() fyne.Theme {
 			theme.ColorNameHeaderBackground:    red(22),
 			theme.ColorNameHover:               green(200),
 			theme.ColorNameHyperlink:           blue(240),
+			theme.ColorNameHyperlinkVisited:    blue(230),
 			theme.ColorNameInputBackground:     red(30),
 			theme.ColorNameInputBorder:         gray(10),
 			theme.ColorNameMenuBackground:      red(50),
@@ -165,6 +167,7 @@ func Theme() fyne.Theme {
 				theme.ColorNameHeaderBackground:    color.NRGBA{R: 0x25, G: 0x25, B: 0x25, A: 0xff},
 				theme.ColorNameHover:               color.NRGBA{R: 0x88, G: 0xff, B: 0xff, A: 0x22},
 				theme.ColorNameHyperlink:           color.NRGBA{R: 0xff, G: 0xcc, B: 0x80, A: 0xff},
+				theme.ColorNameHyperlinkVisited:    color.NRGBA{R: 0xd0, G: 0xa0, B: 0xff, A: 0xff},
 				theme.ColorNameInputBackground:     color.NRGBA{R: 0x66, G: 0x66, B: 0x66, A: 0xff},
 				theme.ColorNameInputBorder:         color.NRGBA{R: 0x86, G: 0x86, B: 0x86, A: 0xff},
 				theme.ColorNameMenuBackground:      color.NRGBA{R: 0x56, G: 0x56, B: 0x56, A: 0xff},
@@ -228,6 +231,7 @@ func (t *configurableTheme) Color(n fyne.ThemeColorName, _ fyne.ThemeVariant) co
 }
 
 func (t *configurableTheme) Font(style fyne.TextStyle) fyne.Resource {
+	style.Underline = false // underlining does not change the font face
 	if t.fonts[style] == nil {
 		fyne.LogError(fmt.Sprintf("font for style %#v not defined in theme %s", style, t.name), nil)
 	}
@@ -13,6 +13,7 @@ type window struct {
 	focused            bool
 	onClosed           func()
 	onCloseIntercepted func()
+	closeGuard         func() fyne.CloseDecision
 
 	canvas    *canvas
 	clipboard clipboard
@@ -129,6 +130,10 @@ func (w *window) SetCloseIntercept(callback func()) {
 	w.onCloseIntercepted = callback
 }
 
+func (w *window) SetCloseGuard(guard func() fyne.CloseDecision) {
+	w.closeGuard = guard
+}
+
 func (w *window) SetOnDropped(dropped func(fyne.Position, []fyne.URI)) {
 
 }
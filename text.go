@@ -72,3 +72,126 @@ func MeasureText(text string, size float32, style TextStyle) Size {
 	s, _ := CurrentApp().Driver().RenderedTextSize(text, size, style, nil)
 	return s
 }
+
+// TextLineMetrics describes a single rendered line within text measured by
+// MeasureTextDetailed.
+//
+// Since: 2.6
+type TextLineMetrics struct {
+	// Begin and End are the rune offsets of this line's content in the
+	// measured string, excluding the line break that follows it.
+	Begin, End int
+	// Size is the rendered size of this line on its own.
+	Size Size
+}
+
+// TextMetrics describes the detailed layout of a string measured with
+// MeasureTextDetailed, so custom widgets can position carets and hit-test
+// taps the same way the built-in text widgets do.
+//
+// Since: 2.6
+type TextMetrics struct {
+	// Size is the bounding size of the rendered text block, with lines
+	// stacked directly below each other.
+	Size Size
+	// Baseline is the distance from the top of the first line to its baseline.
+	Baseline float32
+	// Lines describes each rendered line, split at newline characters.
+	Lines []TextLineMetrics
+	// Advances holds, for every rune boundary in the text, the rendered
+	// width from the start of its line to that boundary. Advances[i] is the
+	// caret offset before rune i and the final entry is the end of the last line.
+	Advances []float32
+}
+
+// MeasureTextDetailed measures text like MeasureText but also returns the
+// line and rune boundary details needed to place carets and map positions
+// back to text indexes. Every rune boundary is measured, making this more
+// expensive than MeasureText, so cache the result rather than recomputing it
+// for each frame or event.
+//
+// Since: 2.6
+func MeasureTextDetailed(text string, size float32, style TextStyle) TextMetrics {
+	driver := CurrentApp().Driver()
+	runes := []rune(text)
+
+	m := TextMetrics{Advances: make([]float32, 0, len(runes)+1)}
+	lineStart := 0
+	flush := func(end int) {
+		lineSize, baseline := driver.RenderedTextSize(string(runes[lineStart:end]), size, style, nil)
+		if len(m.Lines) == 0 {
+			m.Baseline = baseline
+		}
+		m.Lines = append(m.Lines, TextLineMetrics{Begin: lineStart, End: end, Size: lineSize})
+		m.Size.Width = Max(m.Size.Width, lineSize.Width)
+		m.Size.Height += lineSize.Height
+	}
+
+	for i, r := range runes {
+		advance, _ := driver.RenderedTextSize(string(runes[lineStart:i]), size, style, nil)
+		m.Advances = append(m.Advances, advance.Width)
+
+		if r == '\n' {
+			flush(i)
+			lineStart = i + 1
+		}
+	}
+	advance, _ := driver.RenderedTextSize(string(runes[lineStart:]), size, style, nil)
+	m.Advances = append(m.Advances, advance.Width)
+	flush(len(runes))
+
+	return m
+}
+
+// CaretPosition returns the top-left position of a caret placed at the given
+// rune index, relative to the top-left of the measured text.
+//
+// Since: 2.6
+func (m TextMetrics) CaretPosition(index int) Position {
+	if index < 0 {
+		index = 0
+	}
+	if index > len(m.Advances)-1 {
+		index = len(m.Advances) - 1
+	}
+
+	y := float32(0)
+	for _, line := range m.Lines {
+		if index <= line.End {
+			return NewPos(m.Advances[index], y)
+		}
+
+		y += line.Size.Height
+	}
+	return NewPos(0, y)
+}
+
+// IndexAt returns the rune index of the caret boundary closest to the given
+// position, relative to the top-left of the measured text, for hit-testing
+// taps within it.
+//
+// Since: 2.6
+func (m TextMetrics) IndexAt(pos Position) int {
+	y := float32(0)
+	for i, line := range m.Lines {
+		y += line.Size.Height
+		if pos.Y >= y && i < len(m.Lines)-1 {
+			continue
+		}
+
+		best := line.Begin
+		bestDist := float32(-1)
+		for b := line.Begin; b <= line.End; b++ {
+			dist := m.Advances[b] - pos.X
+			if dist < 0 {
+				dist = -dist
+			}
+			if bestDist < 0 || dist < bestDist {
+				best = b
+				bestDist = dist
+			}
+		}
+		return best
+	}
+	return 0
+}
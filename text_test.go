@@ -0,0 +1,86 @@
+package fyne
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// measuringDriver is a dummy driver that measures every rune as 10x20 with a
+// baseline of 16, giving predictable metrics for the tests below.
+type measuringDriver struct{}
+
+func (d *measuringDriver) CreateWindow(string) Window { return nil }
+func (d *measuringDriver) AllWindows() []Window       { return nil }
+func (d *measuringDriver) CanvasForObject(CanvasObject) Canvas {
+	return nil
+}
+func (d *measuringDriver) AbsolutePositionForObject(CanvasObject) Position {
+	return Position{}
+}
+func (d *measuringDriver) Device() Device                { return nil }
+func (d *measuringDriver) Run()                          {}
+func (d *measuringDriver) Quit()                         {}
+func (d *measuringDriver) StartAnimation(*Animation)     {}
+func (d *measuringDriver) StopAnimation(*Animation)      {}
+func (d *measuringDriver) DoubleTapDelay() time.Duration { return 0 }
+func (d *measuringDriver) SetDisableScreenBlanking(bool) {}
+
+func (d *measuringDriver) RenderedTextSize(text string, _ float32, _ TextStyle, _ Resource) (Size, float32) {
+	return NewSize(float32(len([]rune(text))*10), 20), 16
+}
+
+type measuringApp struct {
+	dummyApp
+}
+
+func (measuringApp) Driver() Driver {
+	return &measuringDriver{}
+}
+
+func TestMeasureTextDetailed(t *testing.T) {
+	SetCurrentApp(&measuringApp{})
+
+	m := MeasureTextDetailed("ab\ncdef", 14, TextStyle{})
+
+	assert.Equal(t, NewSize(40, 40), m.Size)
+	assert.Equal(t, float32(16), m.Baseline)
+	if assert.Len(t, m.Lines, 2) {
+		assert.Equal(t, TextLineMetrics{Begin: 0, End: 2, Size: NewSize(20, 20)}, m.Lines[0])
+		assert.Equal(t, TextLineMetrics{Begin: 3, End: 7, Size: NewSize(40, 20)}, m.Lines[1])
+	}
+	assert.Equal(t, []float32{0, 10, 20, 0, 10, 20, 30, 40}, m.Advances)
+}
+
+func TestTextMetrics_CaretPosition(t *testing.T) {
+	SetCurrentApp(&measuringApp{})
+
+	m := MeasureTextDetailed("ab\ncdef", 14, TextStyle{})
+
+	assert.Equal(t, NewPos(10, 0), m.CaretPosition(1))
+	assert.Equal(t, NewPos(20, 0), m.CaretPosition(2), "the end of the first line")
+	assert.Equal(t, NewPos(0, 20), m.CaretPosition(3), "the start of the second line")
+	assert.Equal(t, NewPos(40, 20), m.CaretPosition(100), "indexes are clamped to the text")
+}
+
+func TestTextMetrics_IndexAt(t *testing.T) {
+	SetCurrentApp(&measuringApp{})
+
+	m := MeasureTextDetailed("ab\ncdef", 14, TextStyle{})
+
+	assert.Equal(t, 1, m.IndexAt(NewPos(12, 5)))
+	assert.Equal(t, 2, m.IndexAt(NewPos(100, 5)), "past the end of a line")
+	assert.Equal(t, 4, m.IndexAt(NewPos(11, 25)), "the second line")
+	assert.Equal(t, 7, m.IndexAt(NewPos(100, 100)), "below the text maps to the last line")
+}
+
+func TestMeasureTextDetailed_Empty(t *testing.T) {
+	SetCurrentApp(&measuringApp{})
+
+	m := MeasureTextDetailed("", 14, TextStyle{})
+
+	assert.Len(t, m.Lines, 1)
+	assert.Equal(t, []float32{0}, m.Advances)
+	assert.Equal(t, NewPos(0, 0), m.CaretPosition(0))
+}
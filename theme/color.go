@@ -112,6 +112,11 @@ const (
 	// Since: 2.4
 	ColorNameHyperlink fyne.ThemeColorName = "hyperlink"
 
+	// ColorNameHyperlinkVisited is the name of theme lookup for visited hyperlink color.
+	//
+	// Since: 2.6
+	ColorNameHyperlinkVisited fyne.ThemeColorName = "hyperlinkVisited"
+
 	// ColorNameInputBackground is the name of theme lookup for background color of an input field.
 	//
 	// Since: 2.0
@@ -190,6 +195,7 @@ var (
 	colorDarkForegroundOnWarning = color.NRGBA{R: 0x17, G: 0x17, B: 0x18, A: 0xff}
 	colorDarkHeaderBackground    = color.NRGBA{R: 0x1b, G: 0x1b, B: 0x1b, A: 0xff}
 	colorDarkHover               = color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0x0f}
+	colorDarkHyperlinkVisited    = color.NRGBA{R: 0xce, G: 0x93, B: 0xd8, A: 0xff}
 	colorDarkInputBackground     = color.NRGBA{R: 0x20, G: 0x20, B: 0x23, A: 0xff}
 	colorDarkInputBorder         = color.NRGBA{R: 0x39, G: 0x39, B: 0x3a, A: 0xff}
 	colorDarkMenuBackground      = color.NRGBA{R: 0x28, G: 0x29, B: 0x2e, A: 0xff}
@@ -221,6 +227,7 @@ var (
 	colorLightForegroundOnWarning = color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
 	colorLightHeaderBackground    = color.NRGBA{R: 0xf9, G: 0xf9, B: 0xf9, A: 0xff}
 	colorLightHover               = color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0x0f}
+	colorLightHyperlinkVisited    = color.NRGBA{R: 0x6a, G: 0x1b, B: 0x9a, A: 0xff}
 	colorLightInputBackground     = color.NRGBA{R: 0xf3, G: 0xf3, B: 0xf3, A: 0xff}
 	colorLightInputBorder         = color.NRGBA{R: 0xe3, G: 0xe3, B: 0xe3, A: 0xff}
 	colorLightMenuBackground      = color.NRGBA{R: 0xf5, G: 0xf5, B: 0xf5, A: 0xff}
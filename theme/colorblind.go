@@ -0,0 +1,74 @@
+package theme
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+)
+
+// The Okabe-Ito palette, chosen to stay distinguishable under the common
+// color vision deficiencies.
+var (
+	colorBlindSafeBlue      = color.NRGBA{R: 0x00, G: 0x72, B: 0xb2, A: 0xff}
+	colorBlindSafeSkyBlue   = color.NRGBA{R: 0x56, G: 0xb4, B: 0xe9, A: 0xff}
+	colorBlindSafeGreen     = color.NRGBA{R: 0x00, G: 0x9e, B: 0x73, A: 0xff}
+	colorBlindSafeOrange    = color.NRGBA{R: 0xe6, G: 0x9f, B: 0x00, A: 0xff}
+	colorBlindSafeVermilion = color.NRGBA{R: 0xd5, G: 0x5e, B: 0x00, A: 0xff}
+)
+
+// ColorBlindFriendlyTheme returns a theme based on the given one where the
+// accent and status colors are replaced with a palette that stays
+// distinguishable under the common color vision deficiencies.
+// Passing nil bases the palette on the default theme.
+//
+// Since: 2.6
+func ColorBlindFriendlyTheme(base fyne.Theme) fyne.Theme {
+	if base == nil {
+		base = DefaultTheme()
+	}
+	return &colorBlindTheme{base: base}
+}
+
+// Declare conformity with the Theme interface.
+var _ fyne.Theme = (*colorBlindTheme)(nil)
+
+type colorBlindTheme struct {
+	base fyne.Theme
+}
+
+func (t *colorBlindTheme) Color(n fyne.ThemeColorName, v fyne.ThemeVariant) color.Color {
+	switch n {
+	case ColorNamePrimary:
+		return colorBlindSafeBlue
+	case ColorNameHyperlink:
+		return colorBlindSafeSkyBlue
+	case ColorNameSuccess:
+		return colorBlindSafeGreen
+	case ColorNameWarning:
+		return colorBlindSafeOrange
+	case ColorNameError:
+		return colorBlindSafeVermilion
+	case ColorNameFocus:
+		c := colorBlindSafeBlue
+		c.A = 0x2a
+		return c
+	case ColorNameSelection:
+		c := colorBlindSafeBlue
+		c.A = 0x40
+		return c
+	}
+
+	return t.base.Color(n, v)
+}
+
+func (t *colorBlindTheme) Font(style fyne.TextStyle) fyne.Resource {
+	return t.base.Font(style)
+}
+
+func (t *colorBlindTheme) Icon(n fyne.ThemeIconName) fyne.Resource {
+	return t.base.Icon(n)
+}
+
+func (t *colorBlindTheme) Size(n fyne.ThemeSizeName) float32 {
+	return t.base.Size(n)
+}
@@ -0,0 +1,36 @@
+package theme_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2/theme"
+)
+
+func TestColorBlindFriendlyTheme(t *testing.T) {
+	base := theme.DefaultTheme()
+	cb := theme.ColorBlindFriendlyTheme(base)
+
+	assert.Equal(t, color.NRGBA{R: 0x00, G: 0x72, B: 0xb2, A: 0xff},
+		cb.Color(theme.ColorNamePrimary, theme.VariantLight))
+	assert.Equal(t, color.NRGBA{R: 0x00, G: 0x9e, B: 0x73, A: 0xff},
+		cb.Color(theme.ColorNameSuccess, theme.VariantLight))
+	assert.Equal(t, color.NRGBA{R: 0xe6, G: 0x9f, B: 0x00, A: 0xff},
+		cb.Color(theme.ColorNameWarning, theme.VariantLight))
+	assert.Equal(t, color.NRGBA{R: 0xd5, G: 0x5e, B: 0x00, A: 0xff},
+		cb.Color(theme.ColorNameError, theme.VariantLight))
+
+	// non-accent colors and the other theme resources come from the base theme
+	assert.Equal(t, base.Color(theme.ColorNameBackground, theme.VariantDark),
+		cb.Color(theme.ColorNameBackground, theme.VariantDark))
+	assert.Equal(t, base.Size(theme.SizeNamePadding), cb.Size(theme.SizeNamePadding))
+	assert.Equal(t, base.Icon(theme.IconNameHome), cb.Icon(theme.IconNameHome))
+}
+
+func TestColorBlindFriendlyTheme_NilBase(t *testing.T) {
+	cb := theme.ColorBlindFriendlyTheme(nil)
+	assert.Equal(t, theme.DefaultTheme().Color(theme.ColorNameForeground, theme.VariantLight),
+		cb.Color(theme.ColorNameForeground, theme.VariantLight))
+}
@@ -0,0 +1,46 @@
+package theme_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+func TestNewBadgedResource(t *testing.T) {
+	base := theme.NewThemedResource(helperNewStaticResource())
+	badge := theme.NewErrorThemedResource(helperNewStaticResource())
+	badged := theme.NewBadgedResource(base, badge)
+
+	assert.Equal(t, "badged_content-remove.svg_content-remove.svg", badged.Name())
+	content := string(badged.Content())
+	assert.Contains(t, content, "translate(12 12) scale(0.5)")
+}
+
+func TestBadgedResource_ThemeChangeContent(t *testing.T) {
+	fyne.CurrentApp().Settings().SetTheme(theme.DarkTheme())
+	badged := theme.NewBadgedResource(theme.NewThemedResource(helperNewStaticResource()), theme.NewPrimaryThemedResource(helperNewStaticResource()))
+	content := badged.Content()
+
+	fyne.CurrentApp().Settings().SetTheme(theme.LightTheme())
+	assert.NotEqual(t, content, badged.Content())
+}
+
+func TestNewStackedResource(t *testing.T) {
+	bottom := theme.NewThemedResource(helperNewStaticResource())
+	top := theme.NewSuccessThemedResource(helperNewStaticResource())
+	stacked := theme.NewStackedResource(bottom, top)
+
+	assert.Equal(t, "stacked_content-remove.svg_content-remove.svg", stacked.Name())
+	assert.Contains(t, string(stacked.Content()), "translate(0 0) scale(1)")
+}
+
+func TestNewDocumentTypeResource(t *testing.T) {
+	glyph := theme.NewDisabledResource(helperNewStaticResource())
+	document := theme.NewDocumentTypeResource(glyph)
+
+	assert.Equal(t, "document_content-remove.svg", document.Name())
+	assert.Contains(t, string(document.Content()), "scale(0.4")
+}
@@ -238,6 +238,8 @@ func darkPaletteColorNamed(name fyne.ThemeColorName) color.Color {
 		return colorDarkHover
 	case ColorNameHeaderBackground:
 		return colorDarkHeaderBackground
+	case ColorNameHyperlinkVisited:
+		return colorDarkHyperlinkVisited
 	case ColorNameInputBackground:
 		return colorDarkInputBackground
 	case ColorNameInputBorder:
@@ -312,6 +314,8 @@ func lightPaletteColorNamed(name fyne.ThemeColorName) color.Color {
 		return colorLightHover
 	case ColorNameHeaderBackground:
 		return colorLightHeaderBackground
+	case ColorNameHyperlinkVisited:
+		return colorLightHyperlinkVisited
 	case ColorNameInputBackground:
 		return colorLightInputBackground
 	case ColorNameInputBorder:
@@ -106,3 +106,6 @@ func (t *themedApp) ShowAnimations() bool {
 
 func (t *themedApp) AddChangeListener(chan fyne.Settings) {
 }
+
+func (t *themedApp) AddChangeListenerWithAspects(chan fyne.SettingsChange) {
+}
@@ -0,0 +1,110 @@
+package fyne
+
+import (
+	"sync"
+	"time"
+)
+
+// After schedules fn to run once, d after this call. The callback is driven by
+// the run-loop that ticks animations, so it is serialised with other scheduled
+// callbacks and discarded if the application quits first, unlike a goroutine
+// based timer. The timer is tied to the lifetime of w - fn is discarded if the
+// window has been closed when the timer fires. Pass a nil window to tie the
+// timer to the application instead.
+//
+// Since: 2.6
+func After(w Window, d time.Duration, fn func()) {
+	fired := false
+	anim := &Animation{Duration: d, Curve: AnimationLinear, Tick: func(f float32) {
+		if fired || f < 1 {
+			return
+		}
+		fired = true
+		if windowOpen(w) {
+			fn()
+		}
+	}}
+	anim.Start()
+}
+
+// Every schedules fn to run repeatedly, every d, until the returned stop
+// function is called. The callback is driven by the run-loop that ticks
+// animations, see After. The timer is tied to the lifetime of w and cancels
+// itself once the window has been closed; pass a nil window to keep it running
+// until stop is called or the application quits.
+//
+// Since: 2.6
+func Every(w Window, d time.Duration, fn func()) (stop func()) {
+	var anim *Animation
+	anim = &Animation{Duration: d, RepeatCount: AnimationRepeatForever, Curve: AnimationLinear,
+		Tick: func(f float32) {
+			if f < 1 { // the run-loop ticks exactly 1.0 once at the end of each cycle
+				return
+			}
+			if !windowOpen(w) {
+				anim.Stop()
+				return
+			}
+			fn()
+		}}
+	anim.Start()
+	return anim.Stop
+}
+
+// Debounce returns a function that schedules fn to run once, d after its most
+// recent call, so that a rapid burst of calls collapses into a single callback.
+// The callback is driven by the run-loop that ticks animations, see After, and
+// is discarded if w has been closed when the timer fires - pass a nil window to
+// tie it to the application instead.
+// The returned function may be called from any goroutine.
+//
+// Since: 2.6
+func Debounce(w Window, d time.Duration, fn func()) func() {
+	var lock sync.Mutex
+	gen := 0
+	var pending *Animation
+
+	return func() {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if pending != nil {
+			pending.Stop()
+		}
+		gen++
+		mine := gen
+		anim := &Animation{Duration: d, Curve: AnimationLinear, Tick: func(f float32) {
+			if f < 1 {
+				return
+			}
+
+			lock.Lock()
+			live := gen == mine // a later call may have rescheduled just as this fired
+			lock.Unlock()
+			if live && windowOpen(w) {
+				fn()
+			}
+		}}
+		pending = anim
+		anim.Start()
+	}
+}
+
+// windowOpen reports whether w is still open in the current driver.
+// A nil window scopes a timer to the application lifetime instead.
+func windowOpen(w Window) bool {
+	if w == nil {
+		return true
+	}
+
+	app := CurrentApp()
+	if app == nil || app.Driver() == nil {
+		return false
+	}
+	for _, open := range app.Driver().AllWindows() {
+		if open == w {
+			return true
+		}
+	}
+	return false
+}
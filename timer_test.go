@@ -0,0 +1,183 @@
+package fyne_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/animation"
+)
+
+type timerApp struct {
+	fyne.App
+	driver fyne.Driver
+}
+
+func (a *timerApp) Driver() fyne.Driver {
+	return a.driver
+}
+
+type timerDriver struct {
+	fyne.Driver
+	runner animation.Runner
+
+	windowsMutex sync.Mutex
+	windows      []fyne.Window
+}
+
+func (d *timerDriver) AllWindows() []fyne.Window {
+	d.windowsMutex.Lock()
+	defer d.windowsMutex.Unlock()
+	return d.windows
+}
+
+func (d *timerDriver) StartAnimation(a *fyne.Animation) {
+	d.runner.Start(a)
+}
+
+func (d *timerDriver) StopAnimation(a *fyne.Animation) {
+	d.runner.Stop(a)
+}
+
+func (d *timerDriver) closeAll() {
+	d.windowsMutex.Lock()
+	d.windows = nil
+	d.windowsMutex.Unlock()
+}
+
+type timerWindow struct {
+	fyne.Window
+}
+
+func setupTimerTest(t *testing.T) *timerDriver {
+	driver := &timerDriver{windows: []fyne.Window{&timerWindow{}}}
+	previous := fyne.CurrentApp()
+	fyne.SetCurrentApp(&timerApp{driver: driver})
+	t.Cleanup(func() {
+		fyne.SetCurrentApp(previous)
+	})
+	return driver
+}
+
+func TestAfter(t *testing.T) {
+	setupTimerTest(t)
+
+	fired := make(chan struct{}, 4)
+	fyne.After(nil, time.Millisecond*30, func() {
+		fired <- struct{}{}
+	})
+
+	select {
+	case <-fired:
+	case <-time.After(time.Second):
+		t.Fatal("callback did not fire")
+	}
+	select {
+	case <-fired:
+		t.Error("callback fired more than once")
+	case <-time.After(time.Millisecond * 150):
+	}
+}
+
+func TestAfter_WindowClosed(t *testing.T) {
+	driver := setupTimerTest(t)
+
+	fired := make(chan struct{}, 4)
+	fyne.After(driver.windows[0], time.Millisecond*30, func() {
+		fired <- struct{}{}
+	})
+	driver.closeAll()
+
+	select {
+	case <-fired:
+		t.Error("callback fired for a closed window")
+	case <-time.After(time.Millisecond * 150):
+	}
+}
+
+func TestEvery(t *testing.T) {
+	setupTimerTest(t)
+
+	ticks := make(chan struct{}, 16)
+	stop := fyne.Every(nil, time.Millisecond*30, func() {
+		ticks <- struct{}{}
+	})
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-ticks:
+		case <-time.After(time.Second):
+			t.Fatal("callback stopped firing")
+		}
+	}
+	stop()
+
+	time.Sleep(time.Millisecond * 100) // let any tick already in flight land
+	for len(ticks) > 0 {
+		<-ticks
+	}
+	select {
+	case <-ticks:
+		t.Error("callback fired after stop")
+	case <-time.After(time.Millisecond * 150):
+	}
+}
+
+func TestEvery_WindowClosed(t *testing.T) {
+	driver := setupTimerTest(t)
+
+	ticks := make(chan struct{}, 16)
+	stop := fyne.Every(driver.windows[0], time.Millisecond*30, func() {
+		ticks <- struct{}{}
+	})
+	defer stop()
+
+	select {
+	case <-ticks:
+	case <-time.After(time.Second):
+		t.Fatal("callback did not fire")
+	}
+	driver.closeAll()
+
+	time.Sleep(time.Millisecond * 100) // let any tick already in flight land
+	for len(ticks) > 0 {
+		<-ticks
+	}
+	select {
+	case <-ticks:
+		t.Error("callback fired after the window closed")
+	case <-time.After(time.Millisecond * 150):
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	setupTimerTest(t)
+
+	fired := make(chan struct{}, 16)
+	trigger := fyne.Debounce(nil, time.Millisecond*50, func() {
+		fired <- struct{}{}
+	})
+
+	for i := 0; i < 5; i++ {
+		trigger()
+		time.Sleep(time.Millisecond * 5)
+	}
+	select {
+	case <-fired:
+	case <-time.After(time.Second):
+		t.Fatal("callback did not fire")
+	}
+	select {
+	case <-fired:
+		t.Error("burst of calls fired more than once")
+	case <-time.After(time.Millisecond * 150):
+	}
+
+	trigger()
+	select {
+	case <-fired:
+	case <-time.After(time.Second):
+		t.Fatal("callback did not fire again")
+	}
+}
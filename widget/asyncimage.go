@@ -0,0 +1,241 @@
+package widget
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/theme"
+)
+
+// asyncImageBackoff is the delay before each fetch attempt, so a transient
+// network failure is retried a little later instead of giving up immediately.
+var asyncImageBackoff = []time.Duration{0, 500 * time.Millisecond, 2 * time.Second}
+
+var (
+	asyncImageCache     = map[string]image.Image{}
+	asyncImageCacheLock sync.RWMutex
+)
+
+// AsyncImage is an image widget that fetches and decodes its content in the
+// background, showing an activity indicator until it is ready. Loaded images
+// are shared through an in-memory and on-disk cache keyed by URI, so the same
+// picture is only fetched once across all instances.
+//
+// The widget is safe to reuse inside recycled List or GridWrap items, calling
+// SetURI while a load is running discards the result of the older location.
+//
+// Since: 2.6
+type AsyncImage struct {
+	BaseWidget
+
+	// Placeholder is an optional resource shown while the image is loading,
+	// and left in place if loading fails.
+	Placeholder fyne.Resource
+
+	uri     fyne.URI
+	loadID  int
+	loading bool
+	result  image.Image
+}
+
+// NewAsyncImage returns a new image widget that loads the image at the given
+// location in the background.
+//
+// Since: 2.6
+func NewAsyncImage(u fyne.URI) *AsyncImage {
+	i := &AsyncImage{}
+	i.ExtendBaseWidget(i)
+	i.SetURI(u)
+
+	return i
+}
+
+// SetURI changes the location of the image shown, fetching the new content in
+// the background. Passing nil clears the image back to the placeholder.
+func (i *AsyncImage) SetURI(u fyne.URI) {
+	i.propertyLock.Lock()
+	if u != nil && i.uri != nil && u.String() == i.uri.String() && (i.loading || i.result != nil) {
+		i.propertyLock.Unlock()
+		return // already showing, or fetching, this location
+	}
+	i.uri = u
+	i.loadID++
+	id := i.loadID
+	i.result = nil
+	if u == nil {
+		i.loading = false
+		i.propertyLock.Unlock()
+		i.Refresh()
+		return
+	}
+
+	asyncImageCacheLock.RLock()
+	cached, ok := asyncImageCache[u.String()]
+	asyncImageCacheLock.RUnlock()
+	if ok {
+		i.result = cached
+		i.loading = false
+		i.propertyLock.Unlock()
+		i.Refresh()
+		return
+	}
+
+	i.loading = true
+	i.propertyLock.Unlock()
+	i.Refresh()
+
+	go i.load(id, u)
+}
+
+// URI returns the location of the image this widget is showing or loading.
+func (i *AsyncImage) URI() fyne.URI {
+	i.propertyLock.RLock()
+	defer i.propertyLock.RUnlock()
+
+	return i.uri
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (i *AsyncImage) MinSize() fyne.Size {
+	i.ExtendBaseWidget(i)
+	return i.BaseWidget.MinSize()
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (i *AsyncImage) CreateRenderer() fyne.WidgetRenderer {
+	i.ExtendBaseWidget(i)
+	i.propertyLock.RLock()
+	defer i.propertyLock.RUnlock()
+
+	img := canvas.NewImageFromResource(i.Placeholder)
+	img.FillMode = canvas.ImageFillContain
+	activity := NewActivity()
+	activity.Hide()
+
+	r := &asyncImageRenderer{image: i, img: img, activity: activity}
+	r.SetObjects([]fyne.CanvasObject{img, activity})
+	r.Refresh()
+	return r
+}
+
+// load fetches and decodes the image at the given location, discarding the
+// result if the widget has since been pointed at a different URI.
+func (i *AsyncImage) load(id int, u fyne.URI) {
+	img, err := loadAsyncImage(u)
+
+	i.propertyLock.Lock()
+	if i.loadID != id { // recycled onto another location mid-load
+		i.propertyLock.Unlock()
+		return
+	}
+	i.loading = false
+	if err != nil {
+		fyne.LogError("Failed to load image "+u.String(), err)
+	} else {
+		i.result = img
+	}
+	i.propertyLock.Unlock()
+	i.Refresh()
+}
+
+// loadAsyncImage returns the decoded image at the given location, reading the
+// on-disk cache before fetching, and filling both caches on a fetch.
+func loadAsyncImage(u fyne.URI) (image.Image, error) {
+	key := u.String()
+	path := asyncImageCachePath(key)
+	if data, err := os.ReadFile(path); err == nil {
+		if img, _, err := image.Decode(bytes.NewReader(data)); err == nil {
+			asyncImageCacheLock.Lock()
+			asyncImageCache[key] = img
+			asyncImageCacheLock.Unlock()
+			return img, nil
+		}
+	}
+
+	var res fyne.Resource
+	var err error
+	for _, delay := range asyncImageBackoff {
+		time.Sleep(delay)
+		res, err = storage.LoadResourceFromURI(u)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(res.Content()))
+	if err != nil {
+		return nil, err
+	}
+
+	asyncImageCacheLock.Lock()
+	asyncImageCache[key] = img
+	asyncImageCacheLock.Unlock()
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err == nil {
+		if err = os.WriteFile(path, res.Content(), 0600); err != nil {
+			fyne.LogError("Unable to write image cache file", err)
+		}
+	}
+	return img, nil
+}
+
+// asyncImageCachePath returns the on-disk cache location for the given URI key.
+func asyncImageCachePath(key string) string {
+	cacheRoot, _ := os.UserCacheDir()
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(cacheRoot, "fyne", "images", hex.EncodeToString(sum[:]))
+}
+
+type asyncImageRenderer struct {
+	widget.BaseRenderer
+	img      *canvas.Image
+	activity *Activity
+
+	image *AsyncImage
+}
+
+func (r *asyncImageRenderer) Layout(size fyne.Size) {
+	r.img.Resize(size)
+	a := r.activity.MinSize()
+	r.activity.Resize(a)
+	r.activity.Move(fyne.NewPos((size.Width-a.Width)/2, (size.Height-a.Height)/2))
+}
+
+func (r *asyncImageRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(r.image.Theme().Size(theme.SizeNameInlineIcon))
+}
+
+func (r *asyncImageRenderer) Refresh() {
+	r.image.propertyLock.RLock()
+	loading := r.image.loading
+	result := r.image.result
+	placeholder := r.image.Placeholder
+	r.image.propertyLock.RUnlock()
+
+	if result != nil {
+		r.img.Resource = nil
+		r.img.Image = result
+	} else {
+		r.img.Image = nil
+		r.img.Resource = placeholder
+	}
+	if loading {
+		r.activity.Show()
+		r.activity.Start()
+	} else {
+		r.activity.Stop()
+		r.activity.Hide()
+	}
+	r.img.Refresh()
+}
@@ -0,0 +1,119 @@
+package widget
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func asyncTestImageURI(t *testing.T, width, height int) fyne.URI {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, width, height))))
+	path := filepath.Join(t.TempDir(), "img.png")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0600))
+	return storage.NewFileURI(path)
+}
+
+func waitForAsyncImage(i *AsyncImage) image.Image {
+	for x := 0; x < 200; x++ {
+		i.propertyLock.RLock()
+		result, loading := i.result, i.loading
+		i.propertyLock.RUnlock()
+		if result != nil || !loading {
+			return result
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return nil
+}
+
+func TestAsyncImage_Load(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	uri := asyncTestImageURI(t, 4, 6)
+
+	i := NewAsyncImage(uri)
+	test.NewTempWindow(t, i)
+
+	img := waitForAsyncImage(i)
+	require.NotNil(t, img)
+	assert.Equal(t, image.Rect(0, 0, 4, 6), img.Bounds())
+
+	r := test.TempWidgetRenderer(t, i).(*asyncImageRenderer)
+	assert.Equal(t, img, r.img.Image)
+	assert.False(t, r.activity.Visible())
+
+	_, err := os.Stat(asyncImageCachePath(uri.String()))
+	assert.NoError(t, err, "the loaded image should be cached on disk")
+}
+
+func TestAsyncImage_DiskCache(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	uri := asyncTestImageURI(t, 2, 2)
+
+	first := NewAsyncImage(uri)
+	require.NotNil(t, waitForAsyncImage(first))
+
+	// drop the memory cache and the original file, the copy on disk should serve the reload
+	asyncImageCacheLock.Lock()
+	delete(asyncImageCache, uri.String())
+	asyncImageCacheLock.Unlock()
+	require.NoError(t, os.Remove(uri.Path()))
+
+	second := NewAsyncImage(uri)
+	img := waitForAsyncImage(second)
+	require.NotNil(t, img)
+	assert.Equal(t, image.Rect(0, 0, 2, 2), img.Bounds())
+}
+
+func TestAsyncImage_Recycled(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	old := asyncTestImageURI(t, 2, 2)
+	current := asyncTestImageURI(t, 3, 3)
+
+	i := NewAsyncImage(old)
+	i.SetURI(current) // rebind before the first load can finish
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if img := waitForAsyncImage(i); img != nil && img.Bounds().Dx() == 3 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	i.propertyLock.RLock()
+	defer i.propertyLock.RUnlock()
+	require.NotNil(t, i.result)
+	assert.Equal(t, image.Rect(0, 0, 3, 3), i.result.Bounds(), "the result of the stale load should be dropped")
+	assert.Equal(t, current.String(), i.uri.String())
+}
+
+func TestAsyncImage_LoadFailed(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	backoff := asyncImageBackoff
+	asyncImageBackoff = []time.Duration{0}
+	defer func() { asyncImageBackoff = backoff }()
+
+	i := NewAsyncImage(storage.NewFileURI(filepath.Join(t.TempDir(), "missing.png")))
+	i.Placeholder = theme.BrokenImageIcon()
+	test.NewTempWindow(t, i)
+
+	assert.Nil(t, waitForAsyncImage(i))
+	r := test.TempWidgetRenderer(t, i).(*asyncImageRenderer)
+	assert.Equal(t, theme.BrokenImageIcon(), r.img.Resource)
+	assert.False(t, r.activity.Visible())
+}
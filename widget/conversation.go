@@ -0,0 +1,422 @@
+package widget
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// ConversationMessage is a single message displayed by a Conversation widget.
+//
+// Since: 2.6
+type ConversationMessage struct {
+	// Text is the body of the message.
+	Text string
+	// Author names the sender, shown above incoming messages.
+	Author string
+	// Outgoing aligns the message to the trailing edge for messages the
+	// current user sent, incoming messages align to the leading edge.
+	Outgoing bool
+	// Time is when the message was sent, used for the day separators.
+	Time time.Time
+}
+
+// Declare conformity with the Widget interface.
+var _ fyne.Widget = (*Conversation)(nil)
+
+// Conversation is a chat style message list built on List. Messages appear as
+// bubbles aligned by sender with separators between days, the view stays
+// anchored to the bottom while new messages arrive but does not jump when
+// older history is prepended, and messages received while scrolled up are
+// marked with an unread divider. A typing indicator row can be shown below
+// the newest message.
+//
+// Since: 2.6
+type Conversation struct {
+	BaseWidget
+
+	messages    []ConversationMessage
+	rows        []conversationRow
+	unreadIndex int
+	typing      string
+
+	list *List
+}
+
+type conversationRowKind int
+
+const (
+	conversationBubble conversationRowKind = iota
+	conversationDay
+	conversationUnread
+	conversationTyping
+)
+
+type conversationRow struct {
+	kind    conversationRowKind
+	message int // index into messages for bubble rows
+	text    string
+	key     string
+}
+
+// NewConversation creates an empty chat message list.
+//
+// Since: 2.6
+func NewConversation() *Conversation {
+	c := &Conversation{unreadIndex: -1}
+	c.list = NewList(
+		func() int { return len(c.rows) },
+		func() fyne.CanvasObject { return newConversationRowWidget() },
+		c.updateRow)
+	c.list.KeyForItem = func(id ListItemID) string { return c.rows[id].key }
+	c.list.HideSeparators = true
+
+	c.ExtendBaseWidget(c)
+	return c
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (c *Conversation) CreateRenderer() fyne.WidgetRenderer {
+	c.ExtendBaseWidget(c)
+	return NewSimpleRenderer(c.list)
+}
+
+// Resize recalculates the bubble heights for the new width as messages wrap.
+func (c *Conversation) Resize(size fyne.Size) {
+	c.BaseWidget.Resize(size)
+	c.applyRowHeights()
+}
+
+// Messages returns the messages this conversation is showing.
+func (c *Conversation) Messages() []ConversationMessage {
+	return c.messages
+}
+
+// AddMessage appends a newly arrived or sent message. If the view was at the
+// bottom, or the message is outgoing, the list scrolls to keep it in view,
+// otherwise an unread divider marks where reading stopped.
+func (c *Conversation) AddMessage(m ConversationMessage) {
+	atBottom := c.atBottom()
+	c.messages = append(c.messages, m)
+	if !atBottom && !m.Outgoing && c.unreadIndex < 0 {
+		c.unreadIndex = len(c.messages) - 1
+	}
+
+	c.refreshRows()
+	if atBottom || m.Outgoing {
+		c.list.ScrollToBottom()
+	}
+}
+
+// PrependHistory inserts older messages before the existing ones, keeping the
+// currently visible messages anchored in place rather than jumping the scroll.
+func (c *Conversation) PrependHistory(history []ConversationMessage) {
+	if len(history) == 0 {
+		return
+	}
+
+	oldHeight := c.list.contentMinSize().Height
+	offset := c.list.offsetY
+	if c.unreadIndex >= 0 {
+		c.unreadIndex += len(history)
+	}
+	c.messages = append(append([]ConversationMessage{}, history...), c.messages...)
+
+	c.refreshRows()
+	c.list.ScrollToOffset(offset + c.list.contentMinSize().Height - oldHeight)
+}
+
+// MarkRead removes the unread divider once the user has caught up.
+func (c *Conversation) MarkRead() {
+	if c.unreadIndex < 0 {
+		return
+	}
+
+	c.unreadIndex = -1
+	c.refreshRows()
+}
+
+// SetTyping shows a typing indicator row for the named author below the newest
+// message, or hides it again when the name is empty.
+func (c *Conversation) SetTyping(author string) {
+	if c.typing == author {
+		return
+	}
+
+	atBottom := c.atBottom()
+	c.typing = author
+	c.refreshRows()
+	if atBottom {
+		c.list.ScrollToBottom()
+	}
+}
+
+// atBottom reports whether the newest content is currently in view.
+func (c *Conversation) atBottom() bool {
+	if c.list.scroller == nil {
+		return true
+	}
+
+	bottom := c.list.contentMinSize().Height - c.list.scroller.Size().Height
+	return bottom <= 0 || c.list.offsetY >= bottom-1
+}
+
+// refreshRows rebuilds the display rows from the message data and refreshes the list.
+func (c *Conversation) refreshRows() {
+	c.rows = c.rows[:0]
+	lastDay := ""
+	for i, m := range c.messages {
+		if !m.Time.IsZero() {
+			day := m.Time.Format("January 2, 2006")
+			if day != lastDay {
+				c.rows = append(c.rows, conversationRow{kind: conversationDay, text: day, key: "day|" + day})
+				lastDay = day
+			}
+		}
+		if i == c.unreadIndex {
+			c.rows = append(c.rows, conversationRow{kind: conversationUnread, text: "Unread messages", key: "unread"})
+		}
+		c.rows = append(c.rows, conversationRow{kind: conversationBubble, message: i, key: messageKey(m)})
+	}
+	if c.typing != "" {
+		c.rows = append(c.rows, conversationRow{kind: conversationTyping, text: c.typing + " is typing…", key: "typing"})
+	}
+
+	c.applyRowHeights()
+	c.list.Refresh()
+}
+
+// applyRowHeights sizes each row to fit its wrapped message text at the current width.
+func (c *Conversation) applyRowHeights() {
+	width := c.Size().Width
+	if width <= 0 || len(c.rows) == 0 {
+		return
+	}
+
+	c.list.UpdateBatch(func() {
+		for id := range c.rows {
+			c.list.SetItemHeight(id, c.rowHeight(id, width))
+		}
+	})
+}
+
+func (c *Conversation) rowHeight(id int, width float32) float32 {
+	th := c.Theme()
+	pad := th.Size(theme.SizeNamePadding)
+	textSize := th.Size(theme.SizeNameText)
+
+	row := c.rows[id]
+	if row.kind != conversationBubble {
+		return fyne.MeasureText("M", textSize, fyne.TextStyle{}).Height + pad*3
+	}
+
+	m := c.messages[row.message]
+	wrapped := wrapMessageText(m.Text, bubbleMaxWidth(width, pad), textSize)
+	height := fyne.MeasureText(wrapped, textSize, fyne.TextStyle{}).Height
+	if !m.Outgoing && m.Author != "" {
+		height += fyne.MeasureText(m.Author, th.Size(theme.SizeNameCaptionText), fyne.TextStyle{Bold: true}).Height
+	}
+	return height + pad*4
+}
+
+func (c *Conversation) updateRow(id ListItemID, item fyne.CanvasObject) {
+	r := item.(*conversationRowWidget)
+	row := c.rows[id]
+	r.kind = row.kind
+	r.outgoing = false
+	r.author = ""
+
+	if row.kind == conversationBubble {
+		m := c.messages[row.message]
+		r.outgoing = m.Outgoing
+		if !m.Outgoing {
+			r.author = m.Author
+		}
+		th := c.Theme()
+		r.text = wrapMessageText(m.Text, bubbleMaxWidth(c.Size().Width, th.Size(theme.SizeNamePadding)), th.Size(theme.SizeNameText))
+	} else {
+		r.text = row.text
+	}
+	r.Refresh()
+}
+
+func bubbleMaxWidth(width, pad float32) float32 {
+	return width*0.75 - pad*4
+}
+
+func messageKey(m ConversationMessage) string {
+	return fmt.Sprintf("msg|%d|%s|%s", m.Time.UnixNano(), m.Author, m.Text)
+}
+
+// wrapMessageText greedily wraps the words of the given text so every line
+// fits the available width, retaining explicit line breaks.
+func wrapMessageText(text string, maxWidth float32, textSize float32) string {
+	if maxWidth <= 0 {
+		return text
+	}
+
+	out := &strings.Builder{}
+	for i, para := range strings.Split(text, "\n") {
+		if i > 0 {
+			out.WriteByte('\n')
+		}
+		line := ""
+		for _, word := range strings.Fields(para) {
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+			if line != "" && fyne.MeasureText(candidate, textSize, fyne.TextStyle{}).Width > maxWidth {
+				out.WriteString(line)
+				out.WriteByte('\n')
+				line = word
+				continue
+			}
+			line = candidate
+		}
+		out.WriteString(line)
+	}
+	return out.String()
+}
+
+// Declare conformity with the Widget interface.
+var _ fyne.Widget = (*conversationRowWidget)(nil)
+
+// conversationRowWidget renders one conversation row, either a message bubble,
+// a day separator, the unread divider or the typing indicator.
+type conversationRowWidget struct {
+	BaseWidget
+
+	kind     conversationRowKind
+	text     string
+	author   string
+	outgoing bool
+
+	bubble *canvas.Rectangle
+	line   *canvas.Rectangle
+	name   *canvas.Text
+	label  *Label
+}
+
+func newConversationRowWidget() *conversationRowWidget {
+	r := &conversationRowWidget{}
+	r.ExtendBaseWidget(r)
+	return r
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (r *conversationRowWidget) CreateRenderer() fyne.WidgetRenderer {
+	th := r.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	r.bubble = canvas.NewRectangle(th.Color(theme.ColorNameInputBackground, v))
+	r.bubble.CornerRadius = th.Size(theme.SizeNameInputRadius)
+	r.line = canvas.NewRectangle(th.Color(theme.ColorNamePrimary, v))
+	r.name = canvas.NewText("", th.Color(theme.ColorNamePrimary, v))
+	r.name.TextSize = th.Size(theme.SizeNameCaptionText)
+	r.name.TextStyle.Bold = true
+	r.label = NewLabel("")
+
+	return &conversationRowRenderer{row: r}
+}
+
+// Declare conformity with the WidgetRenderer interface.
+var _ fyne.WidgetRenderer = (*conversationRowRenderer)(nil)
+
+type conversationRowRenderer struct {
+	row *conversationRowWidget
+}
+
+func (r *conversationRowRenderer) Destroy() {
+}
+
+func (r *conversationRowRenderer) MinSize() fyne.Size {
+	return r.row.label.MinSize()
+}
+
+func (r *conversationRowRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.row.bubble, r.row.line, r.row.name, r.row.label}
+}
+
+func (r *conversationRowRenderer) Layout(size fyne.Size) {
+	row := r.row
+	th := row.Theme()
+	pad := th.Size(theme.SizeNamePadding)
+	labelMin := row.label.MinSize()
+
+	switch row.kind {
+	case conversationBubble:
+		nameHeight := float32(0)
+		if row.author != "" {
+			nameHeight = row.name.MinSize().Height
+		}
+		bubbleSize := fyne.NewSize(labelMin.Width, labelMin.Height+nameHeight)
+		x := pad
+		if row.outgoing {
+			x = size.Width - bubbleSize.Width - pad
+		}
+		row.bubble.Move(fyne.NewPos(x, pad))
+		row.bubble.Resize(bubbleSize)
+		row.name.Move(fyne.NewPos(x+pad, pad+pad/2))
+		row.label.Move(fyne.NewPos(x, pad+nameHeight))
+		row.label.Resize(labelMin)
+	case conversationDay, conversationTyping:
+		row.label.Move(fyne.NewPos((size.Width-labelMin.Width)/2, (size.Height-labelMin.Height)/2))
+		row.label.Resize(labelMin)
+	case conversationUnread:
+		row.line.Move(fyne.NewPos(0, size.Height/2))
+		row.line.Resize(fyne.NewSize(size.Width, th.Size(theme.SizeNameSeparatorThickness)))
+		row.label.Move(fyne.NewPos((size.Width-labelMin.Width)/2, (size.Height-labelMin.Height)/2))
+		row.label.Resize(labelMin)
+	}
+}
+
+func (r *conversationRowRenderer) Refresh() {
+	row := r.row
+	th := row.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	row.label.Importance = MediumImportance
+	row.label.TextStyle = fyne.TextStyle{}
+	switch row.kind {
+	case conversationBubble:
+		if row.outgoing {
+			row.bubble.FillColor = diffTint(th.Color(theme.ColorNamePrimary, v), 0x55)
+		} else {
+			row.bubble.FillColor = th.Color(theme.ColorNameInputBackground, v)
+		}
+		row.bubble.Show()
+	case conversationDay:
+		row.label.Importance = LowImportance
+		row.bubble.Hide()
+	case conversationTyping:
+		row.label.TextStyle = fyne.TextStyle{Italic: true}
+		row.label.Importance = LowImportance
+		row.bubble.Hide()
+	case conversationUnread:
+		row.label.Importance = HighImportance
+		row.bubble.Hide()
+	}
+
+	if row.kind == conversationUnread {
+		row.line.FillColor = th.Color(theme.ColorNamePrimary, v)
+		row.line.Show()
+	} else {
+		row.line.Hide()
+	}
+	if row.kind == conversationBubble && row.author != "" {
+		row.name.Text = row.author
+		row.name.Color = th.Color(theme.ColorNamePrimary, v)
+		row.name.Show()
+	} else {
+		row.name.Hide()
+	}
+
+	row.label.SetText(row.text)
+	r.Layout(row.Size())
+	canvas.Refresh(row.super())
+}
@@ -0,0 +1,121 @@
+package widget
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+)
+
+func conversationDayTime(day int) time.Time {
+	return time.Date(2026, time.March, day, 12, 0, 0, 0, time.UTC)
+}
+
+func TestConversation_DaySeparators(t *testing.T) {
+	c := NewConversation()
+	w := test.NewTempWindow(t, c)
+	w.Resize(fyne.NewSize(300, 400))
+
+	c.AddMessage(ConversationMessage{Text: "hi", Author: "ann", Time: conversationDayTime(1)})
+	c.AddMessage(ConversationMessage{Text: "hello", Outgoing: true, Time: conversationDayTime(1)})
+	c.AddMessage(ConversationMessage{Text: "new day", Author: "ann", Time: conversationDayTime(2)})
+
+	kinds := make([]conversationRowKind, len(c.rows))
+	for i, r := range c.rows {
+		kinds[i] = r.kind
+	}
+	assert.Equal(t, []conversationRowKind{
+		conversationDay, conversationBubble, conversationBubble,
+		conversationDay, conversationBubble}, kinds)
+	assert.Equal(t, "March 1, 2026", c.rows[0].text)
+	assert.Equal(t, "March 2, 2026", c.rows[3].text)
+}
+
+func TestConversation_TypingIndicator(t *testing.T) {
+	c := NewConversation()
+	w := test.NewTempWindow(t, c)
+	w.Resize(fyne.NewSize(300, 400))
+
+	c.AddMessage(ConversationMessage{Text: "hi", Author: "ann", Time: conversationDayTime(1)})
+	c.SetTyping("ann")
+	last := c.rows[len(c.rows)-1]
+	assert.Equal(t, conversationTyping, last.kind)
+	assert.Equal(t, "ann is typing…", last.text)
+
+	c.SetTyping("")
+	assert.Equal(t, conversationBubble, c.rows[len(c.rows)-1].kind)
+}
+
+func TestConversation_UnreadDivider(t *testing.T) {
+	c := NewConversation()
+	w := test.NewTempWindow(t, c)
+	w.Resize(fyne.NewSize(300, 120))
+
+	for i := 0; i < 20; i++ {
+		c.AddMessage(ConversationMessage{Text: fmt.Sprintf("msg %d", i), Author: "ann", Time: conversationDayTime(1)})
+	}
+	c.list.ScrollTo(0) // reading old history
+
+	c.AddMessage(ConversationMessage{Text: "unseen", Author: "ann", Time: conversationDayTime(1)})
+	unread := -1
+	for i, r := range c.rows {
+		if r.kind == conversationUnread {
+			unread = i
+		}
+	}
+	assert.Equal(t, len(c.rows)-2, unread, "divider should precede the unseen message")
+
+	c.MarkRead()
+	for _, r := range c.rows {
+		assert.NotEqual(t, conversationUnread, r.kind)
+	}
+}
+
+func TestConversation_PrependKeepsPosition(t *testing.T) {
+	c := NewConversation()
+	w := test.NewTempWindow(t, c)
+	w.Resize(fyne.NewSize(300, 120))
+
+	for i := 0; i < 20; i++ {
+		c.AddMessage(ConversationMessage{Text: fmt.Sprintf("msg %d", i), Author: "ann", Time: conversationDayTime(2)})
+	}
+	c.list.ScrollToOffset(40)
+	offset := c.list.offsetY
+	before := c.list.contentMinSize().Height
+
+	history := make([]ConversationMessage, 10)
+	for i := range history {
+		history[i] = ConversationMessage{Text: fmt.Sprintf("old %d", i), Author: "ann", Time: conversationDayTime(1)}
+	}
+	c.PrependHistory(history)
+
+	delta := c.list.contentMinSize().Height - before
+	assert.Greater(t, delta, float32(0))
+	assert.Equal(t, offset+delta, c.list.offsetY, "view should stay anchored to the same messages")
+	assert.Len(t, c.Messages(), 30)
+}
+
+func TestConversation_BubbleAlignment(t *testing.T) {
+	c := NewConversation()
+	w := test.NewTempWindow(t, c)
+	w.Resize(fyne.NewSize(300, 400))
+
+	c.AddMessage(ConversationMessage{Text: "in", Author: "ann", Time: conversationDayTime(1)})
+	c.AddMessage(ConversationMessage{Text: "out", Outgoing: true, Time: conversationDayTime(1)})
+
+	lo := c.list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	in, ok := lo.searchVisible(lo.visible, 1)
+	assert.True(t, ok)
+	out, ok := lo.searchVisible(lo.visible, 2)
+	assert.True(t, ok)
+
+	inRow := in.child.(*conversationRowWidget)
+	outRow := out.child.(*conversationRowWidget)
+	assert.False(t, inRow.outgoing)
+	assert.True(t, outRow.outgoing)
+	assert.Less(t, inRow.bubble.Position().X, outRow.bubble.Position().X)
+}
@@ -0,0 +1,454 @@
+package widget
+
+import (
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/theme"
+)
+
+// DiffViewMode selects how a DiffView presents the two compared texts.
+//
+// Since: 2.6
+type DiffViewMode int
+
+const (
+	// DiffViewUnified interleaves removed and added lines in a single column.
+	//
+	// Since: 2.6
+	DiffViewUnified DiffViewMode = iota
+	// DiffViewSideBySide shows the old text on the left and the new text on the
+	// right with changed lines aligned.
+	//
+	// Since: 2.6
+	DiffViewSideBySide
+)
+
+// Declare conformity with the Widget interface.
+var _ fyne.Widget = (*DiffView)(nil)
+
+// DiffView is a read-only widget that renders the differences between two
+// strings line by line, with intra-line highlighting of changed regions,
+// gutter markers for added and removed lines, and navigation between hunks.
+// The diff is computed on the whole texts, so it is intended for documents of
+// moderate size such as source files and configuration.
+//
+// Since: 2.6
+type DiffView struct {
+	BaseWidget
+
+	// Mode selects a unified or side-by-side presentation.
+	Mode DiffViewMode
+
+	oldText, newText string
+	lines            []*diffLine // display order for the unified mode
+	rows             []diffRow   // aligned pairs for the side-by-side mode
+	hunks            []diffHunk
+	current          int
+
+	scroller    *widget.Scroll
+	unified     *TextGrid
+	left, right *TextGrid
+	split       *fyne.Container
+	separator   *canvas.Rectangle
+	shownMode   DiffViewMode
+}
+
+// NewDiffView creates a diff widget comparing the two given texts.
+//
+// Since: 2.6
+func NewDiffView(oldText, newText string) *DiffView {
+	d := &DiffView{oldText: oldText, newText: newText, current: -1}
+	d.ExtendBaseWidget(d)
+	d.rebuild()
+	return d
+}
+
+// SetTexts replaces the compared texts, recomputing the diff.
+func (d *DiffView) SetTexts(oldText, newText string) {
+	d.oldText = oldText
+	d.newText = newText
+	d.current = -1
+	d.rebuild()
+	d.Refresh()
+}
+
+// HunkCount returns the number of contiguous changed regions in the diff.
+func (d *DiffView) HunkCount() int {
+	return len(d.hunks)
+}
+
+// CurrentHunk returns the index of the hunk last navigated to,
+// or -1 if no navigation has happened since the texts were set.
+func (d *DiffView) CurrentHunk() int {
+	return d.current
+}
+
+// NextHunk scrolls the view to the next changed hunk.
+func (d *DiffView) NextHunk() {
+	if d.current+1 >= len(d.hunks) {
+		return
+	}
+
+	d.current++
+	d.scrollToHunk(d.current)
+}
+
+// PreviousHunk scrolls the view back to the previous changed hunk.
+func (d *DiffView) PreviousHunk() {
+	if d.current <= 0 {
+		return
+	}
+
+	d.current--
+	d.scrollToHunk(d.current)
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (d *DiffView) CreateRenderer() fyne.WidgetRenderer {
+	d.ExtendBaseWidget(d)
+	th := d.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	d.unified = NewTextGrid()
+	d.left = NewTextGrid()
+	d.right = NewTextGrid()
+	d.separator = canvas.NewRectangle(th.Color(theme.ColorNameSeparator, v))
+	d.split = &fyne.Container{Layout: &diffSplitLayout{view: d},
+		Objects: []fyne.CanvasObject{d.left, d.separator, d.right}}
+	d.scroller = widget.NewScroll(d.unified)
+	d.shownMode = DiffViewUnified
+
+	r := &diffViewRenderer{view: d}
+	r.SetObjects([]fyne.CanvasObject{d.scroller})
+	d.refreshGrids()
+	return r
+}
+
+// rebuild recomputes the diff model from the current texts.
+func (d *DiffView) rebuild() {
+	ops := diffLines(strings.Split(d.oldText, "\n"), strings.Split(d.newText, "\n"))
+	d.lines, d.rows = alignDiff(ops)
+
+	d.hunks = nil
+	inHunk := false
+	unifiedRow := 0
+	for i, row := range d.rows {
+		if row.changed {
+			if !inHunk {
+				d.hunks = append(d.hunks, diffHunk{unifiedRow: unifiedRow, splitRow: i})
+				inHunk = true
+			}
+		} else {
+			inHunk = false
+		}
+		if row.left != nil {
+			unifiedRow++
+		}
+		if row.right != nil && row.right != row.left {
+			unifiedRow++
+		}
+	}
+}
+
+// refreshGrids pushes the diff model into the text grids for the current mode.
+func (d *DiffView) refreshGrids() {
+	if d.scroller == nil {
+		return
+	}
+	th := d.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	removed := &CustomTextGridStyle{BGColor: diffTint(th.Color(theme.ColorNameError, v), 0x2c)}
+	added := &CustomTextGridStyle{BGColor: diffTint(th.Color(theme.ColorNameSuccess, v), 0x2c)}
+	removedHL := &CustomTextGridStyle{BGColor: diffTint(th.Color(theme.ColorNameError, v), 0x66)}
+	addedHL := &CustomTextGridStyle{BGColor: diffTint(th.Color(theme.ColorNameSuccess, v), 0x66)}
+
+	if d.Mode == DiffViewUnified {
+		texts := make([]string, len(d.lines))
+		for i, ln := range d.lines {
+			texts[i] = string(diffMarker(ln.kind)) + " " + ln.text
+		}
+		d.unified.SetText(strings.Join(texts, "\n"))
+		for i, ln := range d.lines {
+			d.styleDiffLine(d.unified, i, ln, removed, added, removedHL, addedHL)
+		}
+	} else {
+		lefts := make([]string, len(d.rows))
+		rights := make([]string, len(d.rows))
+		for i, row := range d.rows {
+			lefts[i] = diffColumnText(row.left)
+			rights[i] = diffColumnText(row.right)
+		}
+		d.left.SetText(strings.Join(lefts, "\n"))
+		d.right.SetText(strings.Join(rights, "\n"))
+		for i, row := range d.rows {
+			d.styleDiffLine(d.left, i, row.left, removed, added, removedHL, addedHL)
+			d.styleDiffLine(d.right, i, row.right, removed, added, removedHL, addedHL)
+		}
+		d.separator.FillColor = th.Color(theme.ColorNameSeparator, v)
+	}
+
+	if d.shownMode != d.Mode {
+		if d.Mode == DiffViewUnified {
+			d.scroller.Content = d.unified
+		} else {
+			d.scroller.Content = d.split
+		}
+		d.shownMode = d.Mode
+	}
+	d.scroller.Refresh()
+}
+
+// styleDiffLine applies the row background and intra-line highlight for one grid row.
+func (d *DiffView) styleDiffLine(grid *TextGrid, row int, ln *diffLine, removed, added, removedHL, addedHL TextGridStyle) {
+	if ln == nil || ln.kind == diffEqual {
+		return
+	}
+
+	style, highlight := removed, removedHL
+	if ln.kind == diffInsert {
+		style, highlight = added, addedHL
+	}
+	grid.SetRowStyle(row, style)
+	if ln.hlEnd > ln.hlStart {
+		// offset by the two gutter cells holding the change marker
+		grid.SetStyleRange(row, ln.hlStart+2, row, ln.hlEnd+1, highlight)
+	}
+}
+
+// scrollToHunk moves the scroll offset so the given hunk is at the top of the view.
+func (d *DiffView) scrollToHunk(hunk int) {
+	if d.scroller == nil {
+		return
+	}
+
+	row, count := d.hunks[hunk].unifiedRow, len(d.lines)
+	grid := d.unified
+	if d.Mode == DiffViewSideBySide {
+		row, count = d.hunks[hunk].splitRow, len(d.rows)
+		grid = d.left
+	}
+	if count == 0 {
+		return
+	}
+
+	d.scroller.Offset.Y = grid.MinSize().Height / float32(count) * float32(row)
+	d.scroller.Refresh()
+}
+
+func diffMarker(kind diffOpKind) rune {
+	switch kind {
+	case diffDelete:
+		return '-'
+	case diffInsert:
+		return '+'
+	}
+	return ' '
+}
+
+func diffColumnText(ln *diffLine) string {
+	if ln == nil {
+		return ""
+	}
+	return string(diffMarker(ln.kind)) + " " + ln.text
+}
+
+// diffTint returns the given color faded to the supplied alpha, for row backgrounds.
+func diffTint(c color.Color, alpha uint8) color.Color {
+	r, g, b, _ := c.RGBA()
+	return color.NRGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: alpha}
+}
+
+type diffOpKind int
+
+const (
+	diffEqual diffOpKind = iota
+	diffDelete
+	diffInsert
+)
+
+type diffOp struct {
+	kind diffOpKind
+	text string
+}
+
+// diffLine is one displayed line of the diff, with an optional intra-line
+// highlight range in runes, hlEnd exclusive.
+type diffLine struct {
+	text           string
+	kind           diffOpKind
+	hlStart, hlEnd int
+}
+
+// diffRow pairs an old and new line for the side-by-side presentation,
+// either side may be nil where a change has no counterpart.
+type diffRow struct {
+	left, right *diffLine
+	changed     bool
+}
+
+type diffHunk struct {
+	unifiedRow, splitRow int
+}
+
+// diffLines computes a line-level diff of the two slices using the longest
+// common subsequence, returning deletions before insertions within each change.
+func diffLines(oldLines, newLines []string) []diffOp {
+	n, m := len(oldLines), len(newLines)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = maxInt(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case oldLines[i] == newLines[j]:
+			ops = append(ops, diffOp{kind: diffEqual, text: oldLines[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{kind: diffDelete, text: oldLines[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{kind: diffInsert, text: newLines[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffOp{kind: diffDelete, text: oldLines[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffOp{kind: diffInsert, text: newLines[j]})
+	}
+	return ops
+}
+
+// alignDiff converts the operations into the unified display order and the
+// aligned rows for side-by-side display, pairing each deletion in a change
+// with the matching insertion and marking their intra-line differences.
+func alignDiff(ops []diffOp) (lines []*diffLine, rows []diffRow) {
+	i := 0
+	for i < len(ops) {
+		if ops[i].kind == diffEqual {
+			line := &diffLine{text: ops[i].text, kind: diffEqual}
+			lines = append(lines, line)
+			rows = append(rows, diffRow{left: line, right: line})
+			i++
+			continue
+		}
+
+		var dels, ins []*diffLine
+		for ; i < len(ops) && ops[i].kind == diffDelete; i++ {
+			dels = append(dels, &diffLine{text: ops[i].text, kind: diffDelete})
+		}
+		for ; i < len(ops) && ops[i].kind == diffInsert; i++ {
+			ins = append(ins, &diffLine{text: ops[i].text, kind: diffInsert})
+		}
+
+		for j := 0; j < maxInt(len(dels), len(ins)); j++ {
+			row := diffRow{changed: true}
+			if j < len(dels) {
+				row.left = dels[j]
+			}
+			if j < len(ins) {
+				row.right = ins[j]
+			}
+			if row.left != nil && row.right != nil {
+				markIntraLine(row.left, row.right)
+			}
+			rows = append(rows, row)
+		}
+		for _, ln := range dels {
+			lines = append(lines, ln)
+		}
+		for _, ln := range ins {
+			lines = append(lines, ln)
+		}
+	}
+	return lines, rows
+}
+
+// markIntraLine highlights the region that differs between a paired deletion
+// and insertion, skipping their common prefix and suffix.
+func markIntraLine(left, right *diffLine) {
+	l, r := []rune(left.text), []rune(right.text)
+	prefix := 0
+	for prefix < len(l) && prefix < len(r) && l[prefix] == r[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(l)-prefix && suffix < len(r)-prefix && l[len(l)-1-suffix] == r[len(r)-1-suffix] {
+		suffix++
+	}
+
+	left.hlStart, left.hlEnd = prefix, len(l)-suffix
+	right.hlStart, right.hlEnd = prefix, len(r)-suffix
+}
+
+// Declare conformity with the Layout interface.
+var _ fyne.Layout = (*diffSplitLayout)(nil)
+
+// diffSplitLayout places the two grids in equal columns with a separator between.
+type diffSplitLayout struct {
+	view *DiffView
+}
+
+func (l *diffSplitLayout) MinSize([]fyne.CanvasObject) fyne.Size {
+	th := l.view.Theme()
+	pad := th.Size(theme.SizeNamePadding)
+	sep := th.Size(theme.SizeNameSeparatorThickness)
+	col := fyne.Max(l.view.left.MinSize().Width, l.view.right.MinSize().Width)
+	height := fyne.Max(l.view.left.MinSize().Height, l.view.right.MinSize().Height)
+	return fyne.NewSize(col*2+pad*2+sep, height)
+}
+
+func (l *diffSplitLayout) Layout(_ []fyne.CanvasObject, size fyne.Size) {
+	th := l.view.Theme()
+	pad := th.Size(theme.SizeNamePadding)
+	sep := th.Size(theme.SizeNameSeparatorThickness)
+	col := fyne.Max((size.Width-pad*2-sep)/2,
+		fyne.Max(l.view.left.MinSize().Width, l.view.right.MinSize().Width))
+
+	l.view.left.Move(fyne.NewPos(0, 0))
+	l.view.left.Resize(fyne.NewSize(col, size.Height))
+	l.view.separator.Move(fyne.NewPos(col+pad, 0))
+	l.view.separator.Resize(fyne.NewSize(sep, size.Height))
+	l.view.right.Move(fyne.NewPos(col+pad*2+sep, 0))
+	l.view.right.Resize(fyne.NewSize(col, size.Height))
+}
+
+// Declare conformity with the WidgetRenderer interface.
+var _ fyne.WidgetRenderer = (*diffViewRenderer)(nil)
+
+type diffViewRenderer struct {
+	widget.BaseRenderer
+
+	view *DiffView
+}
+
+func (r *diffViewRenderer) Layout(size fyne.Size) {
+	r.view.scroller.Resize(size)
+}
+
+func (r *diffViewRenderer) MinSize() fyne.Size {
+	return r.view.scroller.MinSize()
+}
+
+func (r *diffViewRenderer) Refresh() {
+	r.view.refreshGrids()
+	canvas.Refresh(r.view.super())
+}
@@ -0,0 +1,84 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+)
+
+func TestDiffView_Unified(t *testing.T) {
+	d := NewDiffView("one\ntwo\nthree", "one\n2\nthree")
+	w := test.NewTempWindow(t, d)
+	w.Resize(fyne.NewSize(200, 200))
+
+	assert.Equal(t, "  one", d.unified.RowText(0))
+	assert.Equal(t, "- two", d.unified.RowText(1))
+	assert.Equal(t, "+ 2", d.unified.RowText(2))
+	assert.Equal(t, "  three", d.unified.RowText(3))
+	assert.Equal(t, 1, d.HunkCount())
+}
+
+func TestDiffView_SideBySide(t *testing.T) {
+	d := NewDiffView("a\nb\nc", "a\nc\nd")
+	d.Mode = DiffViewSideBySide
+	w := test.NewTempWindow(t, d)
+	w.Resize(fyne.NewSize(300, 200))
+
+	// the deleted "b" has no counterpart, the added "d" pairs with a blank left cell
+	assert.Equal(t, "- b", d.left.RowText(1))
+	assert.Equal(t, "", d.right.RowText(1))
+	assert.Equal(t, "  c", d.left.RowText(2))
+	assert.Equal(t, "", d.left.RowText(3))
+	assert.Equal(t, "+ d", d.right.RowText(3))
+	assert.Equal(t, 2, d.HunkCount())
+}
+
+func TestDiffView_IntraLine(t *testing.T) {
+	d := NewDiffView("hello world", "hello there world")
+	w := test.NewTempWindow(t, d)
+	w.Resize(fyne.NewSize(200, 200))
+
+	// the changed region skips the common prefix and suffix, a pure insertion
+	// leaves an empty range on the deleted side
+	assert.Equal(t, d.lines[0].hlStart, d.lines[0].hlEnd)
+	assert.Equal(t, 6, d.lines[1].hlStart)
+	assert.Equal(t, 12, d.lines[1].hlEnd)
+
+	// the highlight style differs from the row style at the gutter offset
+	rowStyle := d.unified.Rows[1].Style
+	hlStyle := d.unified.Rows[1].Cells[6+2].Style
+	assert.NotNil(t, hlStyle)
+	assert.NotEqual(t, rowStyle.BackgroundColor(), hlStyle.BackgroundColor())
+}
+
+func TestDiffView_Navigation(t *testing.T) {
+	oldText := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj"
+	newText := "a\nB\nc\nd\ne\nf\ng\nh\nI\nj"
+	d := NewDiffView(oldText, newText)
+	w := test.NewTempWindow(t, d)
+	w.Resize(fyne.NewSize(200, 60))
+
+	assert.Equal(t, 2, d.HunkCount())
+	assert.Equal(t, -1, d.CurrentHunk())
+
+	d.NextHunk()
+	assert.Equal(t, 0, d.CurrentHunk())
+	first := d.scroller.Offset.Y
+
+	d.NextHunk()
+	assert.Equal(t, 1, d.CurrentHunk())
+	assert.Greater(t, d.scroller.Offset.Y, first)
+
+	d.NextHunk()
+	assert.Equal(t, 1, d.CurrentHunk(), "navigation stops at the last hunk")
+
+	d.PreviousHunk()
+	assert.Equal(t, 0, d.CurrentHunk())
+
+	d.SetTexts("same", "same")
+	assert.Equal(t, 0, d.HunkCount())
+	assert.Equal(t, -1, d.CurrentHunk())
+}
@@ -0,0 +1,37 @@
+package widget
+
+import "fyne.io/fyne/v2/lang"
+
+// LayoutDirection controls whether a widget lays its content out left-to-right
+// or right-to-left, or follows the system locale.
+//
+// Since: 2.6
+type LayoutDirection int
+
+const (
+	// LayoutDirectionAuto follows the direction of the current system locale.
+	//
+	// Since: 2.6
+	LayoutDirectionAuto LayoutDirection = iota
+	// LayoutDirectionLeftToRight forces a left-to-right presentation.
+	//
+	// Since: 2.6
+	LayoutDirectionLeftToRight
+	// LayoutDirectionRightToLeft forces a right-to-left presentation.
+	//
+	// Since: 2.6
+	LayoutDirectionRightToLeft
+)
+
+// isRTL resolves a layout direction to a concrete orientation, consulting the
+// system locale when the direction is automatic.
+func (d LayoutDirection) isRTL() bool {
+	switch d {
+	case LayoutDirectionLeftToRight:
+		return false
+	case LayoutDirectionRightToLeft:
+		return true
+	}
+
+	return lang.IsRTL()
+}
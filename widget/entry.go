@@ -56,6 +56,82 @@ type Entry struct {
 	// Since: 2.4
 	Scroll widget.ScrollDirection
 
+	// UndoDepth limits how many undoable actions are kept in the edit history,
+	// dropping the oldest as new edits are recorded. Consecutive typing merges
+	// into a single action before it counts against the limit.
+	// The default of zero keeps an unlimited history.
+	//
+	// Since: 2.6
+	UndoDepth int
+
+	// InputMask formats the content as the user types, for values such as
+	// phone numbers or dates. In the mask pattern '9' accepts a digit, 'A' a
+	// letter and '*' any character, while every other rune is a literal that
+	// is filled in automatically. Typed runes that do not fit the next open
+	// slot are dropped, and the Validator checks the formatted text.
+	// Use UnmaskedText to read the value without the mask literals.
+	//
+	// Since: 2.6
+	InputMask string
+
+	// Gutter supplies an optional annotation column, such as line numbers or
+	// breakpoint markers, rendered along the leading edge of a multiline
+	// entry. It is ignored unless MultiLine is true.
+	//
+	// Since: 2.6
+	Gutter GutterProvider `json:"-"`
+
+	// Highlighter styles the entry text for display, such as syntax
+	// highlighting source code. Highlighting affects presentation only; it
+	// does not change the Text and is ignored for Password entries.
+	//
+	// Since: 2.6
+	Highlighter Highlighter `json:"-"`
+
+	// SpellChecker marks misspelled words in the entry text. Words are
+	// checked asynchronously, drawn underlined in the error colour, and the
+	// context menu offers the checker's suggestions for the word under the
+	// pointer. Spell checking is ignored for Password entries.
+	//
+	// Since: 2.6
+	SpellChecker SpellChecker `json:"-"`
+
+	// OnInput intercepts every typed rune before it is inserted, where
+	// OnChanged fires only after the text has already changed. It returns the
+	// rune to insert, allowing input to be transformed such as forcing upper
+	// case, and false to reject the rune entirely, such as enforcing a length
+	// limit. Clipboard pastes are intercepted by OnPaste instead.
+	//
+	// Since: 2.6
+	OnInput func(r rune) (rune, bool) `json:"-"`
+
+	// OnPaste transforms the clipboard text before a paste inserts it, for
+	// example to strip formatting or trim the value to a length limit.
+	// Returning an empty string rejects the paste.
+	//
+	// Since: 2.6
+	OnPaste func(text string) string `json:"-"`
+
+	// PasswordStrength scores the current content of a password entry between
+	// 0 and 1, driving a thin strength bar along the bottom edge of the field.
+	// The bar is tinted with the error, warning and success colours as the
+	// score improves. A nil scorer hides the bar.
+	//
+	// Since: 2.6
+	PasswordStrength func(password string) float64 `json:"-"`
+
+	// PasswordReveal selects how the revealer action of a password entry
+	// behaves, see the PasswordReveal constants. The default is a toggle.
+	//
+	// Since: 2.6
+	PasswordReveal PasswordReveal
+
+	// PasswordRevealTimeout overrides how long PasswordRevealTimed leaves the
+	// content visible before concealing it again, the default is five seconds.
+	//
+	// Since: 2.6
+	PasswordRevealTimeout time.Duration
+
 	// Set a validator that this entry will check against
 	// Since: 1.4
 	Validator           fyne.StringValidator `json:"-"`
@@ -66,6 +142,13 @@ type Entry struct {
 	CursorRow, CursorColumn int
 	OnCursorChanged         func() `json:"-"`
 
+	// OnCompositionChanged is called when an input method composition or the
+	// position of its caret changes, with the position within the entry where
+	// the input method should show its candidate window.
+	//
+	// Since: 2.6
+	OnCompositionChanged func(fyne.Position) `json:"-"`
+
 	cursorAnim *entryCursorAnimation
 
 	dirty       bool
@@ -74,6 +157,52 @@ type Entry struct {
 	placeholder RichText
 	content     *entryContent
 	scroll      *widget.Scroll
+	gutter      *entryGutter
+	strengthBar *canvas.Rectangle
+
+	// styleRuns are the styled spans applied with ApplyStyle and ApplyLink,
+	// following the text as it is edited.
+	styleRuns []entryStyleRun
+
+	// highlightCache holds the per-line results of the Highlighter so
+	// unchanged lines are not lexed again, highlighter tracking which
+	// provider filled it.
+	highlightCache []entryHighlightLine
+	highlighter    Highlighter
+
+	// matchRanges are the spans marked with HighlightRanges, such as search
+	// matches, and searchBar is the overlay opened with ShowSearchBar.
+	matchRanges []TextRange
+	searchBar   *PopUp
+
+	// misspelled are the word spans most recently reported by the
+	// SpellChecker, spellText the text they were computed for and spellGen
+	// a counter that discards the results of superseded checks.
+	misspelled []TextRange
+	spellText  string
+	spellGen   int
+
+	// composition is the pre-edit string an input method is assembling,
+	// shown at the text position compositionStart with its own caret at
+	// compositionCursor runes into it.
+	composition       string
+	compositionStart  int
+	compositionCursor int
+
+	// maskCursor is the text position plus one where the cursor should land
+	// after an InputMask edit is shown, or zero when no move is pending.
+	maskCursor int
+
+	// completion is inline ghost text suggested after the cursor, see SetCompletion.
+	completion     string
+	completionText *canvas.Text
+
+	// completionProvider supplies the dropdown suggestions shown for the
+	// current text and cursor in completionPopup, with completionMenu
+	// tracking the highlighted item, see SetCompletionProvider.
+	completionProvider func(string, int) []CompletionItem
+	completionPopup    *completionPopUp
+	completionMenu     *Menu
 
 	// useful for Form validation (as the error text should only be shown when
 	// the entry is unfocused)
@@ -329,6 +458,7 @@ func (e *Entry) FocusGained() {
 //
 // Implements: fyne.Focusable
 func (e *Entry) FocusLost() {
+	e.hideCompletions()
 	e.setFieldsAndRefresh(func() {
 		e.focused = false
 		e.selectKeyDown = false
@@ -483,6 +613,10 @@ func (e *Entry) Redo() {
 func (e *Entry) Refresh() {
 	e.propertyLock.Lock()
 	e.minCache = fyne.Size{}
+	if e.maskCursor > 0 { // an InputMask edit moved the cursor, see applyMask
+		e.CursorRow, e.CursorColumn = e.rowColFromTextPos(e.maskCursor - 1)
+		e.maskCursor = 0
+	}
 	e.propertyLock.Unlock()
 
 	e.BaseWidget.Refresh()
@@ -543,6 +677,7 @@ func (e *Entry) setText(text string, fromBinding bool) {
 
 	e.propertyLock.Lock()
 	e.undoStack.Clear()
+	e.styleRuns = nil // the replacement text is a new document
 	e.propertyLock.Unlock()
 }
 
@@ -555,6 +690,7 @@ func (e *Entry) Append(text string) {
 	provider.insertAt(provider.len(), []rune(text))
 	content := provider.String()
 	changed := e.updateText(content, false)
+	content = e.Text // an InputMask may have reformatted the content
 	cb := e.OnChanged
 	e.undoStack.Clear()
 	e.propertyLock.Unlock()
@@ -616,6 +752,16 @@ func (e *Entry) TappedSecondary(pe *fyne.PointEvent) {
 		menu = fyne.NewMenu("", pasteItem, selectAllItem)
 	} else {
 		var menuItems []*fyne.MenuItem
+		if e.SpellChecker != nil {
+			row, col := e.getRowCol(pe.Position)
+			e.propertyLock.RLock()
+			suggestions := e.suggestionItems(e.textPosFromRowCol(row, col))
+			e.propertyLock.RUnlock()
+			if len(suggestions) > 0 {
+				menuItems = append(menuItems, suggestions...)
+				menuItems = append(menuItems, fyne.NewMenuItemSeparator())
+			}
+		}
 		e.propertyLock.Lock()
 		canUndo, canRedo := e.undoStack.CanUndo(), e.undoStack.CanRedo()
 		e.propertyLock.Unlock()
@@ -692,6 +838,20 @@ func (e *Entry) TypedKey(key *fyne.KeyEvent) {
 		}
 	}
 
+	if e.completionPopup != nil && e.completionPopup.Visible() {
+		if e.typedKeyCompletion(key) {
+			return
+		}
+	}
+
+	if key.Name == fyne.KeyTab {
+		if e.acceptCompletion() {
+			return
+		}
+	} else {
+		e.SetCompletion("") // any other key dismisses the inline suggestion
+	}
+
 	switch key.Name {
 	case fyne.KeyBackspace:
 		e.propertyLock.RLock()
@@ -705,7 +865,7 @@ func (e *Entry) TypedKey(key *fyne.KeyEvent) {
 		pos := e.cursorTextPos()
 		deletedText := provider.deleteFromTo(pos-1, pos)
 		e.CursorRow, e.CursorColumn = e.rowColFromTextPos(pos - 1)
-		e.undoStack.MergeOrAdd(&entryModifyAction{
+		e.pushUndo(&entryModifyAction{
 			Delete:   true,
 			Position: pos - 1,
 			Text:     deletedText,
@@ -719,7 +879,7 @@ func (e *Entry) TypedKey(key *fyne.KeyEvent) {
 
 		e.propertyLock.Lock()
 		deletedText := provider.deleteFromTo(pos, pos+1)
-		e.undoStack.MergeOrAdd(&entryModifyAction{
+		e.pushUndo(&entryModifyAction{
 			Delete:   true,
 			Position: pos,
 			Text:     deletedText,
@@ -764,6 +924,7 @@ func (e *Entry) TypedKey(key *fyne.KeyEvent) {
 	e.propertyLock.Lock()
 	content := provider.String()
 	changed := e.updateText(content, false)
+	content = e.Text // an InputMask may have reformatted the content
 	if e.CursorRow == e.selectRow && e.CursorColumn == e.selectColumn {
 		e.selecting = false
 	}
@@ -776,6 +937,15 @@ func (e *Entry) TypedKey(key *fyne.KeyEvent) {
 		}
 	}
 	e.Refresh()
+	if changed || e.completionPopup != nil {
+		e.updateCompletions()
+	}
+}
+
+// pushUndo records an undoable action, honoring the configured history depth.
+func (e *Entry) pushUndo(a entryUndoAction) {
+	e.undoStack.limit = e.UndoDepth
+	e.undoStack.MergeOrAdd(a)
 }
 
 // Undo un-does the last modifying user-action.
@@ -896,8 +1066,9 @@ func (e *Entry) deleteWord(right bool) {
 	// convert start, end to absolute text position
 	b := provider.rowBoundary(cursorRow)
 	if b != nil {
-		start += b.begin
-		end += b.begin
+		begin := e.rowBeginTextPos(b)
+		start += begin
+		end += begin
 	}
 
 	provider.deleteFromTo(start, end)
@@ -924,10 +1095,19 @@ func (e *Entry) TypedRune(r rune) {
 		return
 	}
 
+	if f := e.OnInput; f != nil {
+		out, ok := f(r)
+		if !ok {
+			return
+		}
+		r = out
+	}
+
 	e.propertyLock.Lock()
 	if e.popUp != nil {
 		e.popUp.Hide()
 	}
+	e.completion = "" // typing dismisses any inline suggestion
 
 	// if we've typed a character and we're selecting then replace the selection with the character
 	cb := e.OnChanged
@@ -943,9 +1123,10 @@ func (e *Entry) TypedRune(r rune) {
 
 	content := provider.String()
 	e.updateText(content, false)
+	content = e.Text // an InputMask may have reformatted the content
 	e.CursorRow, e.CursorColumn = e.rowColFromTextPos(pos + len(runes))
 
-	e.undoStack.MergeOrAdd(&entryModifyAction{
+	e.pushUndo(&entryModifyAction{
 		Position: pos,
 		Text:     runes,
 	})
@@ -956,6 +1137,7 @@ func (e *Entry) TypedRune(r rune) {
 		cb(content)
 	}
 	e.Refresh()
+	e.updateCompletions()
 }
 
 // TypedShortcut implements the Shortcutable interface
@@ -1043,7 +1225,7 @@ func (e *Entry) eraseSelection() bool {
 	e.selectRow, e.selectColumn = e.CursorRow, e.CursorColumn
 	e.selecting = false
 
-	e.undoStack.MergeOrAdd(&entryModifyAction{
+	e.pushUndo(&entryModifyAction{
 		Delete:   true,
 		Position: posA,
 		Text:     erasedText,
@@ -1066,6 +1248,9 @@ func (e *Entry) getRowCol(p fyne.Position) (int, int) {
 	e.propertyLock.RLock()
 	defer e.propertyLock.RUnlock()
 
+	if g := e.gutter; g != nil && g.Visible() {
+		p.X -= g.width() // the content is inset beside the gutter column
+	}
 	rowHeight := e.textProvider().charMinSize(e.Password, e.TextStyle, textSize).Height
 	row := int(math.Floor(float64(p.Y+e.scroll.Offset.Y-th.Size(theme.SizeNameLineSpacing)) / float64(rowHeight)))
 	col := 0
@@ -1085,6 +1270,9 @@ func (e *Entry) getRowCol(p fyne.Position) (int, int) {
 // starting from the cursor position.
 func (e *Entry) pasteFromClipboard(clipboard fyne.Clipboard) {
 	text := clipboard.Content()
+	if f := e.OnPaste; f != nil && text != "" {
+		text = f(text)
+	}
 	if text == "" {
 		e.propertyLock.Lock()
 		changed := e.selecting && e.eraseSelection()
@@ -1118,6 +1306,7 @@ func (e *Entry) pasteFromClipboard(clipboard fyne.Clipboard) {
 	})
 	content := provider.String()
 	e.updateText(content, false)
+	content = e.Text // an InputMask may have reformatted the content
 	e.CursorRow, e.CursorColumn = e.rowColFromTextPos(pos + len(runes))
 	cb := e.OnChanged
 	e.propertyLock.Unlock()
@@ -1262,23 +1451,27 @@ func (e *Entry) rowColFromTextPos(pos int) (row int, col int) {
 	provider := e.textProvider()
 	canWrap := e.Wrapping == fyne.TextWrapBreak || e.Wrapping == fyne.TextWrapWord
 	totalRows := provider.rows()
+	prevEnd := 0
 	for i := 0; i < totalRows; i++ {
 		b := provider.rowBoundary(i)
 		if b == nil {
 			continue
 		}
-		if b.begin <= pos {
-			if b.end < pos {
+		begin := e.rowBeginTextPos(b)
+		end := begin + provider.rowLength(i)
+		if begin <= pos {
+			if end < pos {
 				row++
 			}
-			col = pos - b.begin
-			// if this gap is at `pos` and is a line wrap, increment (safe to access boundary i-1)
-			if canWrap && b.begin == pos && pos != 0 && provider.rowBoundary(i-1).end == b.begin && row < (totalRows-1) {
+			col = pos - begin
+			// if this gap is at `pos` and is a line wrap, increment
+			if canWrap && begin == pos && pos != 0 && prevEnd == begin && row < (totalRows-1) {
 				row++
 			}
 		} else {
 			break
 		}
+		prevEnd = end
 	}
 	return
 }
@@ -1404,7 +1597,29 @@ func (e *Entry) textPosFromRowCol(row, col int) int {
 	if b == nil {
 		return col
 	}
-	return b.begin + col
+	return e.rowBeginTextPos(b) + col
+}
+
+// rowBeginTextPos returns the textual position of the start of a row. The
+// boundary offsets are relative to the row's first segment, which matches the
+// whole text only while the provider holds a single segment, so the lengths of
+// the segments before it are added back on.
+// expects a read or write lock to be held by the caller
+func (e *Entry) rowBeginTextPos(b *rowBoundary) int {
+	if len(b.segments) == 0 {
+		return b.begin
+	}
+
+	pos := b.begin
+	for _, seg := range e.textProvider().Segments {
+		if seg == b.segments[0] {
+			break
+		}
+		if text, ok := seg.(*TextSegment); ok {
+			pos += len([]rune(text.Text))
+		}
+	}
+	return pos
 }
 
 func (e *Entry) syncSegments() {
@@ -1418,11 +1633,32 @@ func (e *Entry) syncSegments() {
 	text := e.textProvider()
 	text.Wrapping = wrap
 
-	textSegment := text.Segments[0].(*TextSegment)
-	textSegment.Text = e.Text
-	textSegment.Style.ColorName = colName
-	textSegment.Style.concealed = e.Password
-	textSegment.Style.TextStyle = e.TextStyle
+	runs := e.styleRuns
+	if e.Highlighter != nil && !e.Password {
+		runs = overlayStyleRuns(e.highlightRuns(), runs)
+	}
+	if e.SpellChecker != nil && !e.Password {
+		e.checkSpelling()
+		runs = overlayStyleRuns(runs, e.spellRuns())
+	}
+	if len(e.matchRanges) > 0 && !e.Password {
+		runs = overlayStyleRuns(runs, e.matchRuns())
+	}
+	if e.composition != "" && !e.Password {
+		runs = overlayStyleRuns(e.compositionShifted(runs), e.compositionRuns())
+	}
+	if len(runs) > 0 && !e.Password {
+		text.Segments = e.styledSegments(runs, colName)
+	} else {
+		textSegment := text.Segments[0].(*TextSegment)
+		textSegment.Text = e.Text
+		textSegment.Style.ColorName = colName
+		textSegment.Style.concealed = e.Password
+		textSegment.Style.TextStyle = e.TextStyle
+		if len(text.Segments) > 1 { // collapse after the last styled span is removed
+			text.Segments = []RichTextSegment{textSegment}
+		}
+	}
 
 	colName = theme.ColorNamePlaceHolder
 	if disabled {
@@ -1432,7 +1668,7 @@ func (e *Entry) syncSegments() {
 	placeholder := e.placeholderProvider()
 	placeholder.Wrapping = wrap
 
-	textSegment = placeholder.Segments[0].(*TextSegment)
+	textSegment := placeholder.Segments[0].(*TextSegment)
 	textSegment.Style.ColorName = colName
 	textSegment.Style.TextStyle = e.TextStyle
 	textSegment.Text = e.PlaceHolder
@@ -1533,7 +1769,13 @@ func (e *Entry) updateMousePointer(p fyne.Position, rightClick bool) {
 // updateText updates the internal text to the given value.
 // It assumes that a lock exists on the widget.
 func (e *Entry) updateText(text string, fromBinding bool) bool {
+	if e.InputMask != "" && text != e.Text {
+		text = e.applyMask(e.Text, text)
+	}
 	changed := e.Text != text
+	if changed && len(e.styleRuns) > 0 {
+		e.adjustStyleRuns(e.Text, text)
+	}
 	e.Text = text
 	e.syncSegments()
 	e.text.updateRowBounds()
@@ -1559,6 +1801,7 @@ func (e *Entry) updateTextAndRefresh(text string, fromBinding bool) {
 
 	e.propertyLock.Lock()
 	changed := e.updateText(text, fromBinding)
+	text = e.Text // an InputMask may have reformatted the content
 
 	if changed {
 		callback = e.OnChanged
@@ -1611,7 +1854,7 @@ func (e *Entry) typedKeyReturn(provider *RichText, multiLine bool) {
 	s := []rune("\n")
 	pos := e.cursorTextPos()
 	provider.insertAt(pos, s)
-	e.undoStack.MergeOrAdd(&entryModifyAction{
+	e.pushUndo(&entryModifyAction{
 		Position: pos,
 		Text:     s,
 	})
@@ -1681,6 +1924,14 @@ func (r *entryRenderer) trailingInset() float32 {
 	return xInset
 }
 
+// gutterInset returns the width of the gutter column when one is shown.
+func (r *entryRenderer) gutterInset() float32 {
+	if g := r.entry.gutter; g != nil && g.Visible() {
+		return g.width()
+	}
+	return 0
+}
+
 func (r *entryRenderer) Layout(size fyne.Size) {
 	th := r.entry.Theme()
 	borderSize := th.Size(theme.SizeNameInputBorder)
@@ -1718,10 +1969,21 @@ func (r *entryRenderer) Layout(size fyne.Size) {
 		}
 	}
 
+	gutterWidth := r.gutterInset()
+	if g := r.entry.gutter; g != nil && g.Visible() {
+		g.Move(fyne.NewPos(0, inputBorder))
+		g.Resize(fyne.NewSize(gutterWidth, size.Height-inputBorder*2))
+		g.Refresh()
+	}
+
+	if r.entry.PasswordStrength != nil {
+		r.refreshStrengthBar()
+	}
+
 	r.entry.textProvider().inset = fyne.NewSize(0, inputBorder)
 	r.entry.placeholderProvider().inset = fyne.NewSize(0, inputBorder)
-	entrySize := size.Subtract(fyne.NewSize(r.trailingInset(), inputBorder*2))
-	entryPos := fyne.NewPos(0, inputBorder)
+	entrySize := size.Subtract(fyne.NewSize(r.trailingInset()+gutterWidth, inputBorder*2))
+	entryPos := fyne.NewPos(gutterWidth, inputBorder)
 
 	r.entry.propertyLock.Lock()
 	textPos := r.entry.textPosFromRowCol(r.entry.CursorRow, r.entry.CursorColumn)
@@ -1787,6 +2049,7 @@ func (r *entryRenderer) MinSize() fyne.Size {
 	if r.entry.Validator != nil {
 		minSize.Width += iconSpace
 	}
+	minSize.Width += r.gutterInset()
 
 	return minSize
 }
@@ -1816,12 +2079,20 @@ func (r *entryRenderer) Refresh() {
 	v := fyne.CurrentApp().Settings().ThemeVariant()
 	inputBorder := th.Size(theme.SizeNameInputBorder)
 
+	if r.entry.Gutter != nil && r.entry.MultiLine {
+		r.ensureGutterSetup()
+	} else if r.entry.gutter != nil && r.entry.gutter.Visible() {
+		r.entry.gutter.Hide()
+		r.Layout(r.entry.size.Load())
+	}
+
 	// correct our scroll wrappers if the wrap mode changed
-	entrySize := r.entry.size.Load().Subtract(fyne.NewSize(r.trailingInset(), inputBorder*2))
+	gutterWidth := r.gutterInset()
+	entrySize := r.entry.size.Load().Subtract(fyne.NewSize(r.trailingInset()+gutterWidth, inputBorder*2))
 	if wrapping == fyne.TextWrapOff && scroll == widget.ScrollNone && r.scroll.Content != nil {
 		r.scroll.Hide()
 		r.scroll.Content = nil
-		content.Move(fyne.NewPos(0, inputBorder))
+		content.Move(fyne.NewPos(gutterWidth, inputBorder))
 		content.Resize(entrySize)
 
 		for i, o := range r.objects {
@@ -1833,7 +2104,7 @@ func (r *entryRenderer) Refresh() {
 	} else if (wrapping != fyne.TextWrapOff || scroll != widget.ScrollNone) && r.scroll.Content == nil {
 		r.scroll.Content = content
 		content.Move(fyne.NewPos(0, 0))
-		r.scroll.Move(fyne.NewPos(0, inputBorder))
+		r.scroll.Move(fyne.NewPos(gutterWidth, inputBorder))
 		r.scroll.Resize(entrySize)
 		r.scroll.Show()
 
@@ -1872,10 +2143,43 @@ func (r *entryRenderer) Refresh() {
 		r.entry.validationStatus.Hide()
 	}
 
+	if r.entry.PasswordStrength != nil {
+		r.refreshStrengthBar()
+	} else if r.entry.strengthBar != nil {
+		r.entry.strengthBar.Hide()
+	}
+
+	if g := r.entry.gutter; g != nil && g.Visible() {
+		if g.Size().Width != g.width() { // more lines can widen the number column
+			r.Layout(r.entry.size.Load())
+		}
+		g.Refresh()
+	}
+
 	cache.Renderer(r.entry.content).Refresh()
 	canvas.Refresh(r.entry.super())
 }
 
+// ensureGutterSetup lazily creates the gutter column the first time a
+// GutterProvider is set on a multiline entry.
+func (r *entryRenderer) ensureGutterSetup() {
+	if r.entry.gutter != nil {
+		if !r.entry.gutter.Visible() {
+			r.entry.gutter.Show()
+			r.Layout(r.entry.size.Load())
+		}
+		return
+	}
+
+	gutter := newEntryGutter(r.entry)
+	r.entry.gutter = gutter
+	r.objects = append(r.objects, gutter)
+	r.scroll.OnScrolled = func(fyne.Position) {
+		gutter.Refresh()
+	}
+	r.Layout(r.entry.size.Load())
+}
+
 func (r *entryRenderer) ensureValidationSetup() {
 	if r.entry.validationStatus == nil {
 		r.entry.validationStatus = newValidationStatus(r.entry)
@@ -1906,7 +2210,11 @@ func (e *entryContent) CreateRenderer() fyne.WidgetRenderer {
 	if provider.len() != 0 {
 		placeholder.Hide()
 	}
-	objects := []fyne.CanvasObject{placeholder, provider, e.entry.cursorAnim.cursor}
+	if e.entry.completionText == nil {
+		e.entry.completionText = canvas.NewText("", color.Transparent) // colored by the theme on refresh
+		e.entry.completionText.Hide()
+	}
+	objects := []fyne.CanvasObject{placeholder, provider, e.entry.completionText, e.entry.cursorAnim.cursor}
 
 	r := &entryContentRenderer{e.entry.cursorAnim.cursor, []fyne.CanvasObject{}, objects,
 		provider, placeholder, e}
@@ -2005,6 +2313,19 @@ func (r *entryContentRenderer) Refresh() {
 		r.content.entry.cursorAnim.stop()
 		r.cursor.Hide()
 	}
+	if ghost := r.content.entry.completionText; ghost != nil {
+		r.content.entry.propertyLock.RLock()
+		ghost.Text = r.content.entry.completion
+		r.content.entry.propertyLock.RUnlock()
+		ghost.TextSize = th.Size(theme.SizeNameText)
+		ghost.TextStyle = r.content.entry.TextStyle
+		ghost.Color = th.Color(theme.ColorNamePlaceHolder, v)
+		if ghost.Text == "" {
+			ghost.Hide()
+		} else {
+			ghost.Show()
+		}
+	}
 	r.moveCursor()
 
 	selectionColor := th.Color(theme.ColorNameSelection, v)
@@ -2165,6 +2486,10 @@ func (r *entryContentRenderer) moveCursor() {
 	r.cursor.Resize(fyne.NewSize(inputBorder, lineHeight))
 	r.cursor.Move(fyne.NewPos(xPos-(inputBorder/2), yPos+innerPad-inputBorder))
 
+	if ghost := r.content.entry.completionText; ghost != nil {
+		ghost.Move(fyne.NewPos(xPos, yPos+innerPad-inputBorder))
+	}
+
 	callback := r.content.entry.OnCursorChanged
 	r.content.entry.propertyLock.Unlock()
 	r.ensureCursorVisible()
@@ -2172,6 +2497,7 @@ func (r *entryContentRenderer) moveCursor() {
 	if callback != nil {
 		callback()
 	}
+	r.content.entry.notifyInputMethod()
 }
 
 func (r *entryContentRenderer) updateScrollDirections() {
@@ -2367,6 +2693,8 @@ type entryUndoStack struct {
 	// items[index-1] and below are the possible undo actions.
 	// items[index] and above are the possible redo actions.
 	index int
+	// limit caps the history length when non-zero, see Entry.UndoDepth.
+	limit int
 }
 
 // Applies the undo action to s and returns the result along with the action performed
@@ -2405,6 +2733,12 @@ func (u *entryUndoStack) Add(a entryUndoAction) {
 	u.items = u.items[:u.index]
 	u.items = append(u.items, a)
 	u.index++
+
+	if u.limit > 0 && len(u.items) > u.limit {
+		over := len(u.items) - u.limit
+		u.items = u.items[over:]
+		u.index -= over
+	}
 }
 
 // Tries to merge the action with the last item on the undo stack.
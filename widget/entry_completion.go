@@ -0,0 +1,312 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/search"
+)
+
+// SetCompletion sets inline completion ghost text that is rendered dimmed after the
+// cursor position. The suggestion is accepted with the Tab key, which inserts it at
+// the cursor, and is cleared by any other edit or cursor movement.
+// Pass an empty string to remove the current suggestion.
+//
+// Since: 2.6
+func (e *Entry) SetCompletion(text string) {
+	e.propertyLock.Lock()
+	changed := e.completion != text
+	e.completion = text
+	e.propertyLock.Unlock()
+
+	if changed {
+		e.Refresh()
+	}
+}
+
+// Completion returns the inline completion ghost text currently suggested, if any.
+//
+// Since: 2.6
+func (e *Entry) Completion() string {
+	e.propertyLock.RLock()
+	defer e.propertyLock.RUnlock()
+	return e.completion
+}
+
+// acceptCompletion inserts any pending ghost text at the cursor position,
+// reporting whether a completion was accepted.
+func (e *Entry) acceptCompletion() bool {
+	e.propertyLock.Lock()
+	text := e.completion
+	if text == "" {
+		e.propertyLock.Unlock()
+		return false
+	}
+	e.completion = ""
+
+	cb := e.OnChanged
+	runes := []rune(text)
+	pos := e.cursorTextPos()
+	provider := e.textProvider()
+	provider.insertAt(pos, runes)
+
+	content := provider.String()
+	e.updateText(content, false)
+	e.CursorRow, e.CursorColumn = e.rowColFromTextPos(pos + len(runes))
+
+	e.undoStack.MergeOrAdd(&entryModifyAction{
+		Position: pos,
+		Text:     runes,
+	})
+	e.propertyLock.Unlock()
+
+	e.validate()
+	if cb != nil {
+		cb(content)
+	}
+	e.Refresh()
+	return true
+}
+
+// CompletionItem is a single suggestion offered by the completion dropdown of
+// an Entry, see SetCompletionProvider.
+//
+// Since: 2.6
+type CompletionItem struct {
+	// Text replaces the word being completed when the item is accepted.
+	Text string
+	// Description is an optional detail, such as a type or source, shown
+	// beside the text.
+	Description string
+}
+
+// SetCompletionProvider sets a callback that supplies auto-completion
+// suggestions for the current text and cursor position. While suggestions are
+// available a dropdown is shown below the cursor as the user types; the arrow
+// keys move the highlight, Tab, Return or Enter accept the highlighted
+// suggestion and Escape dismisses the dropdown. The suggestions are fuzzy
+// filtered against the partial word before the cursor, which accepting one
+// replaces. A nil provider removes the dropdown.
+//
+// Since: 2.6
+func (e *Entry) SetCompletionProvider(provider func(text string, cursor int) []CompletionItem) {
+	e.completionProvider = provider
+	if provider == nil {
+		e.hideCompletions()
+	}
+}
+
+// updateCompletions refreshes the completion dropdown for the current text
+// and cursor position, hiding it when no suggestion remains.
+func (e *Entry) updateCompletions() {
+	if e.completionProvider == nil {
+		return
+	}
+
+	e.propertyLock.RLock()
+	text := e.Text
+	cursor := e.cursorTextPos()
+	e.propertyLock.RUnlock()
+
+	items := fuzzyFilterCompletions(e.completionProvider(text, cursor), completionPattern(text, cursor))
+	if len(items) == 0 {
+		e.hideCompletions()
+		return
+	}
+	e.showCompletions(items)
+}
+
+// showCompletions opens, or refreshes, the dropdown below the cursor offering
+// the given suggestions. The entry keeps keyboard focus while it is open.
+func (e *Entry) showCompletions(items []CompletionItem) {
+	super := e.super()
+	c := fyne.CurrentApp().Driver().CanvasForObject(super)
+	if c == nil {
+		return
+	}
+
+	menuItems := make([]*fyne.MenuItem, len(items))
+	for i, item := range items {
+		item := item // capture
+		label := item.Text
+		if item.Description != "" {
+			label += " " + item.Description
+		}
+		menuItems[i] = fyne.NewMenuItem(label, func() {
+			e.acceptCompletionItem(item)
+		})
+	}
+
+	e.hideCompletions()
+	menu := NewMenu(fyne.NewMenu("", menuItems...))
+	menu.OnDismiss = e.hideCompletions
+	menu.ActivateNext() // highlight the first suggestion
+	e.completionMenu = menu
+	e.completionPopup = newCompletionPopUp(menu, c, e)
+
+	e.propertyLock.RLock()
+	pos := e.compositionCaretPos()
+	e.propertyLock.RUnlock()
+	e.completionPopup.ShowAtRelativePosition(pos, super)
+	c.Focus(e.completionPopup)
+}
+
+// hideCompletions dismisses the completion dropdown if it is showing.
+func (e *Entry) hideCompletions() {
+	if e.completionPopup != nil {
+		e.completionPopup.Hide()
+		e.completionPopup = nil
+		e.completionMenu = nil
+	}
+}
+
+// typedKeyCompletion handles the key while the completion dropdown is open,
+// reporting whether the key was consumed.
+func (e *Entry) typedKeyCompletion(key *fyne.KeyEvent) bool {
+	switch key.Name {
+	case fyne.KeyDown:
+		e.completionMenu.ActivateNext()
+	case fyne.KeyUp:
+		e.completionMenu.ActivatePrevious()
+	case fyne.KeyReturn, fyne.KeyEnter, fyne.KeyTab:
+		e.completionMenu.TriggerLast()
+	case fyne.KeyEscape:
+		e.hideCompletions()
+	default:
+		return false
+	}
+	return true
+}
+
+// acceptCompletionItem replaces the partial word before the cursor with the
+// chosen suggestion, leaving the cursor after the inserted text.
+func (e *Entry) acceptCompletionItem(item CompletionItem) {
+	e.hideCompletions()
+
+	e.propertyLock.RLock()
+	cursor := e.cursorTextPos()
+	pattern := completionPattern(e.Text, cursor)
+	e.propertyLock.RUnlock()
+
+	e.ReplaceAt(TextRange{Start: cursor - len([]rune(pattern)), End: cursor}, item.Text)
+}
+
+var _ fyne.Focusable = (*completionPopUp)(nil)
+
+// completionPopUp is the dropdown shown by SetCompletionProvider. Opening an
+// overlay moves keyboard focus into it, so the popup handles the navigation
+// keys itself and forwards everything else back to the entry, letting the
+// user keep typing while the suggestions are open.
+type completionPopUp struct {
+	PopUp
+	entry *Entry
+}
+
+func newCompletionPopUp(content fyne.CanvasObject, canvas fyne.Canvas, entry *Entry) *completionPopUp {
+	p := &completionPopUp{entry: entry}
+	p.Content = content
+	p.Canvas = canvas
+	p.ExtendBaseWidget(p)
+	return p
+}
+
+// AcceptsTab ensures the Tab key reaches TypedKey so it can accept the
+// highlighted suggestion instead of moving focus.
+func (p *completionPopUp) AcceptsTab() bool {
+	return true
+}
+
+// FocusGained is called when the popup gained focus, the entry stays focused
+// within its own scope so there is nothing to do.
+func (p *completionPopUp) FocusGained() {}
+
+// FocusLost is called when the popup lost focus.
+func (p *completionPopUp) FocusLost() {}
+
+// Hide hides the popup, removing it from the canvas overlays.
+//
+// Implements: fyne.Widget
+func (p *completionPopUp) Hide() {
+	if p.overlayShown {
+		p.Canvas.Overlays().Remove(p)
+		p.overlayShown = false
+	}
+	p.BaseWidget.Hide()
+}
+
+// Show shows the popup as an overlay of its own type, so the canvas can focus it.
+//
+// Implements: fyne.Widget
+func (p *completionPopUp) Show() {
+	if !p.overlayShown {
+		p.Canvas.Overlays().Add(p)
+		p.overlayShown = true
+	}
+	p.Refresh()
+	p.BaseWidget.Show()
+}
+
+// ShowAtPosition shows the popup at the given position.
+func (p *completionPopUp) ShowAtPosition(pos fyne.Position) {
+	p.Move(pos)
+	p.Show()
+}
+
+// ShowAtRelativePosition shows the popup at the given position relative to stated object.
+func (p *completionPopUp) ShowAtRelativePosition(rel fyne.Position, to fyne.CanvasObject) {
+	withRelativePosition(rel, to, p.ShowAtPosition)
+}
+
+// KeyDown forwards modifier state, such as shift for selecting, to the entry.
+func (p *completionPopUp) KeyDown(key *fyne.KeyEvent) {
+	p.entry.KeyDown(key)
+}
+
+// KeyUp forwards modifier state to the entry.
+func (p *completionPopUp) KeyUp(key *fyne.KeyEvent) {
+	p.entry.KeyUp(key)
+}
+
+// TypedKey navigates the suggestions and forwards any other key to the entry.
+func (p *completionPopUp) TypedKey(key *fyne.KeyEvent) {
+	if !p.entry.typedKeyCompletion(key) {
+		p.entry.TypedKey(key)
+	}
+}
+
+// TypedRune forwards typed text to the entry, which updates the suggestions.
+func (p *completionPopUp) TypedRune(r rune) {
+	p.entry.TypedRune(r)
+}
+
+// completionPattern returns the partial word immediately before the cursor,
+// which the suggestions are filtered against.
+func completionPattern(text string, cursor int) string {
+	runes := []rune(text)
+	if cursor > len(runes) {
+		cursor = len(runes)
+	}
+	start := cursor
+	for start > 0 && isWordRune(runes[start-1]) {
+		start--
+	}
+	return string(runes[start:cursor])
+}
+
+// fuzzyFilterCompletions returns the items whose text fuzzy matches pattern,
+// with the best matches first, see the data/search package.
+func fuzzyFilterCompletions(items []CompletionItem, pattern string) []CompletionItem {
+	if pattern == "" {
+		return items
+	}
+
+	texts := make([]string, len(items))
+	for i, item := range items {
+		texts[i] = item.Text
+	}
+
+	matches := search.Rank(texts, pattern, search.Fuzzy)
+	out := make([]CompletionItem, len(matches))
+	for i, match := range matches {
+		out[i] = items[match.Index]
+	}
+	return out
+}
@@ -0,0 +1,104 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntry_Completion(t *testing.T) {
+	entry := NewEntry()
+	w := test.NewWindow(entry)
+	defer w.Close()
+	w.Resize(fyne.NewSize(150, 50))
+	w.Canvas().Focus(entry)
+
+	test.Type(entry, "he")
+	entry.SetCompletion("llo")
+	assert.Equal(t, "llo", entry.Completion())
+	assert.True(t, entry.completionText.Visible())
+
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyTab})
+	assert.Equal(t, "hello", entry.Text)
+	assert.Equal(t, "", entry.Completion())
+	assert.False(t, entry.completionText.Visible())
+}
+
+func TestEntry_CompletionDismissed(t *testing.T) {
+	entry := NewEntry()
+	w := test.NewWindow(entry)
+	defer w.Close()
+	w.Resize(fyne.NewSize(150, 50))
+	w.Canvas().Focus(entry)
+
+	test.Type(entry, "go")
+	entry.SetCompletion("pher")
+
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyLeft})
+	assert.Equal(t, "", entry.Completion()) // cursor movement dismisses
+
+	entry.SetCompletion("pher")
+	test.Type(entry, "!")
+	assert.Equal(t, "", entry.Completion()) // typing dismisses
+	assert.Equal(t, "g!o", entry.Text)
+}
+
+func TestEntry_CompletionProvider(t *testing.T) {
+	entry := NewEntry()
+	w := test.NewTempWindow(t, entry)
+	w.Resize(fyne.NewSize(200, 100))
+	w.Canvas().Focus(entry)
+
+	entry.SetCompletionProvider(func(text string, cursor int) []CompletionItem {
+		return []CompletionItem{
+			{Text: "fooBar", Description: "func"},
+			{Text: "form"},
+			{Text: "other"},
+		}
+	})
+
+	entry.TypedRune('f')
+	require.NotNil(t, entry.completionPopup)
+	assert.True(t, entry.completionPopup.Visible())
+	assert.Len(t, entry.completionMenu.Items, 2, "suggestions are filtered against the typed word")
+	popup := entry.completionPopup
+	assert.Equal(t, fyne.Focusable(popup), w.Canvas().Focused(), "the dropdown forwards keys to the entry")
+
+	popup.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	popup.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, "form", entry.Text)
+	assert.Nil(t, entry.completionPopup)
+	assert.Equal(t, 4, entry.CursorColumn)
+}
+
+func TestEntry_CompletionProvider_Escape(t *testing.T) {
+	entry := NewEntry()
+	w := test.NewTempWindow(t, entry)
+	w.Canvas().Focus(entry)
+	entry.SetCompletionProvider(func(string, int) []CompletionItem {
+		return []CompletionItem{{Text: "fooBar"}}
+	})
+
+	entry.TypedRune('f')
+	require.NotNil(t, entry.completionPopup)
+
+	entry.completionPopup.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	assert.Nil(t, entry.completionPopup)
+	assert.Equal(t, "f", entry.Text, "dismissing does not change the text")
+}
+
+func TestFuzzyFilterCompletions(t *testing.T) {
+	items := []CompletionItem{{Text: "falsebanner"}, {Text: "fooBar"}, {Text: "other"}}
+
+	matched := fuzzyFilterCompletions(items, "fbr")
+	require.Len(t, matched, 2)
+	assert.Equal(t, "fooBar", matched[0].Text, "consecutive runes rank higher")
+	assert.Equal(t, "falsebanner", matched[1].Text)
+
+	assert.Equal(t, items, fuzzyFilterCompletions(items, ""), "no pattern keeps every suggestion")
+	assert.Empty(t, fuzzyFilterCompletions(items, "zzz"))
+}
@@ -0,0 +1,258 @@
+package widget
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+)
+
+// TextRange is a span of an entry's text, in rune offsets.
+//
+// Since: 2.6
+type TextRange struct {
+	Start, End int
+}
+
+// FindOptions controls how Find and ReplaceAll match their query.
+//
+// Since: 2.6
+type FindOptions struct {
+	// CaseSensitive requires the exact case of the query; matching ignores case by default.
+	CaseSensitive bool
+	// WholeWord skips matches that are part of a longer word.
+	WholeWord bool
+}
+
+// Find returns the ranges of the entry text that match the given query, in
+// order of appearance. Matches do not overlap and an empty query matches
+// nothing.
+//
+// Since: 2.6
+func (e *Entry) Find(query string, options FindOptions) []TextRange {
+	if query == "" {
+		return nil
+	}
+
+	e.propertyLock.RLock()
+	text := []rune(e.Text)
+	e.propertyLock.RUnlock()
+
+	q := []rune(query)
+	fold := func(r rune) rune {
+		if options.CaseSensitive {
+			return r
+		}
+		return unicode.ToLower(r)
+	}
+
+	var matches []TextRange
+	for i := 0; i+len(q) <= len(text); i++ {
+		found := true
+		for j, qr := range q {
+			if fold(text[i+j]) != fold(qr) {
+				found = false
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+		if options.WholeWord &&
+			((i > 0 && isWordRune(text[i-1])) || (i+len(q) < len(text) && isWordRune(text[i+len(q)]))) {
+			continue
+		}
+		matches = append(matches, TextRange{Start: i, End: i + len(q)})
+		i += len(q) - 1
+	}
+	return matches
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// HighlightRanges marks the given spans of the entry text, showing them in the
+// primary theme color the way the built-in search bar presents matches. Pass
+// nil to clear the marks. The ranges do not follow subsequent edits, so they
+// should be refreshed after the text changes.
+//
+// Since: 2.6
+func (e *Entry) HighlightRanges(ranges []TextRange) {
+	e.propertyLock.Lock()
+	e.matchRanges = ranges
+	e.propertyLock.Unlock()
+
+	e.Refresh()
+}
+
+// ReplaceAt replaces the given span of the entry text, as returned by Find,
+// with the replacement and moves the cursor to the end of the new text.
+//
+// Since: 2.6
+func (e *Entry) ReplaceAt(r TextRange, replacement string) {
+	e.propertyLock.Lock()
+	runes := []rune(e.Text)
+	if r.Start < 0 || r.Start > r.End || r.End > len(runes) {
+		e.propertyLock.Unlock()
+		return
+	}
+	changed := e.updateText(string(runes[:r.Start])+replacement+string(runes[r.End:]), false)
+	e.CursorRow, e.CursorColumn = e.rowColFromTextPos(r.Start + len([]rune(replacement)))
+	callback := e.OnChanged
+	text := e.Text
+	e.propertyLock.Unlock()
+
+	e.validate()
+	if changed && callback != nil {
+		callback(text)
+	}
+	e.Refresh()
+}
+
+// ReplaceAll replaces every match of the query with the replacement in a
+// single text update, returning the number of replacements made.
+//
+// Since: 2.6
+func (e *Entry) ReplaceAll(query, replacement string, options FindOptions) int {
+	matches := e.Find(query, options)
+	if len(matches) == 0 {
+		return 0
+	}
+
+	e.propertyLock.Lock()
+	runes := []rune(e.Text)
+	var content strings.Builder
+	pos := 0
+	for _, m := range matches {
+		content.WriteString(string(runes[pos:m.Start]))
+		content.WriteString(replacement)
+		pos = m.End
+	}
+	content.WriteString(string(runes[pos:]))
+	changed := e.updateText(content.String(), false)
+	last := matches[len(matches)-1]
+	offset := (len([]rune(replacement)) - (last.End - last.Start)) * len(matches)
+	e.CursorRow, e.CursorColumn = e.rowColFromTextPos(last.End + offset)
+	callback := e.OnChanged
+	text := e.Text
+	e.propertyLock.Unlock()
+
+	e.validate()
+	if changed && callback != nil {
+		callback(text)
+	}
+	e.Refresh()
+	return len(matches)
+}
+
+// matchRuns returns the style runs that render the spans marked with
+// HighlightRanges.
+// It assumes that a lock exists on the widget.
+func (e *Entry) matchRuns() []entryStyleRun {
+	style := RichTextStyle{ColorName: theme.ColorNamePrimary, TextStyle: fyne.TextStyle{Bold: true}}
+	runs := make([]entryStyleRun, 0, len(e.matchRanges))
+	for _, m := range e.matchRanges {
+		if m.Start < 0 || m.End <= m.Start {
+			continue
+		}
+		runs = append(runs, entryStyleRun{start: m.Start, end: m.End, style: style})
+	}
+	sort.Slice(runs, func(i, j int) bool { return runs[i].start < runs[j].start })
+	return normalizeStyleRuns(runs)
+}
+
+// ShowSearchBar opens a find and replace bar over the top trailing corner of
+// the entry, highlighting matches as the search term is typed. It is
+// dismissed with its close button or by tapping elsewhere.
+//
+// Since: 2.6
+func (e *Entry) ShowSearchBar() {
+	c := fyne.CurrentApp().Driver().CanvasForObject(e.super())
+	if c == nil {
+		return
+	}
+	if e.searchBar != nil {
+		e.searchBar.Show()
+		return
+	}
+
+	var matches []TextRange
+	current := 0
+	count := NewLabel("")
+	focusMatch := func() {
+		if len(matches) == 0 {
+			count.SetText("")
+			return
+		}
+		count.SetText(fmt.Sprintf("%d/%d", current+1, len(matches)))
+
+		e.propertyLock.Lock()
+		e.CursorRow, e.CursorColumn = e.rowColFromTextPos(matches[current].Start)
+		e.propertyLock.Unlock()
+		e.Refresh()
+	}
+
+	query := NewEntry()
+	query.PlaceHolder = "Find"
+	replace := NewEntry()
+	replace.PlaceHolder = "Replace with"
+	search := func(string) {
+		matches = e.Find(query.Text, FindOptions{})
+		current = 0
+		e.HighlightRanges(matches)
+		focusMatch()
+	}
+	query.OnChanged = search
+
+	previous := NewButtonWithIcon("", theme.MoveUpIcon(), func() {
+		if len(matches) > 0 {
+			current = (current + len(matches) - 1) % len(matches)
+			focusMatch()
+		}
+	})
+	next := NewButtonWithIcon("", theme.MoveDownIcon(), func() {
+		if len(matches) > 0 {
+			current = (current + 1) % len(matches)
+			focusMatch()
+		}
+	})
+	dismiss := NewButtonWithIcon("", theme.CancelIcon(), func() {
+		e.HideSearchBar()
+	})
+	replaceOne := NewButton("Replace", func() {
+		if current < len(matches) {
+			e.ReplaceAt(matches[current], replace.Text)
+			search(query.Text)
+		}
+	})
+	replaceAll := NewButton("All", func() {
+		e.ReplaceAll(query.Text, replace.Text, FindOptions{})
+		search(query.Text)
+	})
+
+	content := &fyne.Container{Layout: layout.NewVBoxLayout(), Objects: []fyne.CanvasObject{
+		&fyne.Container{Layout: layout.NewHBoxLayout(), Objects: []fyne.CanvasObject{query, count, previous, next, dismiss}},
+		&fyne.Container{Layout: layout.NewHBoxLayout(), Objects: []fyne.CanvasObject{replace, replaceOne, replaceAll}},
+	}}
+
+	e.searchBar = NewPopUp(content, c)
+	e.searchBar.ShowAtRelativePosition(fyne.NewPos(e.Size().Width-content.MinSize().Width, 0), e.super())
+	c.Focus(query)
+}
+
+// HideSearchBar dismisses the search bar opened with ShowSearchBar and clears
+// the highlighted matches.
+//
+// Since: 2.6
+func (e *Entry) HideSearchBar() {
+	if e.searchBar == nil {
+		return
+	}
+	e.searchBar.Hide()
+	e.HighlightRanges(nil)
+}
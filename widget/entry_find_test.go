@@ -0,0 +1,92 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+)
+
+func TestEntry_Find(t *testing.T) {
+	entry := NewMultiLineEntry()
+	entry.SetText("Fyne is fun.\nfyne.io hosts Fyne")
+
+	assert.Equal(t, []TextRange{{Start: 0, End: 4}, {Start: 13, End: 17}, {Start: 27, End: 31}},
+		entry.Find("fyne", FindOptions{}))
+	assert.Equal(t, []TextRange{{Start: 0, End: 4}, {Start: 27, End: 31}},
+		entry.Find("Fyne", FindOptions{CaseSensitive: true}))
+	assert.Empty(t, entry.Find("yne", FindOptions{WholeWord: true}))
+	assert.Empty(t, entry.Find("", FindOptions{}))
+}
+
+func TestEntry_Find_NoOverlap(t *testing.T) {
+	entry := NewEntry()
+	entry.SetText("aaaa")
+
+	assert.Equal(t, []TextRange{{Start: 0, End: 2}, {Start: 2, End: 4}}, entry.Find("aa", FindOptions{}))
+}
+
+func TestEntry_HighlightRanges(t *testing.T) {
+	entry := NewEntry()
+	entry.SetText("find me here")
+
+	entry.HighlightRanges(entry.Find("me", FindOptions{}))
+	segs := entry.textProvider().Segments
+	if assert.Len(t, segs, 3) {
+		assert.Equal(t, "me", segs[1].(*TextSegment).Text)
+		assert.Equal(t, theme.ColorNamePrimary, segs[1].(*TextSegment).Style.ColorName)
+	}
+
+	entry.HighlightRanges(nil)
+	assert.Len(t, entry.textProvider().Segments, 1)
+}
+
+func TestEntry_ReplaceAt(t *testing.T) {
+	entry := NewEntry()
+	entry.SetText("hello world")
+
+	entry.ReplaceAt(TextRange{Start: 6, End: 11}, "fyne")
+	assert.Equal(t, "hello fyne", entry.Text)
+	assert.Equal(t, 10, entry.CursorColumn)
+
+	entry.ReplaceAt(TextRange{Start: 8, End: 20}, "x") // out of range is ignored
+	assert.Equal(t, "hello fyne", entry.Text)
+}
+
+func TestEntry_ReplaceAll(t *testing.T) {
+	entry := NewMultiLineEntry()
+	entry.Wrapping = fyne.TextWrapOff
+	entry.SetText("one two\ntwo three")
+	changes := 0
+	entry.OnChanged = func(string) { changes++ }
+
+	assert.Equal(t, 2, entry.ReplaceAll("two", "2", FindOptions{}))
+	assert.Equal(t, "one 2\n2 three", entry.Text)
+	assert.Equal(t, 1, changes, "a single update for all replacements")
+
+	assert.Zero(t, entry.ReplaceAll("missing", "x", FindOptions{}))
+}
+
+func TestEntry_ShowSearchBar(t *testing.T) {
+	entry := NewMultiLineEntry()
+	w := test.NewTempWindow(t, entry)
+	w.Resize(fyne.NewSize(300, 200))
+
+	entry.SetText("find me, find us")
+	entry.ShowSearchBar()
+	if !assert.NotNil(t, entry.searchBar) {
+		return
+	}
+	assert.True(t, entry.searchBar.Visible())
+
+	query := entry.searchBar.Content.(*fyne.Container).Objects[0].(*fyne.Container).Objects[0].(*Entry)
+	query.SetText("find")
+	assert.Len(t, entry.matchRanges, 2)
+
+	entry.HideSearchBar()
+	assert.False(t, entry.searchBar.Visible())
+	assert.Empty(t, entry.matchRanges)
+}
@@ -0,0 +1,208 @@
+package widget
+
+import (
+	"image/color"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/theme"
+)
+
+// GutterProvider supplies the content of the gutter shown along the leading
+// edge of a multiline Entry, such as line numbers, breakpoint dots or diff
+// markers, as the foundation for code editor style entries.
+//
+// Since: 2.6
+type GutterProvider interface {
+	// GutterText returns the text shown beside the given zero-indexed line,
+	// or "" for none.
+	GutterText(line int) string
+	// GutterIcon returns an icon shown beside the given zero-indexed line,
+	// or nil for none.
+	GutterIcon(line int) fyne.Resource
+}
+
+// NewLineNumberGutter returns a GutterProvider that labels every line with its
+// one-based number, for use in the Gutter field of a multiline Entry.
+//
+// Since: 2.6
+func NewLineNumberGutter() GutterProvider {
+	return lineNumberGutter{}
+}
+
+type lineNumberGutter struct{}
+
+func (lineNumberGutter) GutterText(line int) string {
+	return strconv.Itoa(line + 1)
+}
+
+func (lineNumberGutter) GutterIcon(int) fyne.Resource {
+	return nil
+}
+
+// entryGutter renders the annotation column of an Entry with a GutterProvider,
+// tracking the vertical scroll position of the content beside it.
+type entryGutter struct {
+	BaseWidget
+
+	entry *Entry
+}
+
+func newEntryGutter(e *Entry) *entryGutter {
+	g := &entryGutter{entry: e}
+	g.ExtendBaseWidget(g)
+	return g
+}
+
+// width returns the horizontal space the gutter needs for its current content.
+func (g *entryGutter) width() float32 {
+	provider := g.entry.Gutter
+	if provider == nil {
+		return 0
+	}
+
+	th := g.entry.Theme()
+	lines := strings.Count(g.entry.Text, "\n") + 1
+	longest := ""
+	hasIcon := false
+	for line := 0; line < lines; line++ {
+		if text := provider.GutterText(line); len(text) > len(longest) {
+			longest = text
+		}
+		hasIcon = hasIcon || provider.GutterIcon(line) != nil
+	}
+
+	width := fyne.MeasureText(longest, th.Size(theme.SizeNameText), g.entry.TextStyle).Width
+	if hasIcon {
+		width += th.Size(theme.SizeNameInlineIcon) + th.Size(theme.SizeNameLineSpacing)
+	}
+	return width + th.Size(theme.SizeNameInnerPadding)
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (g *entryGutter) CreateRenderer() fyne.WidgetRenderer {
+	g.ExtendBaseWidget(g)
+
+	r := &entryGutterRenderer{gutter: g,
+		background: canvas.NewRectangle(color.Transparent), divider: canvas.NewRectangle(color.Transparent)}
+	r.SetObjects([]fyne.CanvasObject{r.background, r.divider})
+	r.Refresh()
+	return r
+}
+
+// gutterLineRows returns the first visual row of every logical line of the
+// entry, accounting for soft wrapping. The caller must hold the entry read lock.
+func gutterLineRows(e *Entry) []int {
+	rows := make([]int, strings.Count(e.Text, "\n")+1)
+	if e.Wrapping == fyne.TextWrapOff {
+		for i := range rows {
+			rows[i] = i
+		}
+		return rows
+	}
+
+	line := 0
+	for i, r := range []rune(e.Text) {
+		if r != '\n' {
+			continue
+		}
+		line++
+		rows[line], _ = e.rowColFromTextPos(i + 1)
+	}
+	return rows
+}
+
+var _ fyne.WidgetRenderer = (*entryGutterRenderer)(nil)
+
+type entryGutterRenderer struct {
+	widget.BaseRenderer
+	background, divider *canvas.Rectangle
+
+	texts  []*canvas.Text
+	icons  []*canvas.Image
+	gutter *entryGutter
+}
+
+func (r *entryGutterRenderer) Layout(size fyne.Size) {
+	thickness := r.gutter.Theme().Size(theme.SizeNameSeparatorThickness)
+	r.background.Resize(size)
+	r.divider.Move(fyne.NewPos(size.Width-thickness, 0))
+	r.divider.Resize(fyne.NewSize(thickness, size.Height))
+}
+
+func (r *entryGutterRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(r.gutter.width(), 0)
+}
+
+func (r *entryGutterRenderer) Refresh() {
+	provider := r.gutter.entry.Gutter
+	if provider == nil {
+		return
+	}
+
+	e := r.gutter.entry
+	th := r.gutter.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	textSize := th.Size(theme.SizeNameText)
+	innerPad := th.Size(theme.SizeNameInnerPadding)
+	inputBorder := th.Size(theme.SizeNameInputBorder)
+	iconSize := th.Size(theme.SizeNameInlineIcon)
+
+	e.propertyLock.RLock()
+	style := e.TextStyle
+	lineHeight := e.textProvider().charMinSize(e.Password, style, textSize).Height
+	offsetY := float32(0)
+	if e.scroll != nil && e.scroll.Content != nil {
+		offsetY = e.scroll.Offset.Y
+	}
+	lineRows := gutterLineRows(e)
+	e.propertyLock.RUnlock()
+
+	r.background.FillColor = th.Color(theme.ColorNameHeaderBackground, v)
+	r.background.Refresh()
+	r.divider.FillColor = th.Color(theme.ColorNameSeparator, v)
+	r.divider.Refresh()
+
+	size := r.gutter.Size()
+	objects := []fyne.CanvasObject{r.background, r.divider}
+	texts, images := 0, 0
+	for line, row := range lineRows {
+		y := innerPad - inputBorder + lineHeight*float32(row) - offsetY
+		if y+lineHeight < 0 || y > size.Height {
+			continue // scrolled out of view
+		}
+
+		if texts >= len(r.texts) {
+			r.texts = append(r.texts, canvas.NewText("", color.Transparent))
+		}
+		label := r.texts[texts]
+		texts++
+		label.Text = provider.GutterText(line)
+		label.TextSize = textSize
+		label.TextStyle = style
+		label.Color = th.Color(theme.ColorNamePlaceHolder, v)
+		label.Alignment = fyne.TextAlignTrailing
+		label.Move(fyne.NewPos(0, y))
+		label.Resize(fyne.NewSize(size.Width-innerPad/2, lineHeight))
+		objects = append(objects, label)
+
+		if res := provider.GutterIcon(line); res != nil {
+			if images >= len(r.icons) {
+				icon := canvas.NewImageFromResource(nil)
+				icon.FillMode = canvas.ImageFillContain
+				r.icons = append(r.icons, icon)
+			}
+			icon := r.icons[images]
+			images++
+			icon.Resource = res
+			icon.Move(fyne.NewPos(innerPad/4, y+(lineHeight-iconSize)/2))
+			icon.Resize(fyne.NewSquareSize(iconSize))
+			objects = append(objects, icon)
+		}
+	}
+	r.SetObjects(objects)
+	canvas.Refresh(r.gutter.super())
+}
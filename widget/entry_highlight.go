@@ -0,0 +1,137 @@
+package widget
+
+import (
+	"sort"
+	"strings"
+)
+
+// Highlighter computes syntax styling for the content of an Entry, so a lexer
+// such as chroma can be plugged in to build a code editor. Lines are
+// highlighted independently and the result is cached, so only the lines whose
+// text changed since the previous refresh are lexed again as the user types.
+//
+// Since: 2.6
+type Highlighter interface {
+	// HighlightLine returns the styled spans of the given line of text,
+	// which is passed without its trailing newline.
+	HighlightLine(line string) []HighlightRange
+}
+
+// HighlightRange is one styled span returned by a Highlighter, with Start and
+// End rune offsets within the line it was computed for.
+//
+// Since: 2.6
+type HighlightRange struct {
+	Start, End int
+	Style      RichTextStyle
+}
+
+// entryHighlightLine caches the highlight result for one line of the entry,
+// so unchanged lines are not lexed again on every edit.
+type entryHighlightLine struct {
+	text string
+	runs []HighlightRange
+}
+
+// highlightRuns returns the style runs computed by the entry's Highlighter,
+// lexing only the lines whose text changed since the last call.
+// It assumes that a lock exists on the widget.
+func (e *Entry) highlightRuns() []entryStyleRun {
+	if e.highlighter != e.Highlighter { // a replaced highlighter invalidates every line
+		e.highlightCache = nil
+		e.highlighter = e.Highlighter
+	}
+
+	lines := strings.Split(e.Text, "\n")
+	if len(e.highlightCache) > len(lines) {
+		e.highlightCache = e.highlightCache[:len(lines)]
+	}
+
+	var runs []entryStyleRun
+	offset := 0
+	for i, line := range lines {
+		if i >= len(e.highlightCache) {
+			e.highlightCache = append(e.highlightCache, entryHighlightLine{text: line, runs: e.Highlighter.HighlightLine(line)})
+		} else if e.highlightCache[i].text != line {
+			e.highlightCache[i] = entryHighlightLine{text: line, runs: e.Highlighter.HighlightLine(line)}
+		}
+
+		length := len([]rune(line))
+		for _, r := range e.highlightCache[i].runs {
+			start, end := r.Start, r.End
+			if end > length {
+				end = length
+			}
+			if start < 0 || start >= end {
+				continue
+			}
+			runs = append(runs, entryStyleRun{start: offset + start, end: offset + end, style: r.Style})
+		}
+		offset += length + 1 // the newline that ended this line
+	}
+	return normalizeStyleRuns(runs)
+}
+
+// overlayStyleRuns layers the runs applied with ApplyStyle and ApplyLink over
+// the highlight runs, combining styles where they overlap with the applied
+// styling taking priority.
+func overlayStyleRuns(base, over []entryStyleRun) []entryStyleRun {
+	if len(over) == 0 {
+		return base
+	}
+	if len(base) == 0 {
+		return over
+	}
+
+	bounds := make([]int, 0, (len(base)+len(over))*2)
+	for _, run := range base {
+		bounds = append(bounds, run.start, run.end)
+	}
+	for _, run := range over {
+		bounds = append(bounds, run.start, run.end)
+	}
+	sort.Ints(bounds)
+
+	at := func(runs []entryStyleRun, pos int) *entryStyleRun {
+		for i := range runs {
+			if runs[i].start <= pos && pos < runs[i].end {
+				return &runs[i]
+			}
+		}
+		return nil
+	}
+
+	out := make([]entryStyleRun, 0, len(bounds))
+	for i := 0; i < len(bounds)-1; i++ {
+		start, end := bounds[i], bounds[i+1]
+		if start >= end {
+			continue
+		}
+		b, o := at(base, start), at(over, start)
+		if b == nil && o == nil {
+			continue
+		}
+
+		run := entryStyleRun{start: start, end: end}
+		if b != nil {
+			run.style = b.style
+		}
+		if o != nil {
+			run.style.TextStyle.Bold = run.style.TextStyle.Bold || o.style.TextStyle.Bold
+			run.style.TextStyle.Italic = run.style.TextStyle.Italic || o.style.TextStyle.Italic
+			run.style.TextStyle.Underline = run.style.TextStyle.Underline || o.style.TextStyle.Underline
+			run.style.TextStyle.Monospace = run.style.TextStyle.Monospace || o.style.TextStyle.Monospace
+			if o.style.ColorName != "" {
+				run.style.ColorName = o.style.ColorName
+			}
+			if o.style.SizeName != "" {
+				run.style.SizeName = o.style.SizeName
+			}
+			if o.url != nil {
+				run.url = o.url
+			}
+		}
+		out = append(out, run)
+	}
+	return normalizeStyleRuns(out)
+}
@@ -0,0 +1,77 @@
+package widget
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// keywordHighlighter marks every "func" in a line, counting how many lines
+// were lexed to verify the damage tracking.
+type keywordHighlighter struct {
+	lexed int
+}
+
+func (h *keywordHighlighter) HighlightLine(line string) []HighlightRange {
+	h.lexed++
+	if i := strings.Index(line, "func"); i != -1 {
+		return []HighlightRange{{Start: i, End: i + 4,
+			Style: RichTextStyle{ColorName: theme.ColorNamePrimary, TextStyle: fyne.TextStyle{Bold: true}}}}
+	}
+	return nil
+}
+
+func TestEntry_Highlighter(t *testing.T) {
+	entry := NewMultiLineEntry()
+	highlighter := &keywordHighlighter{}
+	entry.Highlighter = highlighter
+	entry.SetText("func a() {\n}")
+
+	segs := entry.textProvider().Segments
+	if assert.Len(t, segs, 2) {
+		assert.Equal(t, "func", segs[0].(*TextSegment).Text)
+		assert.True(t, segs[0].(*TextSegment).Style.TextStyle.Bold)
+		assert.Equal(t, theme.ColorNamePrimary, segs[0].(*TextSegment).Style.ColorName)
+		assert.False(t, segs[1].(*TextSegment).Style.TextStyle.Bold)
+	}
+	assert.Equal(t, 2, highlighter.lexed)
+}
+
+func TestEntry_Highlighter_DamageTracking(t *testing.T) {
+	entry := NewMultiLineEntry()
+	entry.Wrapping = fyne.TextWrapOff
+	highlighter := &keywordHighlighter{}
+	entry.Highlighter = highlighter
+	entry.SetText("func a() {\n}\nfunc b() {\n}")
+	lexed := highlighter.lexed
+
+	entry.CursorRow, entry.CursorColumn = 3, 1
+	entry.TypedRune('!')
+
+	assert.Equal(t, "func a() {\n}\nfunc b() {\n}!", entry.Text)
+	assert.Equal(t, lexed+1, highlighter.lexed, "only the edited line is lexed again")
+}
+
+func TestEntry_Highlighter_OverlaysAppliedStyle(t *testing.T) {
+	entry := NewMultiLineEntry()
+	entry.Highlighter = &keywordHighlighter{}
+	entry.SetText("func a() {")
+
+	selectEntryRange(entry, 0, 0, 0, 6)
+	entry.ApplyStyle(RichTextStyle{TextStyle: fyne.TextStyle{Italic: true}})
+
+	segs := entry.textProvider().Segments
+	if assert.Len(t, segs, 3) {
+		assert.Equal(t, "func", segs[0].(*TextSegment).Text)
+		assert.True(t, segs[0].(*TextSegment).Style.TextStyle.Bold, "the highlight shows through the applied style")
+		assert.True(t, segs[0].(*TextSegment).Style.TextStyle.Italic)
+		assert.Equal(t, " a", segs[1].(*TextSegment).Text)
+		assert.False(t, segs[1].(*TextSegment).Style.TextStyle.Bold)
+		assert.True(t, segs[1].(*TextSegment).Style.TextStyle.Italic)
+		assert.False(t, segs[2].(*TextSegment).Style.TextStyle.Italic)
+	}
+}
@@ -0,0 +1,141 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+)
+
+// SetComposingText updates the composition (pre-edit) string that an input
+// method, such as a CJK IME, is assembling at the cursor. The string is shown
+// underlined at the cursor position but is presentation only — it does not
+// become part of Text until the input method commits it through TypedRune.
+// cursor is the rune offset of the composition caret within text, and an
+// empty text clears the preview. Composition is ignored for Password entries.
+//
+// Since: 2.6
+func (e *Entry) SetComposingText(text string, cursor int) {
+	e.propertyLock.Lock()
+	if e.composition == "" && text != "" {
+		e.compositionStart = e.textPosFromRowCol(e.CursorRow, e.CursorColumn)
+	}
+	length := len([]rune(text))
+	if cursor < 0 {
+		cursor = 0
+	} else if cursor > length {
+		cursor = length
+	}
+	e.composition = text
+	e.compositionCursor = cursor
+	e.propertyLock.Unlock()
+
+	e.Refresh()
+	e.notifyInputMethod()
+}
+
+// Composition returns the pre-edit string currently shown at the cursor, or an
+// empty string when no input method composition is in progress.
+//
+// Since: 2.6
+func (e *Entry) Composition() string {
+	e.propertyLock.RLock()
+	defer e.propertyLock.RUnlock()
+
+	return e.composition
+}
+
+// displayText returns the text shown by the entry, which is the content with
+// any composition (pre-edit) string inserted where it is being assembled.
+// It assumes that a lock exists on the widget.
+func (e *Entry) displayText() string {
+	if e.composition == "" || e.Password {
+		return e.Text
+	}
+
+	runes := []rune(e.Text)
+	pos := e.compositionStart
+	if pos > len(runes) {
+		pos = len(runes)
+	}
+	return string(runes[:pos]) + e.composition + string(runes[pos:])
+}
+
+// compositionRuns returns the style run underlining the composition string
+// within the display text.
+// It assumes that a lock exists on the widget.
+func (e *Entry) compositionRuns() []entryStyleRun {
+	return []entryStyleRun{{
+		start: e.compositionStart,
+		end:   e.compositionStart + len([]rune(e.composition)),
+		style: RichTextStyle{TextStyle: fyne.TextStyle{Underline: true}},
+	}}
+}
+
+// compositionShifted moves the given style runs out of the way of the
+// composition string inserted into the display text.
+// It assumes that a lock exists on the widget.
+func (e *Entry) compositionShifted(runs []entryStyleRun) []entryStyleRun {
+	length := len([]rune(e.composition))
+	out := make([]entryStyleRun, len(runs))
+	for i, run := range runs {
+		if run.start >= e.compositionStart {
+			run.start += length
+		}
+		if run.end > e.compositionStart {
+			run.end += length
+		}
+		out[i] = run
+	}
+	return out
+}
+
+// compositionCaretPos returns the position of the composition caret, or of the
+// text cursor when no composition is in progress, relative to the entry.
+// It assumes that a lock exists on the widget.
+func (e *Entry) compositionCaretPos() fyne.Position {
+	th := e.Theme()
+	textSize := th.Size(theme.SizeNameText)
+	innerPad := th.Size(theme.SizeNameInnerPadding)
+
+	provider := e.textProvider()
+	row, col := e.CursorRow, e.CursorColumn
+	if e.composition != "" {
+		row, col = e.rowColFromTextPos(e.compositionStart + e.compositionCursor)
+	}
+
+	size := provider.lineSizeToColumn(col, row, textSize, innerPad)
+	x := size.Width
+	if g := e.gutter; g != nil && g.Visible() {
+		x += g.width() // the content is inset beside the gutter column
+	}
+	return fyne.NewPos(x, size.Height*float32(row+1)+innerPad).SubtractXY(e.scroll.Offset.X, e.scroll.Offset.Y)
+}
+
+// notifyInputMethod reports the position of the composition caret to the
+// OnCompositionChanged callback and, when the driver supports it, to the
+// input method so its candidate window follows the caret.
+func (e *Entry) notifyInputMethod() {
+	app := fyne.CurrentApp()
+	if app == nil {
+		return
+	}
+	driver := app.Driver()
+	handler, handled := driver.(desktop.InputMethodHandler)
+	callback := e.OnCompositionChanged
+	if callback == nil && !handled {
+		return
+	}
+
+	e.propertyLock.RLock()
+	pos := e.compositionCaretPos()
+	e.propertyLock.RUnlock()
+
+	if callback != nil {
+		callback(pos)
+	}
+	if handled {
+		if c := driver.CanvasForObject(e.super()); c != nil {
+			handler.SetInputMethodPosition(c, driver.AbsolutePositionForObject(e.super()).Add(pos))
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntry_SetComposingText(t *testing.T) {
+	entry := NewEntry()
+	entry.SetText("ab")
+	entry.CursorColumn = 1
+
+	entry.SetComposingText("かな", 2)
+	assert.Equal(t, "かな", entry.Composition())
+	assert.Equal(t, "ab", entry.Text, "composition is not part of the content")
+
+	segments := entry.textProvider().Segments
+	assert.Len(t, segments, 3)
+	preedit := segments[1].(*TextSegment)
+	assert.Equal(t, "かな", preedit.Text)
+	assert.True(t, preedit.Style.TextStyle.Underline)
+
+	// committing replaces the preview with typed text
+	entry.SetComposingText("", 0)
+	entry.TypedRune('仮')
+	entry.TypedRune('名')
+	assert.Equal(t, "", entry.Composition())
+	assert.Equal(t, "a仮名b", entry.Text)
+	assert.Len(t, entry.textProvider().Segments, 1)
+}
+
+func TestEntry_SetComposingText_Password(t *testing.T) {
+	entry := NewPasswordEntry()
+	entry.SetText("secret")
+
+	entry.SetComposingText("かな", 0)
+	segment := entry.textProvider().Segments[0].(*TextSegment)
+	assert.Equal(t, "secret", segment.Text, "concealed text shows no composition")
+}
+
+func TestEntry_OnCompositionChanged(t *testing.T) {
+	entry := NewEntry()
+	test.NewTempWindow(t, entry).Resize(fyne.NewSize(150, 50))
+
+	var moved []fyne.Position
+	entry.OnCompositionChanged = func(pos fyne.Position) {
+		moved = append(moved, pos)
+	}
+
+	entry.SetText("ab")
+	entry.SetComposingText("か", 1)
+	if assert.NotEmpty(t, moved) {
+		first := moved[len(moved)-1]
+
+		moved = nil
+		entry.SetComposingText("かな", 2)
+		if assert.NotEmpty(t, moved) {
+			assert.Greater(t, moved[len(moved)-1].X, first.X, "the caret follows the composition")
+		}
+	}
+}
@@ -457,3 +457,76 @@ func getClickPosition(str string, row int) *fyne.PointEvent {
 	pos := fyne.NewPos(x+2, y)
 	return &fyne.PointEvent{Position: pos}
 }
+
+type testGutterProvider struct{}
+
+func (testGutterProvider) GutterText(line int) string {
+	if line == 1 {
+		return "+"
+	}
+	return ""
+}
+
+func (testGutterProvider) GutterIcon(line int) fyne.Resource {
+	if line == 2 {
+		return theme.MediaRecordIcon()
+	}
+	return nil
+}
+
+func TestEntry_Gutter(t *testing.T) {
+	entry := NewMultiLineEntry()
+	entry.Gutter = NewLineNumberGutter()
+	w := test.NewTempWindow(t, entry)
+	w.Resize(fyne.NewSize(150, 100))
+	entry.SetText("one\ntwo\nthree")
+
+	assert.NotNil(t, entry.gutter)
+	assert.True(t, entry.gutter.Visible())
+	assert.Greater(t, entry.gutter.Size().Width, float32(0))
+
+	gr := test.TempWidgetRenderer(t, entry.gutter).(*entryGutterRenderer)
+	var labels []string
+	for _, o := range gr.Objects() {
+		if text, ok := o.(*canvas.Text); ok {
+			labels = append(labels, text.Text)
+		}
+	}
+	assert.Equal(t, []string{"1", "2", "3"}, labels)
+
+	// the text content is inset beside the gutter column
+	r := cache.Renderer(entry).(*entryRenderer)
+	assert.Equal(t, entry.gutter.Size().Width, r.scroll.Position().X)
+}
+
+func TestEntry_Gutter_Icons(t *testing.T) {
+	entry := NewMultiLineEntry()
+	entry.Gutter = testGutterProvider{}
+	w := test.NewTempWindow(t, entry)
+	w.Resize(fyne.NewSize(150, 100))
+	entry.SetText("one\ntwo\nthree")
+
+	gr := test.TempWidgetRenderer(t, entry.gutter).(*entryGutterRenderer)
+	var labels []string
+	icons := 0
+	for _, o := range gr.Objects() {
+		switch m := o.(type) {
+		case *canvas.Text:
+			labels = append(labels, m.Text)
+		case *canvas.Image:
+			icons++
+			assert.Equal(t, theme.MediaRecordIcon(), m.Resource)
+		}
+	}
+	assert.Equal(t, []string{"", "+", ""}, labels)
+	assert.Equal(t, 1, icons)
+}
+
+func TestEntry_Gutter_SingleLine(t *testing.T) {
+	entry := NewEntry()
+	entry.Gutter = NewLineNumberGutter()
+	test.NewTempWindow(t, entry)
+	entry.SetText("one")
+
+	assert.Nil(t, entry.gutter)
+}
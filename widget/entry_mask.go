@@ -0,0 +1,123 @@
+package widget
+
+import "unicode"
+
+// maskSlot returns whether the mask rune is an open slot for user input,
+// rather than a literal that is filled in automatically.
+func maskSlot(m rune) bool {
+	return m == '9' || m == 'A' || m == '*'
+}
+
+// maskAccepts returns whether the rune fits the given mask slot.
+func maskAccepts(m, r rune) bool {
+	switch m {
+	case '9':
+		return unicode.IsDigit(r)
+	case 'A':
+		return unicode.IsLetter(r)
+	case '*':
+		return unicode.IsGraphic(r)
+	}
+	return false
+}
+
+// maskFormat runs input through the mask pattern, returning the formatted
+// runes, the formatted position just after each accepted input rune, and the
+// accepted input runes themselves. Input runes that do not fit the next open
+// slot are skipped, which both restricts typing and discards the literals of
+// already formatted text when it passes through again.
+func maskFormat(mask, input []rune) (masked []rune, rawEnds []int, raw []rune) {
+	i := 0
+	for _, m := range mask {
+		if !maskSlot(m) {
+			masked = append(masked, m)
+			continue
+		}
+
+		for i < len(input) && !maskAccepts(m, input[i]) {
+			i++
+		}
+		if i >= len(input) {
+			break
+		}
+		masked = append(masked, input[i])
+		raw = append(raw, input[i])
+		rawEnds = append(rawEnds, len(masked))
+		i++
+	}
+
+	if len(raw) == 0 { // nothing valid was entered, so show no literals either
+		return nil, nil, nil
+	}
+	return masked, rawEnds, raw
+}
+
+// applyMask reformats a proposed edit of the entry content through the input
+// mask, recording where the cursor should land once the change is shown.
+func (e *Entry) applyMask(old, proposed string) string {
+	mask := []rune(e.InputMask)
+	o, p := []rune(old), []rune(proposed)
+
+	masked, rawEnds, raw := maskFormat(mask, p)
+	prefix := 0
+	for prefix < len(o) && prefix < len(p) && o[prefix] == p[prefix] {
+		prefix++
+	}
+
+	if string(masked) == old && len(p) < len(o) {
+		// deleting only literals would reformat to the same text,
+		// so delete the raw character before them as well
+		k := 0
+		for k < len(rawEnds) && rawEnds[k] <= prefix {
+			k++
+		}
+		if k > 0 {
+			k--
+			raw = append(raw[:k], raw[k+1:]...)
+		}
+		masked, rawEnds, _ = maskFormat(mask, raw)
+
+		pos := 0
+		if k > 0 && k <= len(rawEnds) {
+			pos = rawEnds[k-1]
+		}
+		e.maskCursor = pos + 1
+		return string(masked)
+	}
+
+	// place the cursor after the last raw character of the edited text,
+	// skipping past any literals filled in behind it
+	suffix := 0
+	for suffix < len(o)-prefix && suffix < len(p)-prefix && o[len(o)-1-suffix] == p[len(p)-1-suffix] {
+		suffix++
+	}
+	_, _, rawTyped := maskFormat(mask, p[:len(p)-suffix])
+	pos := 0
+	if k := len(rawTyped); k > 0 && k <= len(rawEnds) {
+		pos = rawEnds[k-1]
+	}
+	isSlot := make([]bool, len(masked))
+	for _, end := range rawEnds {
+		isSlot[end-1] = true
+	}
+	for pos < len(masked) && !isSlot[pos] {
+		pos++
+	}
+	e.maskCursor = pos + 1
+	return string(masked)
+}
+
+// UnmaskedText returns the entry content with the InputMask literals removed,
+// leaving only the characters the user entered.
+//
+// Since: 2.6
+func (e *Entry) UnmaskedText() string {
+	e.propertyLock.RLock()
+	defer e.propertyLock.RUnlock()
+
+	if e.InputMask == "" {
+		return e.Text
+	}
+	_, _, raw := maskFormat([]rune(e.InputMask), []rune(e.Text))
+	return string(raw)
+}
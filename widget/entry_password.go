@@ -1,21 +1,68 @@
 package widget
 
 import (
+	"image/color"
+	"time"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/driver/mobile"
 	"fyne.io/fyne/v2/theme"
 )
 
+// PasswordReveal describes how the revealer action item of a password entry
+// behaves, see Entry.PasswordReveal.
+//
+// Since: 2.6
+type PasswordReveal int
+
+const (
+	// PasswordRevealToggle shows the content until the revealer is tapped again.
+	PasswordRevealToggle PasswordReveal = iota
+	// PasswordRevealHold shows the content only while the revealer is held down.
+	PasswordRevealHold
+	// PasswordRevealTimed conceals the content again automatically after
+	// Entry.PasswordRevealTimeout has passed.
+	PasswordRevealTimed
+)
+
+// passwordRevealTimeout is how long PasswordRevealTimed leaves the content
+// visible when Entry.PasswordRevealTimeout is not set.
+const passwordRevealTimeout = 5 * time.Second
+
+// WipeText clears the entry and overwrites the rune buffers held in the edit
+// history before releasing them, so a secret value does not linger in memory
+// for the garbage collector to find. The text is replaced through SetText,
+// notifying OnChanged as usual.
+//
+// Since: 2.6
+func (e *Entry) WipeText() {
+	e.propertyLock.Lock()
+	for _, item := range e.undoStack.items {
+		if a, ok := item.(*entryModifyAction); ok {
+			for i := range a.Text {
+				a.Text[i] = 0
+			}
+		}
+	}
+	e.propertyLock.Unlock()
+
+	e.SetText("")
+}
+
 var _ desktop.Cursorable = (*passwordRevealer)(nil)
 var _ fyne.Tappable = (*passwordRevealer)(nil)
 var _ fyne.Widget = (*passwordRevealer)(nil)
+var _ desktop.Mouseable = (*passwordRevealer)(nil)
+var _ mobile.Touchable = (*passwordRevealer)(nil)
 
 type passwordRevealer struct {
 	BaseWidget
 
-	icon  *canvas.Image
-	entry *Entry
+	icon         *canvas.Image
+	entry        *Entry
+	concealTimer *time.Timer
 }
 
 func newPasswordRevealer(e *Entry) *passwordRevealer {
@@ -41,16 +88,90 @@ func (r *passwordRevealer) Cursor() desktop.Cursor {
 }
 
 func (r *passwordRevealer) Tapped(*fyne.PointEvent) {
-	if r.entry.Disabled() {
+	if r.entry.Disabled() || r.entry.PasswordReveal == PasswordRevealHold {
 		return
 	}
 
 	r.entry.setFieldsAndRefresh(func() {
 		r.entry.Password = !r.entry.Password
 	})
+	if r.concealTimer != nil {
+		r.concealTimer.Stop()
+		r.concealTimer = nil
+	}
+	if !r.entry.Password && r.entry.PasswordReveal == PasswordRevealTimed {
+		timeout := r.entry.PasswordRevealTimeout
+		if timeout <= 0 {
+			timeout = passwordRevealTimeout
+		}
+		r.concealTimer = time.AfterFunc(timeout, r.conceal)
+	}
 	fyne.CurrentApp().Driver().CanvasForObject(r).Focus(r.entry.super().(fyne.Focusable))
 }
 
+// conceal hides the content again after the reveal timeout has passed.
+func (r *passwordRevealer) conceal() {
+	if r.entry.Password {
+		return
+	}
+	r.entry.setFieldsAndRefresh(func() {
+		r.entry.Password = true
+	})
+}
+
+// MouseDown reveals the content of a hold-to-show password entry.
+//
+// Implements: desktop.Mouseable
+func (r *passwordRevealer) MouseDown(*desktop.MouseEvent) {
+	r.holdStart()
+}
+
+// MouseUp conceals the content of a hold-to-show password entry again.
+//
+// Implements: desktop.Mouseable
+func (r *passwordRevealer) MouseUp(*desktop.MouseEvent) {
+	r.holdEnd()
+}
+
+// TouchDown reveals the content of a hold-to-show password entry.
+//
+// Implements: mobile.Touchable
+func (r *passwordRevealer) TouchDown(*mobile.TouchEvent) {
+	r.holdStart()
+}
+
+// TouchUp conceals the content of a hold-to-show password entry again.
+//
+// Implements: mobile.Touchable
+func (r *passwordRevealer) TouchUp(*mobile.TouchEvent) {
+	r.holdEnd()
+}
+
+// TouchCancel conceals the content of a hold-to-show password entry again.
+//
+// Implements: mobile.Touchable
+func (r *passwordRevealer) TouchCancel(*mobile.TouchEvent) {
+	r.holdEnd()
+}
+
+func (r *passwordRevealer) holdStart() {
+	if r.entry.Disabled() || r.entry.PasswordReveal != PasswordRevealHold || !r.entry.Password {
+		return
+	}
+	r.entry.setFieldsAndRefresh(func() {
+		r.entry.Password = false
+	})
+}
+
+func (r *passwordRevealer) holdEnd() {
+	if r.entry.PasswordReveal != PasswordRevealHold || r.entry.Password {
+		return
+	}
+	r.entry.setFieldsAndRefresh(func() {
+		r.entry.Password = true
+	})
+}
+
 var _ fyne.WidgetRenderer = (*passwordRevealerRenderer)(nil)
 
 type passwordRevealerRenderer struct {
@@ -85,3 +206,46 @@ func (r *passwordRevealerRenderer) Refresh() {
 	}
 	canvas.Refresh(r.icon)
 }
+
+// ensureStrengthSetup lazily creates the strength bar the first time a
+// PasswordStrength scorer is set on the entry.
+func (r *entryRenderer) ensureStrengthSetup() {
+	if r.entry.strengthBar != nil {
+		return
+	}
+
+	r.entry.strengthBar = canvas.NewRectangle(color.Transparent)
+	r.objects = append(r.objects, r.entry.strengthBar)
+}
+
+// refreshStrengthBar sizes and tints the bar along the bottom edge of the
+// field in proportion to the PasswordStrength score of the current content.
+func (r *entryRenderer) refreshStrengthBar() {
+	r.ensureStrengthSetup()
+
+	score := r.entry.PasswordStrength(r.entry.Text)
+	if score < 0 {
+		score = 0
+	} else if score > 1 {
+		score = 1
+	}
+
+	name := theme.ColorNameError
+	if score >= 0.75 {
+		name = theme.ColorNameSuccess
+	} else if score >= 0.5 {
+		name = theme.ColorNameWarning
+	}
+
+	th := r.entry.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	inputBorder := th.Size(theme.SizeNameInputBorder)
+	size := r.entry.Size()
+
+	bar := r.entry.strengthBar
+	bar.FillColor = th.Color(name, v)
+	bar.Resize(fyne.NewSize((size.Width-inputBorder*2)*float32(score), inputBorder*2))
+	bar.Move(fyne.NewPos(inputBorder, size.Height-inputBorder*3))
+	bar.Show()
+	canvas.Refresh(bar)
+}
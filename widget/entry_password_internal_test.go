@@ -0,0 +1,86 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntry_PasswordRevealHold(t *testing.T) {
+	entry := NewPasswordEntry()
+	entry.PasswordReveal = PasswordRevealHold
+	w := test.NewTempWindow(t, entry)
+	w.Resize(fyne.NewSize(150, 50))
+
+	pr := entry.ActionItem.(*passwordRevealer)
+	pr.Tapped(nil)
+	assert.True(t, entry.Password, "tapping does not toggle a hold-to-show entry")
+
+	pr.MouseDown(nil)
+	assert.False(t, entry.Password)
+	pr.MouseUp(nil)
+	assert.True(t, entry.Password)
+
+	pr.TouchDown(nil)
+	assert.False(t, entry.Password)
+	pr.TouchCancel(nil)
+	assert.True(t, entry.Password)
+}
+
+func TestEntry_PasswordRevealTimed(t *testing.T) {
+	entry := NewPasswordEntry()
+	entry.PasswordReveal = PasswordRevealTimed
+	entry.PasswordRevealTimeout = 20 * time.Millisecond
+	w := test.NewTempWindow(t, entry)
+	w.Resize(fyne.NewSize(150, 50))
+
+	pr := entry.ActionItem.(*passwordRevealer)
+	pr.Tapped(nil)
+	assert.False(t, entry.Password)
+
+	deadline := time.Now().Add(time.Second)
+	for !entry.Password && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	assert.True(t, entry.Password, "the content conceals again after the timeout")
+}
+
+func TestEntry_WipeText(t *testing.T) {
+	entry := NewPasswordEntry()
+	test.Type(entry, "hunter2")
+	assert.True(t, entry.undoStack.CanUndo())
+
+	actions := entry.undoStack.items
+	entry.WipeText()
+
+	assert.Equal(t, "", entry.Text)
+	assert.False(t, entry.undoStack.CanUndo())
+	for _, item := range actions {
+		for _, r := range item.(*entryModifyAction).Text {
+			assert.Equal(t, rune(0), r, "the history buffers were overwritten")
+		}
+	}
+}
+
+func TestEntry_PasswordStrength(t *testing.T) {
+	entry := NewPasswordEntry()
+	entry.PasswordStrength = func(password string) float64 {
+		return float64(len(password)) / 8
+	}
+	w := test.NewTempWindow(t, entry)
+	w.Resize(fyne.NewSize(100, 50))
+
+	entry.SetText("ab")
+	assert.NotNil(t, entry.strengthBar)
+	weak := entry.strengthBar.Size().Width
+	assert.Greater(t, weak, float32(0))
+	weakColor := entry.strengthBar.FillColor
+
+	entry.SetText("abcdefgh")
+	assert.Greater(t, entry.strengthBar.Size().Width, weak)
+	assert.NotEqual(t, weakColor, entry.strengthBar.FillColor)
+}
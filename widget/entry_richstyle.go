@@ -0,0 +1,376 @@
+package widget
+
+import (
+	"net/url"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// entryStyleRun is a styled span of an entry's text, in rune offsets.
+// The style holds only the attributes that differ from the entry's base style,
+// so a run with a zero style and no link carries no information and is dropped.
+type entryStyleRun struct {
+	start, end int
+	style      RichTextStyle
+	url        *url.URL
+}
+
+// ApplyStyle updates the styling of the currently selected text.
+// The boolean attributes of style.TextStyle act as toggles: bold, italic,
+// underline or monospace are removed if the whole selection already has them
+// and applied otherwise. A non-empty ColorName or SizeName is applied to the
+// selection directly; pass theme.ColorNameForeground to restore the default
+// text color. Styled spans follow the text as it is edited and are rendered
+// inline, enabling simple rich text editing on top of a regular entry.
+//
+// Since: 2.6
+func (e *Entry) ApplyStyle(style RichTextStyle) {
+	e.propertyLock.Lock()
+	start, end := e.selection()
+	if start == -1 {
+		e.propertyLock.Unlock()
+		return
+	}
+
+	setBold := style.TextStyle.Bold && !e.styleCovers(start, end, func(r entryStyleRun) bool { return r.style.TextStyle.Bold })
+	setItalic := style.TextStyle.Italic && !e.styleCovers(start, end, func(r entryStyleRun) bool { return r.style.TextStyle.Italic })
+	setUnderline := style.TextStyle.Underline && !e.styleCovers(start, end, func(r entryStyleRun) bool { return r.style.TextStyle.Underline })
+	setMonospace := style.TextStyle.Monospace && !e.styleCovers(start, end, func(r entryStyleRun) bool { return r.style.TextStyle.Monospace })
+
+	e.mutateStyleRange(start, end, func(run *entryStyleRun) {
+		if style.TextStyle.Bold {
+			run.style.TextStyle.Bold = setBold
+		}
+		if style.TextStyle.Italic {
+			run.style.TextStyle.Italic = setItalic
+		}
+		if style.TextStyle.Underline {
+			run.style.TextStyle.Underline = setUnderline
+		}
+		if style.TextStyle.Monospace {
+			run.style.TextStyle.Monospace = setMonospace
+		}
+		if style.ColorName != "" {
+			if style.ColorName == theme.ColorNameForeground {
+				run.style.ColorName = ""
+			} else {
+				run.style.ColorName = style.ColorName
+			}
+		}
+		if style.SizeName != "" {
+			run.style.SizeName = style.SizeName
+		}
+	})
+	e.propertyLock.Unlock()
+
+	e.Refresh()
+}
+
+// ApplyLink marks the currently selected text as a link to the given URL,
+// showing it underlined in the hyperlink color. Passing nil removes an
+// existing link from the selection. The link target is carried into the
+// segments returned by RichTextSegments.
+//
+// Since: 2.6
+func (e *Entry) ApplyLink(u *url.URL) {
+	e.propertyLock.Lock()
+	start, end := e.selection()
+	if start == -1 {
+		e.propertyLock.Unlock()
+		return
+	}
+
+	e.mutateStyleRange(start, end, func(run *entryStyleRun) {
+		run.url = u
+		if u != nil {
+			run.style.ColorName = theme.ColorNameHyperlink
+			run.style.TextStyle.Underline = true
+		} else {
+			run.style.ColorName = ""
+			run.style.TextStyle.Underline = false
+		}
+	})
+	e.propertyLock.Unlock()
+
+	e.Refresh()
+}
+
+// TextStyleAtSelection returns the styling of the currently selected text, or
+// of the text that would be typed at the cursor if nothing is selected. The
+// boolean text style attributes are set only if the whole selection has them,
+// and ColorName and SizeName only if they are uniform across it, so a toolbar
+// can reflect the state of the selection. The zero value describes plain text
+// in the entry's base style.
+//
+// Since: 2.6
+func (e *Entry) TextStyleAtSelection() RichTextStyle {
+	e.propertyLock.RLock()
+	defer e.propertyLock.RUnlock()
+
+	start, end := e.selection()
+	if start == -1 {
+		// the style newly typed text would take, from the run the cursor touches
+		pos := e.cursorTextPos()
+		for _, run := range e.styleRuns {
+			if run.start < pos && pos <= run.end {
+				return run.style
+			}
+		}
+		return RichTextStyle{}
+	}
+
+	var result RichTextStyle
+	result.TextStyle.Bold = e.styleCovers(start, end, func(r entryStyleRun) bool { return r.style.TextStyle.Bold })
+	result.TextStyle.Italic = e.styleCovers(start, end, func(r entryStyleRun) bool { return r.style.TextStyle.Italic })
+	result.TextStyle.Underline = e.styleCovers(start, end, func(r entryStyleRun) bool { return r.style.TextStyle.Underline })
+	result.TextStyle.Monospace = e.styleCovers(start, end, func(r entryStyleRun) bool { return r.style.TextStyle.Monospace })
+
+	for _, run := range e.styleRuns {
+		if run.end <= start || run.start >= end {
+			continue
+		}
+		if e.styleCovers(start, end, func(r entryStyleRun) bool { return r.style.ColorName == run.style.ColorName }) {
+			result.ColorName = run.style.ColorName
+		}
+		if e.styleCovers(start, end, func(r entryStyleRun) bool { return r.style.SizeName == run.style.SizeName }) {
+			result.SizeName = run.style.SizeName
+		}
+		break
+	}
+	return result
+}
+
+// RichTextSegments returns the entry content as rich text segments, with
+// styled spans as styled text and links as hyperlink segments, suitable for
+// showing in a RichText widget or serializing the edited document.
+//
+// Since: 2.6
+func (e *Entry) RichTextSegments() []RichTextSegment {
+	e.propertyLock.RLock()
+	defer e.propertyLock.RUnlock()
+
+	runes := []rune(e.Text)
+	segs := make([]RichTextSegment, 0, len(e.styleRuns)*2+1)
+	pos := 0
+	plain := func(end int) {
+		if end > pos {
+			segs = append(segs, &TextSegment{Style: RichTextStyleInline, Text: string(runes[pos:end])})
+			pos = end
+		}
+	}
+
+	for _, run := range e.styleRuns {
+		plain(run.start)
+		if run.url != nil {
+			segs = append(segs, &HyperlinkSegment{Text: string(runes[pos:run.end]), URL: run.url})
+		} else {
+			style := RichTextStyleInline
+			style.TextStyle = run.style.TextStyle
+			if run.style.ColorName != "" {
+				style.ColorName = run.style.ColorName
+			}
+			if run.style.SizeName != "" {
+				style.SizeName = run.style.SizeName
+			}
+			segs = append(segs, &TextSegment{Style: style, Text: string(runes[pos:run.end])})
+		}
+		pos = run.end
+	}
+	plain(len(runes))
+	return segs
+}
+
+// styleCovers returns whether every rune in [start, end) is part of a style
+// run for which has returns true.
+func (e *Entry) styleCovers(start, end int, has func(entryStyleRun) bool) bool {
+	cursor := start
+	for _, run := range e.styleRuns {
+		if run.end <= cursor {
+			continue
+		}
+		if run.start > cursor || !has(run) {
+			return false
+		}
+		cursor = run.end
+		if cursor >= end {
+			return true
+		}
+	}
+	return cursor >= end
+}
+
+// mutateStyleRange applies mutate to the style runs covering [start, end),
+// splitting existing runs at the boundaries and creating runs for unstyled
+// gaps so the whole range is visited exactly once.
+func (e *Entry) mutateStyleRange(start, end int, mutate func(run *entryStyleRun)) {
+	if start >= end {
+		return
+	}
+
+	out := make([]entryStyleRun, 0, len(e.styleRuns)+2)
+	cursor := start
+	for _, run := range e.styleRuns {
+		if run.end <= start {
+			out = append(out, run)
+			continue
+		}
+		if run.start >= end {
+			if cursor < end {
+				gap := entryStyleRun{start: cursor, end: end}
+				mutate(&gap)
+				out = append(out, gap)
+				cursor = end
+			}
+			out = append(out, run)
+			continue
+		}
+
+		if run.start < start { // keep the part before the range unchanged
+			out = append(out, entryStyleRun{start: run.start, end: start, style: run.style, url: run.url})
+			run.start = start
+		}
+		if run.start > cursor { // an unstyled gap inside the range
+			gap := entryStyleRun{start: cursor, end: run.start}
+			mutate(&gap)
+			out = append(out, gap)
+		}
+		if run.end > end { // split off the part beyond the range
+			mid := entryStyleRun{start: run.start, end: end, style: run.style, url: run.url}
+			mutate(&mid)
+			out = append(out, mid, entryStyleRun{start: end, end: run.end, style: run.style, url: run.url})
+			cursor = end
+		} else {
+			cursor = run.end
+			mutate(&run)
+			out = append(out, run)
+		}
+	}
+	if cursor < end {
+		gap := entryStyleRun{start: cursor, end: end}
+		mutate(&gap)
+		out = append(out, gap)
+	}
+
+	e.styleRuns = normalizeStyleRuns(out)
+}
+
+// adjustStyleRuns moves the style runs to follow a text edit, located by
+// diffing the old and new content. Edits are single contiguous changes, so the
+// first and last differing runes bound the replaced span. Runs touching the
+// edit point grow with text typed at their end, matching how styled text
+// behaves in word processors.
+func (e *Entry) adjustStyleRuns(oldText, newText string) {
+	o, n := []rune(oldText), []rune(newText)
+	prefix := 0
+	for prefix < len(o) && prefix < len(n) && o[prefix] == n[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(o)-prefix && suffix < len(n)-prefix && o[len(o)-1-suffix] == n[len(n)-1-suffix] {
+		suffix++
+	}
+	del := len(o) - prefix - suffix
+	ins := len(n) - prefix - suffix
+
+	adjust := func(pos int) int {
+		if pos > prefix {
+			if pos > prefix+del {
+				pos -= del
+			} else {
+				pos = prefix
+			}
+		}
+		if pos >= prefix {
+			pos += ins
+		}
+		return pos
+	}
+
+	runs := e.styleRuns[:0]
+	for _, run := range e.styleRuns {
+		run.start = adjust(run.start)
+		run.end = adjust(run.end)
+		if run.end > run.start {
+			runs = append(runs, run)
+		}
+	}
+	e.styleRuns = normalizeStyleRuns(runs)
+}
+
+// normalizeStyleRuns drops empty or unstyled runs and merges adjacent runs
+// that carry the same styling, keeping the run list minimal and sorted.
+func normalizeStyleRuns(runs []entryStyleRun) []entryStyleRun {
+	out := runs[:0]
+	for _, run := range runs {
+		if run.start >= run.end {
+			continue
+		}
+		if run.style == (RichTextStyle{}) && run.url == nil {
+			continue
+		}
+		if n := len(out); n > 0 && out[n-1].end == run.start &&
+			out[n-1].style == run.style && styleRunURLsEqual(out[n-1].url, run.url) {
+			out[n-1].end = run.end
+			continue
+		}
+		out = append(out, run)
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+func styleRunURLsEqual(a, b *url.URL) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.String() == b.String()
+}
+
+// styledSegments builds the provider segments for an entry with the given
+// style runs, splitting the text so each styled span renders with its own
+// attributes.
+func (e *Entry) styledSegments(runs []entryStyleRun, colName fyne.ThemeColorName) []RichTextSegment {
+	runes := []rune(e.displayText())
+	base := RichTextStyleInline
+	base.ColorName = colName
+	base.TextStyle = e.TextStyle
+
+	segs := make([]RichTextSegment, 0, len(runs)*2+1)
+	pos := 0
+	add := func(end int, style RichTextStyle) {
+		if end > len(runes) {
+			end = len(runes)
+		}
+		if end <= pos {
+			return
+		}
+		segs = append(segs, &TextSegment{Style: style, Text: string(runes[pos:end])})
+		pos = end
+	}
+
+	for _, run := range runs {
+		add(run.start, base)
+
+		style := base
+		style.TextStyle.Bold = style.TextStyle.Bold || run.style.TextStyle.Bold
+		style.TextStyle.Italic = style.TextStyle.Italic || run.style.TextStyle.Italic
+		style.TextStyle.Underline = style.TextStyle.Underline || run.style.TextStyle.Underline
+		style.TextStyle.Monospace = style.TextStyle.Monospace || run.style.TextStyle.Monospace
+		if run.style.ColorName != "" {
+			style.ColorName = run.style.ColorName
+		}
+		if run.style.SizeName != "" {
+			style.SizeName = run.style.SizeName
+		}
+		add(run.end, style)
+	}
+	add(len(runes), base)
+
+	if len(segs) == 0 { // the provider requires at least one text segment
+		segs = append(segs, &TextSegment{Style: base})
+	}
+	return segs
+}
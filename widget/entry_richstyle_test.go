@@ -0,0 +1,115 @@
+package widget
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// selectEntryRange marks the span between the two row/column locations as the
+// current selection, as if the user had dragged over it.
+func selectEntryRange(e *Entry, startRow, startCol, endRow, endCol int) {
+	e.selectRow, e.selectColumn = startRow, startCol
+	e.CursorRow, e.CursorColumn = endRow, endCol
+	e.selecting = true
+}
+
+func TestEntry_ApplyStyle(t *testing.T) {
+	entry := NewEntry()
+	entry.SetText("hello world")
+
+	selectEntryRange(entry, 0, 0, 0, 5)
+	entry.ApplyStyle(RichTextStyle{TextStyle: fyne.TextStyle{Bold: true}})
+
+	assert.True(t, entry.TextStyleAtSelection().TextStyle.Bold)
+	segs := entry.textProvider().Segments
+	if assert.Len(t, segs, 2) {
+		assert.Equal(t, "hello", segs[0].(*TextSegment).Text)
+		assert.True(t, segs[0].(*TextSegment).Style.TextStyle.Bold)
+		assert.Equal(t, " world", segs[1].(*TextSegment).Text)
+		assert.False(t, segs[1].(*TextSegment).Style.TextStyle.Bold)
+	}
+
+	// a partially styled selection applies the attribute to all of it
+	selectEntryRange(entry, 0, 3, 0, 8)
+	entry.ApplyStyle(RichTextStyle{TextStyle: fyne.TextStyle{Bold: true}})
+	assert.True(t, entry.styleCovers(0, 8, func(r entryStyleRun) bool { return r.style.TextStyle.Bold }))
+
+	// applying it again toggles it off
+	selectEntryRange(entry, 0, 0, 0, 8)
+	entry.ApplyStyle(RichTextStyle{TextStyle: fyne.TextStyle{Bold: true}})
+	assert.Empty(t, entry.styleRuns)
+	assert.Len(t, entry.textProvider().Segments, 1, "segments collapse when the last span is unstyled")
+}
+
+func TestEntry_ApplyStyle_Color(t *testing.T) {
+	entry := NewEntry()
+	entry.SetText("warning")
+
+	selectEntryRange(entry, 0, 0, 0, 7)
+	entry.ApplyStyle(RichTextStyle{ColorName: theme.ColorNameError})
+	assert.Equal(t, theme.ColorNameError, entry.TextStyleAtSelection().ColorName)
+
+	entry.ApplyStyle(RichTextStyle{ColorName: theme.ColorNameForeground})
+	assert.Empty(t, entry.styleRuns, "the foreground color restores plain text")
+}
+
+func TestEntry_StyleFollowsEdits(t *testing.T) {
+	entry := NewEntry()
+	entry.SetText("hello world")
+	selectEntryRange(entry, 0, 0, 0, 5)
+	entry.ApplyStyle(RichTextStyle{TextStyle: fyne.TextStyle{Bold: true}})
+	entry.selecting = false
+
+	// typing at the start of the text shifts the styled span right
+	entry.CursorRow, entry.CursorColumn = 0, 0
+	entry.TypedRune('x')
+	assert.Equal(t, []entryStyleRun{{start: 1, end: 6, style: RichTextStyle{TextStyle: fyne.TextStyle{Bold: true}}}}, entry.styleRuns)
+
+	// typing at the end of the span extends it
+	entry.CursorRow, entry.CursorColumn = 0, 6
+	entry.TypedRune('s')
+	assert.Equal(t, 7, entry.styleRuns[0].end)
+	assert.Equal(t, "xhellos", entry.textProvider().Segments[0].(*TextSegment).Text+entry.textProvider().Segments[1].(*TextSegment).Text[:6])
+
+	// deleting inside the span shrinks it
+	entry.CursorRow, entry.CursorColumn = 0, 4
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyBackspace})
+	assert.Equal(t, entryStyleRun{start: 1, end: 6, style: RichTextStyle{TextStyle: fyne.TextStyle{Bold: true}}}, entry.styleRuns[0])
+
+	// replacing the text wholesale starts a new unstyled document
+	entry.SetText("plain")
+	assert.Empty(t, entry.styleRuns)
+}
+
+func TestEntry_ApplyLink(t *testing.T) {
+	entry := NewEntry()
+	entry.SetText("visit fyne.io today")
+	u, _ := url.Parse("https://fyne.io")
+
+	selectEntryRange(entry, 0, 6, 0, 13)
+	entry.ApplyLink(u)
+
+	style := entry.TextStyleAtSelection()
+	assert.True(t, style.TextStyle.Underline)
+	assert.Equal(t, theme.ColorNameHyperlink, style.ColorName)
+
+	segs := entry.RichTextSegments()
+	if assert.Len(t, segs, 3) {
+		link, ok := segs[1].(*HyperlinkSegment)
+		if assert.True(t, ok) {
+			assert.Equal(t, "fyne.io", link.Text)
+			assert.Equal(t, u, link.URL)
+		}
+		assert.Equal(t, "visit ", segs[0].(*TextSegment).Text)
+		assert.Equal(t, " today", segs[2].(*TextSegment).Text)
+	}
+
+	entry.ApplyLink(nil)
+	assert.Empty(t, entry.styleRuns)
+	assert.Len(t, entry.RichTextSegments(), 1)
+}
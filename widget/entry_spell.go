@@ -0,0 +1,216 @@
+package widget
+
+import (
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// maxSpellSuggestions limits how many replacement words the context menu offers.
+const maxSpellSuggestions = 5
+
+// SpellChecker reports misspelled words in the content of an Entry and offers
+// replacements for them. The interface mirrors the spell and suggest calls of
+// dictionary backends such as hunspell, so such a backend can be plugged in
+// directly. Checks run on a background goroutine so a slow backend does not
+// block typing.
+//
+// Since: 2.6
+type SpellChecker interface {
+	// CheckWord reports whether the given word is spelled correctly.
+	CheckWord(word string) bool
+	// Suggest returns replacement candidates for a misspelled word,
+	// ordered from most to least likely.
+	Suggest(word string) []string
+}
+
+// checkSpelling starts an asynchronous spell check of the entry text if it
+// changed since the last one completed or began.
+// It assumes that a lock exists on the widget.
+func (e *Entry) checkSpelling() {
+	if e.spellText == e.Text {
+		return
+	}
+	e.spellText = e.Text
+	e.spellGen++
+
+	gen := e.spellGen
+	checker := e.SpellChecker
+	text := e.Text
+	go func() {
+		ranges := spellCheckText(checker, text)
+
+		e.propertyLock.Lock()
+		if gen != e.spellGen { // a newer check superseded this one
+			e.propertyLock.Unlock()
+			return
+		}
+		changed := !equalTextRanges(e.misspelled, ranges)
+		e.misspelled = ranges
+		e.propertyLock.Unlock()
+
+		if changed {
+			e.Refresh()
+		}
+	}()
+}
+
+// spellRuns returns the style runs underlining the words most recently
+// reported as misspelled, clamped in case the text changed since the check.
+// It assumes that a lock exists on the widget.
+func (e *Entry) spellRuns() []entryStyleRun {
+	length := len([]rune(e.Text))
+	runs := make([]entryStyleRun, 0, len(e.misspelled))
+	for _, r := range e.misspelled {
+		end := r.End
+		if end > length {
+			end = length
+		}
+		if r.Start < 0 || r.Start >= end {
+			continue
+		}
+		runs = append(runs, entryStyleRun{start: r.Start, end: end, style: RichTextStyle{
+			ColorName: theme.ColorNameError,
+			TextStyle: fyne.TextStyle{Underline: true},
+		}})
+	}
+	return runs
+}
+
+// suggestionItems returns context menu items that replace the misspelled word
+// at the given text position with one of the SpellChecker's suggestions.
+// It assumes that a lock exists on the widget.
+func (e *Entry) suggestionItems(pos int) []*fyne.MenuItem {
+	runes := []rune(e.Text)
+	for _, r := range e.misspelled {
+		if pos < r.Start || pos > r.End || r.End > len(runes) {
+			continue
+		}
+
+		suggestions := e.SpellChecker.Suggest(string(runes[r.Start:r.End]))
+		if len(suggestions) > maxSpellSuggestions {
+			suggestions = suggestions[:maxSpellSuggestions]
+		}
+		items := make([]*fyne.MenuItem, 0, len(suggestions))
+		for _, s := range suggestions {
+			span, suggestion := r, s
+			items = append(items, fyne.NewMenuItem(suggestion, func() {
+				e.ReplaceAt(span, suggestion)
+			}))
+		}
+		return items
+	}
+	return nil
+}
+
+// spellCheckText returns the rune ranges of the words of text that checker
+// does not know.
+func spellCheckText(checker SpellChecker, text string) (misspelled []TextRange) {
+	runes := []rune(text)
+	start := -1
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && isWordRune(runes[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			if !checker.CheckWord(string(runes[start:i])) {
+				misspelled = append(misspelled, TextRange{Start: start, End: i})
+			}
+			start = -1
+		}
+	}
+	return misspelled
+}
+
+func equalTextRanges(a, b []TextRange) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, r := range a {
+		if r != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// WordListSpellChecker is a simple SpellChecker backed by a list of known
+// words, for use where a dictionary backend such as hunspell is not available.
+// Checks are case-insensitive and suggestions are the known words closest to
+// the misspelled one by edit distance.
+//
+// Since: 2.6
+type WordListSpellChecker struct {
+	words map[string]struct{}
+}
+
+// NewWordListSpellChecker returns a SpellChecker that accepts exactly the
+// given words, ignoring case.
+//
+// Since: 2.6
+func NewWordListSpellChecker(words ...string) *WordListSpellChecker {
+	known := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		known[strings.ToLower(w)] = struct{}{}
+	}
+	return &WordListSpellChecker{words: known}
+}
+
+// CheckWord reports whether the given word is in the word list.
+func (w *WordListSpellChecker) CheckWord(word string) bool {
+	_, ok := w.words[strings.ToLower(word)]
+	return ok
+}
+
+// Suggest returns the known words within an edit distance of two from the
+// given word, closest first.
+func (w *WordListSpellChecker) Suggest(word string) []string {
+	word = strings.ToLower(word)
+	var suggestions []string
+	for candidate := range w.words {
+		if editDistance(word, candidate) <= 2 {
+			suggestions = append(suggestions, candidate)
+		}
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		di, dj := editDistance(word, suggestions[i]), editDistance(word, suggestions[j])
+		if di != dj {
+			return di < dj
+		}
+		return suggestions[i] < suggestions[j]
+	})
+	return suggestions
+}
+
+// editDistance returns the Levenshtein distance between two words.
+func editDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	prev := make([]int, len(br)+1)
+	row := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i, ra := range ar {
+		row[0] = i + 1
+		for j, rb := range br {
+			cost := 1
+			if ra == rb {
+				cost = 0
+			}
+			row[j+1] = prev[j] + cost
+			if del := prev[j+1] + 1; del < row[j+1] {
+				row[j+1] = del
+			}
+			if ins := row[j] + 1; ins < row[j+1] {
+				row[j+1] = ins
+			}
+		}
+		prev, row = row, prev
+	}
+	return prev[len(br)]
+}
@@ -0,0 +1,82 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func waitForSpellCheck(t *testing.T, entry *Entry, want int) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); {
+		entry.propertyLock.RLock()
+		got := len(entry.misspelled)
+		entry.propertyLock.RUnlock()
+		if got == want {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("spell check did not report %d misspelled words in time", want)
+}
+
+func TestEntry_SpellChecker(t *testing.T) {
+	entry := NewEntry()
+	entry.SpellChecker = NewWordListSpellChecker("hello", "world")
+	entry.SetText("hello wrold")
+
+	waitForSpellCheck(t, entry, 1)
+	assert.Equal(t, []TextRange{{Start: 6, End: 11}}, entry.misspelled)
+
+	segments := entry.textProvider().Segments
+	assert.Len(t, segments, 2)
+	marked := segments[1].(*TextSegment)
+	assert.Equal(t, "wrold", marked.Text)
+	assert.Equal(t, theme.ColorNameError, marked.Style.ColorName)
+	assert.True(t, marked.Style.TextStyle.Underline)
+
+	entry.SetText("hello world")
+	waitForSpellCheck(t, entry, 0)
+	assert.Len(t, entry.textProvider().Segments, 1)
+}
+
+func TestEntry_SpellChecker_Suggestions(t *testing.T) {
+	entry := NewEntry()
+	entry.SpellChecker = NewWordListSpellChecker("hello", "world")
+	entry.SetText("hello wrold")
+	waitForSpellCheck(t, entry, 1)
+
+	entry.propertyLock.RLock()
+	items := entry.suggestionItems(8)
+	none := entry.suggestionItems(2)
+	entry.propertyLock.RUnlock()
+	assert.Nil(t, none, "no suggestions over a correctly spelled word")
+	if assert.Len(t, items, 1) {
+		assert.Equal(t, "world", items[0].Label)
+		items[0].Action()
+		assert.Equal(t, "hello world", entry.Text)
+	}
+}
+
+func TestEntry_SpellChecker_Password(t *testing.T) {
+	entry := NewPasswordEntry()
+	entry.SpellChecker = NewWordListSpellChecker("hello")
+	entry.SetText("wrold")
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, entry.misspelled, "concealed text is never spell checked")
+}
+
+func TestWordListSpellChecker(t *testing.T) {
+	checker := NewWordListSpellChecker("hello", "help", "world")
+
+	assert.True(t, checker.CheckWord("hello"))
+	assert.True(t, checker.CheckWord("Hello"))
+	assert.False(t, checker.CheckWord("helo"))
+
+	assert.Equal(t, []string{"hello", "help"}, checker.Suggest("helo"))
+	assert.Empty(t, checker.Suggest("zzzzzz"))
+}
@@ -3,8 +3,10 @@ package widget_test
 import (
 	"image/color"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
+	"unicode"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
@@ -1039,6 +1041,33 @@ func TestEntry_PasteUnicode(t *testing.T) {
 	assert.Equal(t, 1, e.CursorColumn)
 }
 
+func TestEntry_OnPasteTransform(t *testing.T) {
+	entry := widget.NewEntry()
+	entry.OnPaste = strings.ToUpper
+
+	clipboard := test.NewClipboard()
+	clipboard.SetContent("hello")
+	entry.TypedShortcut(&fyne.ShortcutPaste{Clipboard: clipboard})
+	assert.Equal(t, "HELLO", entry.Text)
+
+	entry.OnPaste = func(string) string { return "" } // reject the paste
+	entry.TypedShortcut(&fyne.ShortcutPaste{Clipboard: clipboard})
+	assert.Equal(t, "HELLO", entry.Text)
+}
+
+func TestEntry_OnInput(t *testing.T) {
+	entry := widget.NewEntry()
+	entry.OnInput = func(r rune) (rune, bool) {
+		if len(entry.Text) >= 3 {
+			return 0, false // enforce a length limit
+		}
+		return unicode.ToUpper(r), true
+	}
+
+	test.Type(entry, "abcd")
+	assert.Equal(t, "ABC", entry.Text)
+}
+
 func TestEntry_Placeholder(t *testing.T) {
 	entry := &widget.Entry{Scroll: container.ScrollNone}
 	entry.Text = "Text"
@@ -1515,6 +1544,61 @@ func TestEntry_SetText_Underflow(t *testing.T) {
 	assert.Equal(t, "", entry.Text)
 }
 
+func TestEntry_InputMask(t *testing.T) {
+	entry := widget.NewEntry()
+	entry.InputMask = "(99) 9999-9999"
+
+	var changed string
+	entry.OnChanged = func(s string) { changed = s }
+
+	test.Type(entry, "12")
+	assert.Equal(t, "(12) ", entry.Text, "literals fill in around the typed digits")
+	assert.Equal(t, "(12) ", changed, "OnChanged receives the formatted text")
+	assert.Equal(t, 5, entry.CursorColumn, "the cursor skips to the next open slot")
+
+	test.Type(entry, "a!")
+	assert.Equal(t, "(12) ", entry.Text, "runes that do not fit the slot are dropped")
+
+	test.Type(entry, "34567890")
+	assert.Equal(t, "(12) 3456-7890", entry.Text)
+	assert.Equal(t, "1234567890", entry.UnmaskedText())
+
+	test.Type(entry, "1")
+	assert.Equal(t, "(12) 3456-7890", entry.Text, "a full mask accepts no more input")
+}
+
+func TestEntry_InputMask_Delete(t *testing.T) {
+	entry := widget.NewEntry()
+	entry.InputMask = "(99) 9"
+	test.Type(entry, "123")
+	assert.Equal(t, "(12) 3", entry.Text)
+
+	key := &fyne.KeyEvent{Name: fyne.KeyBackspace}
+	entry.TypedKey(key)
+	assert.Equal(t, "(12) ", entry.Text)
+
+	// deleting into the literals removes the digit before them as well
+	entry.TypedKey(key)
+	assert.Equal(t, "(1", entry.Text)
+	assert.Equal(t, 2, entry.CursorColumn)
+
+	entry.TypedKey(key)
+	assert.Equal(t, "", entry.Text, "removing the last digit clears the leading literal")
+}
+
+func TestEntry_InputMask_SetText(t *testing.T) {
+	entry := widget.NewEntry()
+	entry.InputMask = "(99) 9999-9999"
+
+	entry.SetText("0123456789")
+	assert.Equal(t, "(01) 2345-6789", entry.Text)
+	assert.Equal(t, "0123456789", entry.UnmaskedText())
+
+	entry.SetText("")
+	assert.Equal(t, "", entry.Text)
+	assert.Equal(t, "", entry.UnmaskedText())
+}
+
 func TestEntry_SetText_Overflow_Multiline(t *testing.T) {
 	entry := widget.NewEntry()
 	entry.MultiLine = true
@@ -2084,6 +2168,27 @@ func TestEntry_UndoRedo_Replace(t *testing.T) {
 	assert.Equal(t, "àbzéf", entry.Text)
 }
 
+func TestEntry_UndoDepth(t *testing.T) {
+	entry := widget.NewEntry()
+	entry.UndoDepth = 2
+
+	// each word merges into one action, giving three in total
+	for _, r := range "one two three" {
+		entry.TypedRune(r)
+	}
+	assert.Equal(t, "one two three", entry.Text)
+
+	entry.TypedShortcut(&fyne.ShortcutUndo{})
+	assert.Equal(t, "one two", entry.Text)
+
+	entry.TypedShortcut(&fyne.ShortcutUndo{})
+	assert.Equal(t, "one", entry.Text)
+
+	// the oldest action was dropped to honor the depth limit
+	entry.TypedShortcut(&fyne.ShortcutUndo{})
+	assert.Equal(t, "one", entry.Text)
+}
+
 func TestEntry_UndoRedoImage(t *testing.T) {
 	e, window := setupImageTest(t, true)
 	window.Resize(fyne.NewSize(128, 128))
@@ -0,0 +1,211 @@
+package widget
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// ExportFormat identifies a file format that collection widget data can be
+// exported to with ExportTable and ExportList.
+//
+// Since: 2.6
+type ExportFormat int
+
+const (
+	// ExportCSV writes comma separated values.
+	//
+	// Since: 2.6
+	ExportCSV ExportFormat = iota
+
+	// ExportTSV writes tab separated values.
+	//
+	// Since: 2.6
+	ExportTSV
+
+	// ExportJSON writes a JSON array with one array of cell strings per row.
+	//
+	// Since: 2.6
+	ExportJSON
+
+	// ExportXLSX writes a single sheet spreadsheet in the Office Open XML format.
+	//
+	// Since: 2.6
+	ExportXLSX
+)
+
+// ExportFormatForName returns the export format matching the extension of the
+// given file name, defaulting to ExportCSV when the extension is not known.
+//
+// Since: 2.6
+func ExportFormatForName(name string) ExportFormat {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".tsv":
+		return ExportTSV
+	case ".json":
+		return ExportJSON
+	case ".xlsx":
+		return ExportXLSX
+	}
+	return ExportCSV
+}
+
+// ExportTable writes the data behind the given table to the writer in the
+// requested format. Cell contents are read through the table's data callbacks
+// using its cell template, a header row is included when the table shows one.
+//
+// Since: 2.6
+func ExportTable(t *Table, format ExportFormat, w io.Writer) error {
+	if t.Length == nil || t.CreateCell == nil || t.UpdateCell == nil {
+		return fmt.Errorf("table has no data callbacks to export")
+	}
+
+	rows, cols := t.Length()
+	data := make([][]string, 0, rows+1)
+	if t.ShowHeaderRow && t.CreateHeader != nil && t.UpdateHeader != nil {
+		template := t.CreateHeader()
+		header := make([]string, cols)
+		for col := 0; col < cols; col++ {
+			t.UpdateHeader(TableCellID{Row: -1, Col: col}, template)
+			header[col] = exportObjectText(template)
+		}
+		data = append(data, header)
+	}
+
+	template := t.CreateCell()
+	for row := 0; row < rows; row++ {
+		cells := make([]string, cols)
+		for col := 0; col < cols; col++ {
+			t.UpdateCell(TableCellID{Row: row, Col: col}, template)
+			cells[col] = exportObjectText(template)
+		}
+		data = append(data, cells)
+	}
+	return exportRows(data, format, w)
+}
+
+// ExportList writes the data behind the given list to the writer in the
+// requested format, one row per item, read through the list's data callbacks.
+//
+// Since: 2.6
+func ExportList(l *List, format ExportFormat, w io.Writer) error {
+	if l.Length == nil || l.CreateItem == nil || l.UpdateItem == nil {
+		return fmt.Errorf("list has no data callbacks to export")
+	}
+
+	length := l.Length()
+	template := l.CreateItem()
+	data := make([][]string, length)
+	for row := 0; row < length; row++ {
+		l.UpdateItem(row, template)
+		data[row] = []string{exportObjectText(template)}
+	}
+	return exportRows(data, format, w)
+}
+
+// exportObjectText extracts the displayed text of a cell template object.
+func exportObjectText(o fyne.CanvasObject) string {
+	switch c := o.(type) {
+	case *Label:
+		return c.Text
+	case *Entry:
+		return c.Text
+	case *RichText:
+		return c.String()
+	case *TextGrid:
+		return c.Text()
+	case *Check:
+		return fmt.Sprintf("%t", c.Checked)
+	case *canvas.Text:
+		return c.Text
+	}
+	return ""
+}
+
+func exportRows(data [][]string, format ExportFormat, w io.Writer) error {
+	switch format {
+	case ExportTSV:
+		cw := csv.NewWriter(w)
+		cw.Comma = '\t'
+		return cw.WriteAll(data)
+	case ExportJSON:
+		return json.NewEncoder(w).Encode(data)
+	case ExportXLSX:
+		return exportXLSX(data, w)
+	}
+
+	return csv.NewWriter(w).WriteAll(data)
+}
+
+// exportXLSX writes the rows as a minimal single sheet workbook, cell values
+// are stored as inline strings so no shared string table is needed.
+func exportXLSX(data [][]string, w io.Writer) error {
+	var sheet bytes.Buffer
+	sheet.WriteString(xml.Header)
+	sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for i, row := range data {
+		fmt.Fprintf(&sheet, `<row r="%d">`, i+1)
+		for j, cell := range row {
+			fmt.Fprintf(&sheet, `<c r="%s%d" t="inlineStr"><is><t>`, xlsxColumnName(j), i+1)
+			if err := xml.EscapeText(&sheet, []byte(cell)); err != nil {
+				return err
+			}
+			sheet.WriteString(`</t></is></c>`)
+		}
+		sheet.WriteString(`</row>`)
+	}
+	sheet.WriteString(`</sheetData></worksheet>`)
+
+	files := []struct{ name, body string }{
+		{"[Content_Types].xml", xml.Header +
+			`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`</Types>`},
+		{"_rels/.rels", xml.Header +
+			`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`},
+		{"xl/workbook.xml", xml.Header +
+			`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets>` +
+			`</workbook>`},
+		{"xl/_rels/workbook.xml.rels", xml.Header +
+			`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`</Relationships>`},
+		{"xl/worksheets/sheet1.xml", sheet.String()},
+	}
+
+	z := zip.NewWriter(w)
+	for _, file := range files {
+		f, err := z.Create(file.name)
+		if err != nil {
+			return err
+		}
+		if _, err = io.WriteString(f, file.body); err != nil {
+			return err
+		}
+	}
+	return z.Close()
+}
+
+// xlsxColumnName returns the spreadsheet letters of the given zero based column.
+func xlsxColumnName(col int) string {
+	name := ""
+	for col >= 0 {
+		name = string(rune('A'+col%26)) + name
+		col = col/26 - 1
+	}
+	return name
+}
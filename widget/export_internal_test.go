@@ -0,0 +1,108 @@
+package widget
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+)
+
+func exportTestTable() *Table {
+	data := [][]string{{"a", "b"}, {"c", "d, with comma"}}
+	return NewTable(
+		func() (int, int) { return len(data), 2 },
+		func() fyne.CanvasObject { return NewLabel("") },
+		func(id TableCellID, template fyne.CanvasObject) {
+			template.(*Label).SetText(data[id.Row][id.Col])
+		})
+}
+
+func TestExportTable_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, ExportTable(exportTestTable(), ExportCSV, &buf))
+	assert.Equal(t, "a,b\nc,\"d, with comma\"\n", buf.String())
+}
+
+func TestExportTable_TSV(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, ExportTable(exportTestTable(), ExportTSV, &buf))
+	assert.Equal(t, "a\tb\nc\td, with comma\n", buf.String())
+}
+
+func TestExportTable_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, ExportTable(exportTestTable(), ExportJSON, &buf))
+	assert.JSONEq(t, `[["a","b"],["c","d, with comma"]]`, buf.String())
+}
+
+func TestExportTable_XLSX(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, ExportTable(exportTestTable(), ExportXLSX, &buf))
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	var sheet string
+	for _, f := range r.File {
+		if f.Name != "xl/worksheets/sheet1.xml" {
+			continue
+		}
+		rc, err := f.Open()
+		assert.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		assert.NoError(t, err)
+		assert.NoError(t, rc.Close())
+		sheet = string(content)
+	}
+	assert.Contains(t, sheet, `<c r="A1" t="inlineStr"><is><t>a</t></is></c>`)
+	assert.Contains(t, sheet, `<c r="B2" t="inlineStr"><is><t>d, with comma</t></is></c>`)
+}
+
+func TestExportTable_Header(t *testing.T) {
+	table := exportTestTable()
+	table.ShowHeaderRow = true
+	table.CreateHeader = func() fyne.CanvasObject { return NewLabel("") }
+	table.UpdateHeader = func(id TableCellID, template fyne.CanvasObject) {
+		template.(*Label).SetText(fmt.Sprintf("col %d", id.Col))
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, ExportTable(table, ExportCSV, &buf))
+	assert.True(t, strings.HasPrefix(buf.String(), "col 0,col 1\n"))
+}
+
+func TestExportList(t *testing.T) {
+	items := []string{"one", "two"}
+	list := NewList(
+		func() int { return len(items) },
+		func() fyne.CanvasObject { return NewLabel("") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(items[id])
+		})
+
+	var buf bytes.Buffer
+	assert.NoError(t, ExportList(list, ExportCSV, &buf))
+	assert.Equal(t, "one\ntwo\n", buf.String())
+
+	assert.Error(t, ExportList(NewList(nil, nil, nil), ExportCSV, &buf))
+}
+
+func TestExportFormatForName(t *testing.T) {
+	assert.Equal(t, ExportCSV, ExportFormatForName("data.csv"))
+	assert.Equal(t, ExportTSV, ExportFormatForName("data.TSV"))
+	assert.Equal(t, ExportJSON, ExportFormatForName("data.json"))
+	assert.Equal(t, ExportXLSX, ExportFormatForName("data.xlsx"))
+	assert.Equal(t, ExportCSV, ExportFormatForName("data"))
+}
+
+func TestXLSXColumnName(t *testing.T) {
+	assert.Equal(t, "A", xlsxColumnName(0))
+	assert.Equal(t, "Z", xlsxColumnName(25))
+	assert.Equal(t, "AA", xlsxColumnName(26))
+	assert.Equal(t, "AB", xlsxColumnName(27))
+}
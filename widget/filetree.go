@@ -0,0 +1,398 @@
+package widget
+
+import (
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/storage"
+)
+
+// Declare conformity with the Widget interface.
+var _ fyne.Widget = (*FileTree)(nil)
+
+// FileTree is a virtualised tree widget showing the resources nested below a
+// listable URI, such as a local directory, an archive or any other registered
+// storage repository. Listings are fetched lazily when branches are opened,
+// node icons follow the file MIME type, and where the repository supports
+// watching the tree refreshes automatically when the underlying storage
+// changes. Nodes offer rename and delete context actions on desktop.
+//
+// Since: 2.6
+type FileTree struct {
+	Tree
+
+	// Filter limits the tree to URIs that match, when set. Listable child
+	// URIs are always shown so directories can be explored.
+	Filter storage.FileFilter
+	// ShowHidden includes resources with a "." name prefix, which are
+	// hidden by default.
+	ShowHidden bool
+	// Sorter orders the children of each branch, the default shows listable
+	// URIs first and sorts alphabetically.
+	Sorter func(fyne.URI, fyne.URI) bool `json:"-"`
+
+	// MultiSelect allows multiple files to be selected, tapping a node
+	// toggles its membership of the selection set.
+	MultiSelect bool
+	// OnSelectionChanged is called with all selected URIs whenever the
+	// selection set changes, for use alongside MultiSelect.
+	OnSelectionChanged func(selected []fyne.URI) `json:"-"`
+
+	listCache     map[TreeNodeID][]TreeNodeID
+	uriCache      map[TreeNodeID]fyne.URI
+	watches       map[TreeNodeID]func()
+	multiSelected []TreeNodeID
+}
+
+// NewFileTree creates a FileTree displaying the resources below the given root URI.
+//
+// Since: 2.6
+func NewFileTree(root fyne.URI) *FileTree {
+	t := &FileTree{
+		listCache: make(map[TreeNodeID][]TreeNodeID),
+		uriCache:  make(map[TreeNodeID]fyne.URI),
+		watches:   make(map[TreeNodeID]func()),
+	}
+	t.Root = root.String()
+	t.uriCache[t.Root] = root
+	t.ChildUIDs = t.childUIDs
+	t.IsBranch = t.isBranch
+	t.CreateNode = func(bool) fyne.CanvasObject {
+		return newFileTreeNode(t)
+	}
+	t.UpdateNode = t.updateNode
+	t.OnBranchOpened = t.branchOpened
+	t.OnBranchClosed = t.branchClosed
+
+	t.ExtendBaseWidget(t)
+	return t
+}
+
+// SelectedURIs returns the URIs in the current selection set.
+func (t *FileTree) SelectedURIs() []fyne.URI {
+	selected := make([]fyne.URI, 0, len(t.multiSelected))
+	for _, uid := range t.multiSelected {
+		if u := t.uri(uid); u != nil {
+			selected = append(selected, u)
+		}
+	}
+	return selected
+}
+
+// RefreshURI drops the cached listing below the given URI and refreshes the tree,
+// for use when the underlying storage is known to have changed.
+func (t *FileTree) RefreshURI(u fyne.URI) {
+	delete(t.listCache, u.String())
+	t.Refresh()
+}
+
+// RenameURI gives the resource at the given URI a new name within its parent,
+// refreshing the listing that contains it.
+func (t *FileTree) RenameURI(u fyne.URI, name string) error {
+	parent, err := storage.Parent(u)
+	if err != nil {
+		return err
+	}
+	target, err := storage.Child(parent, name)
+	if err != nil {
+		return err
+	}
+	if err := storage.Move(u, target); err != nil {
+		return err
+	}
+
+	t.removeSelection(u.String())
+	t.RefreshURI(parent)
+	return nil
+}
+
+// DeleteURI removes the resource at the given URI from its storage repository,
+// refreshing the listing that contained it.
+func (t *FileTree) DeleteURI(u fyne.URI) error {
+	if err := storage.Delete(u); err != nil {
+		return err
+	}
+
+	t.removeSelection(u.String())
+	if parent, err := storage.Parent(u); err == nil {
+		t.RefreshURI(parent)
+	} else {
+		t.Refresh()
+	}
+	return nil
+}
+
+// Refresh re-lists any branches the tree is showing as well as updating the display.
+func (t *FileTree) Refresh() {
+	t.listCache = make(map[TreeNodeID][]TreeNodeID)
+	t.Tree.Refresh()
+}
+
+func (t *FileTree) uri(uid TreeNodeID) fyne.URI {
+	if u, ok := t.uriCache[uid]; ok {
+		return u
+	}
+
+	u, err := storage.ParseURI(uid)
+	if err != nil {
+		fyne.LogError("Unable to parse file tree node "+uid, err)
+		return nil
+	}
+	t.uriCache[uid] = u
+	return u
+}
+
+func (t *FileTree) childUIDs(uid TreeNodeID) []TreeNodeID {
+	if ids, ok := t.listCache[uid]; ok {
+		return ids
+	}
+
+	u := t.uri(uid)
+	if u == nil {
+		return nil
+	}
+	listing, err := storage.List(u)
+	if err != nil {
+		fyne.LogError("Unable to list "+uid, err)
+		return nil
+	}
+
+	uris := make([]fyne.URI, 0, len(listing))
+	for _, child := range listing {
+		if !t.ShowHidden && strings.HasPrefix(child.Name(), ".") {
+			continue
+		}
+		if t.Filter != nil && !t.Filter.Matches(child) {
+			if listable, _ := storage.CanList(child); !listable {
+				continue
+			}
+		}
+		uris = append(uris, child)
+	}
+
+	sorter := t.Sorter
+	if sorter == nil {
+		sorter = defaultFileTreeSort
+	}
+	sort.Slice(uris, func(i, j int) bool {
+		return sorter(uris[i], uris[j])
+	})
+
+	ids := make([]TreeNodeID, len(uris))
+	for i, child := range uris {
+		ids[i] = child.String()
+		t.uriCache[ids[i]] = child
+	}
+	t.listCache[uid] = ids
+	return ids
+}
+
+func (t *FileTree) isBranch(uid TreeNodeID) bool {
+	u := t.uri(uid)
+	if u == nil {
+		return false
+	}
+
+	listable, err := storage.CanList(u)
+	return err == nil && listable
+}
+
+func (t *FileTree) updateNode(uid TreeNodeID, _ bool, node fyne.CanvasObject) {
+	n := node.(*fileTreeNode)
+	n.uid = uid
+	u := t.uri(uid)
+	n.icon.SetURI(u)
+	if u == nil {
+		n.label.SetText(uid)
+	} else {
+		n.label.SetText(u.Name())
+	}
+
+	bold := t.isMultiSelected(uid)
+	if n.label.TextStyle.Bold != bold {
+		n.label.TextStyle.Bold = bold
+		n.label.Refresh()
+	}
+}
+
+// branchOpened starts watching an opened branch where the storage repository
+// supports it, so external changes refresh the listing automatically.
+func (t *FileTree) branchOpened(uid TreeNodeID) {
+	if _, ok := t.watches[uid]; ok {
+		return
+	}
+	u := t.uri(uid)
+	if u == nil {
+		return
+	}
+
+	cancel, err := storage.Watch(u, func() {
+		t.RefreshURI(u)
+	})
+	if err != nil {
+		return // repository does not support watching, rely on manual refresh
+	}
+	t.watches[uid] = cancel
+}
+
+func (t *FileTree) branchClosed(uid TreeNodeID) {
+	if cancel, ok := t.watches[uid]; ok {
+		cancel()
+		delete(t.watches, uid)
+	}
+}
+
+func (t *FileTree) isMultiSelected(uid TreeNodeID) bool {
+	for _, s := range t.multiSelected {
+		if s == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// toggleSelection flips membership of the selection set for the given node.
+func (t *FileTree) toggleSelection(uid TreeNodeID) {
+	if t.isMultiSelected(uid) {
+		t.removeSelection(uid)
+		return
+	}
+
+	t.multiSelected = append(t.multiSelected, uid)
+	t.selectionChanged()
+}
+
+func (t *FileTree) removeSelection(uid TreeNodeID) {
+	for i, s := range t.multiSelected {
+		if s == uid {
+			t.multiSelected = append(t.multiSelected[:i], t.multiSelected[i+1:]...)
+			t.selectionChanged()
+			return
+		}
+	}
+}
+
+func (t *FileTree) selectionChanged() {
+	if f := t.OnSelectionChanged; f != nil {
+		f(t.SelectedURIs())
+	}
+	t.Refresh()
+}
+
+func defaultFileTreeSort(a, b fyne.URI) bool {
+	aList, _ := storage.CanList(a)
+	bList, _ := storage.CanList(b)
+	if aList != bList {
+		return aList // listable URIs are shown first
+	}
+	return strings.ToLower(a.Name()) < strings.ToLower(b.Name())
+}
+
+// Declare conformity with interfaces.
+var _ fyne.Widget = (*fileTreeNode)(nil)
+var _ fyne.Tappable = (*fileTreeNode)(nil)
+var _ desktop.Mouseable = (*fileTreeNode)(nil)
+
+// fileTreeNode is the content shown for each tree row, handling taps for
+// multi-select and the secondary-tap context menu.
+type fileTreeNode struct {
+	BaseWidget
+
+	tree  *FileTree
+	uid   TreeNodeID
+	icon  *FileIcon
+	label *Label
+}
+
+func newFileTreeNode(tree *FileTree) *fileTreeNode {
+	n := &fileTreeNode{tree: tree, icon: NewFileIcon(nil), label: NewLabel("Template Object")}
+	n.ExtendBaseWidget(n)
+	return n
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (n *fileTreeNode) CreateRenderer() fyne.WidgetRenderer {
+	box := &fyne.Container{Layout: layout.NewHBoxLayout(),
+		Objects: []fyne.CanvasObject{n.icon, n.label}}
+	return NewSimpleRenderer(box)
+}
+
+// Tapped is called when the node is tapped, selecting it in the tree and
+// toggling the selection set when multi-select is enabled.
+//
+// Implements: fyne.Tappable
+func (n *fileTreeNode) Tapped(*fyne.PointEvent) {
+	if n.tree.MultiSelect {
+		n.tree.toggleSelection(n.uid)
+	}
+
+	if n.tree.currentFocus != "" {
+		n.tree.RefreshItem(n.tree.currentFocus)
+	}
+	n.tree.Select(n.uid)
+	if !fyne.CurrentDevice().IsMobile() {
+		canvas := fyne.CurrentApp().Driver().CanvasForObject(n.tree)
+		if canvas != nil {
+			canvas.Focus(n.tree)
+		}
+		n.tree.currentFocus = n.uid
+	}
+}
+
+// MouseDown is called on desktop so a secondary click opens the context menu.
+//
+// Implements: desktop.Mouseable
+func (n *fileTreeNode) MouseDown(ev *desktop.MouseEvent) {
+	if ev.Button != desktop.MouseButtonSecondary {
+		return
+	}
+
+	u := n.tree.uri(n.uid)
+	c := fyne.CurrentApp().Driver().CanvasForObject(n.super())
+	if u == nil || c == nil {
+		return
+	}
+
+	menu := fyne.NewMenu("",
+		fyne.NewMenuItem("Rename", func() {
+			n.tree.promptRename(u, ev.AbsolutePosition)
+		}),
+		fyne.NewMenuItem("Delete", func() {
+			if err := n.tree.DeleteURI(u); err != nil {
+				fyne.LogError("Unable to delete "+u.String(), err)
+			}
+		}))
+	ShowPopUpMenuAtPosition(menu, c, ev.AbsolutePosition)
+}
+
+// MouseUp is called when a desktop mouse button is released over the node.
+//
+// Implements: desktop.Mouseable
+func (n *fileTreeNode) MouseUp(*desktop.MouseEvent) {
+}
+
+// promptRename pops up an entry pre-filled with the current name, renaming on submit.
+func (t *FileTree) promptRename(u fyne.URI, pos fyne.Position) {
+	c := fyne.CurrentApp().Driver().CanvasForObject(t.super())
+	if c == nil {
+		return
+	}
+
+	entry := NewEntry()
+	entry.SetText(u.Name())
+	pop := NewPopUp(entry, c)
+	entry.OnSubmitted = func(name string) {
+		pop.Hide()
+		if name == "" || name == u.Name() {
+			return
+		}
+		if err := t.RenameURI(u, name); err != nil {
+			fyne.LogError("Unable to rename "+u.String(), err)
+		}
+	}
+	pop.ShowAtPosition(pos)
+	c.Focus(entry)
+}
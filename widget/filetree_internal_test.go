@@ -0,0 +1,131 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	intRepo "fyne.io/fyne/v2/internal/repository"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/storage/repository"
+	"fyne.io/fyne/v2/test"
+)
+
+// watchableTestRepo adds change notifications to the in-memory repository.
+type watchableTestRepo struct {
+	*intRepo.InMemoryRepository
+	watchers map[string][]func()
+}
+
+func (r *watchableTestRepo) Watch(u fyne.URI, onChanged func()) (func(), error) {
+	key := u.String()
+	r.watchers[key] = append(r.watchers[key], onChanged)
+	return func() {
+		delete(r.watchers, key)
+	}, nil
+}
+
+func (r *watchableTestRepo) Move(source, destination fyne.URI) error {
+	r.Data[destination.Path()] = r.Data[source.Path()]
+	delete(r.Data, source.Path())
+	return nil
+}
+
+func (r *watchableTestRepo) notify(u fyne.URI) {
+	for _, f := range r.watchers[u.String()] {
+		f()
+	}
+}
+
+func setupFileTreeRepo(t *testing.T, scheme string) (*watchableTestRepo, fyne.URI) {
+	repo := &watchableTestRepo{
+		InMemoryRepository: intRepo.NewInMemoryRepository(scheme),
+		watchers:           make(map[string][]func()),
+	}
+	repository.Register(scheme, repo)
+
+	repo.Data["/root"] = []byte{}
+	repo.Data["/root/b.txt"] = []byte("b")
+	repo.Data["/root/a.txt"] = []byte("a")
+	repo.Data["/root/.hidden"] = []byte("h")
+
+	root, err := storage.ParseURI(scheme + ":///root")
+	assert.NoError(t, err)
+	return repo, root
+}
+
+func TestFileTree_Listing(t *testing.T) {
+	_, root := setupFileTreeRepo(t, "ftreelist")
+	tree := NewFileTree(root)
+	w := test.NewTempWindow(t, tree)
+	w.Resize(fyne.NewSize(200, 200))
+
+	ids := tree.childUIDs(root.String())
+	assert.Equal(t, []TreeNodeID{"ftreelist:///root/a.txt", "ftreelist:///root/b.txt"}, ids)
+
+	tree.ShowHidden = true
+	tree.Refresh()
+	assert.Len(t, tree.childUIDs(root.String()), 3)
+}
+
+func TestFileTree_Watch(t *testing.T) {
+	repo, root := setupFileTreeRepo(t, "ftreewatch")
+	tree := NewFileTree(root)
+	w := test.NewTempWindow(t, tree)
+	w.Resize(fyne.NewSize(200, 200))
+
+	tree.OpenBranch(root.String())
+	assert.Len(t, tree.watches, 1)
+	assert.Len(t, tree.childUIDs(root.String()), 2)
+
+	repo.Data["/root/c.txt"] = []byte("c")
+	repo.notify(root)
+	assert.Len(t, tree.childUIDs(root.String()), 3)
+
+	tree.CloseBranch(root.String())
+	assert.Empty(t, tree.watches)
+}
+
+func TestFileTree_RenameDelete(t *testing.T) {
+	repo, root := setupFileTreeRepo(t, "ftreeedit")
+	tree := NewFileTree(root)
+	w := test.NewTempWindow(t, tree)
+	w.Resize(fyne.NewSize(200, 200))
+
+	a, err := storage.ParseURI("ftreeedit:///root/a.txt")
+	assert.NoError(t, err)
+	assert.NoError(t, tree.RenameURI(a, "z.txt"))
+	_, renamed := repo.Data["/root/z.txt"]
+	assert.True(t, renamed)
+	_, old := repo.Data["/root/a.txt"]
+	assert.False(t, old)
+
+	b, err := storage.ParseURI("ftreeedit:///root/b.txt")
+	assert.NoError(t, err)
+	assert.NoError(t, tree.DeleteURI(b))
+	_, deleted := repo.Data["/root/b.txt"]
+	assert.False(t, deleted)
+	assert.Equal(t, []TreeNodeID{"ftreeedit:///root/z.txt"}, tree.childUIDs(root.String()))
+}
+
+func TestFileTree_MultiSelect(t *testing.T) {
+	_, root := setupFileTreeRepo(t, "ftreesel")
+	tree := NewFileTree(root)
+	tree.MultiSelect = true
+	var selected []fyne.URI
+	tree.OnSelectionChanged = func(sel []fyne.URI) {
+		selected = sel
+	}
+	w := test.NewTempWindow(t, tree)
+	w.Resize(fyne.NewSize(200, 200))
+
+	tree.toggleSelection("ftreesel:///root/a.txt")
+	tree.toggleSelection("ftreesel:///root/b.txt")
+	assert.Len(t, selected, 2)
+	assert.Len(t, tree.SelectedURIs(), 2)
+
+	tree.toggleSelection("ftreesel:///root/a.txt")
+	assert.Len(t, selected, 1)
+	assert.Equal(t, "ftreesel:///root/b.txt", selected[0].String())
+}
@@ -0,0 +1,384 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	internalWidget "fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/theme"
+)
+
+// GraphEdgeRouting determines how GraphCanvas routes the edges between nodes.
+//
+// Since: 2.6
+type GraphEdgeRouting int
+
+const (
+	// GraphEdgeStraight connects nodes with a single direct line segment.
+	//
+	// Since: 2.6
+	GraphEdgeStraight GraphEdgeRouting = iota
+	// GraphEdgeOrthogonal connects nodes with horizontal and vertical segments only.
+	//
+	// Since: 2.6
+	GraphEdgeOrthogonal
+)
+
+// GraphNode describes a single node in a GraphModel.
+// The struct is plain data so a model can be serialized with encoding/json or similar.
+//
+// Since: 2.6
+type GraphNode struct {
+	ID       string        `json:"id"`
+	Title    string        `json:"title"`
+	Position fyne.Position `json:"position"`
+	Size     fyne.Size     `json:"size"`
+}
+
+// GraphEdge describes a directed connection between two nodes in a GraphModel.
+//
+// Since: 2.6
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// GraphModel holds the nodes and edges displayed by a GraphCanvas.
+//
+// Since: 2.6
+type GraphModel struct {
+	Nodes []*GraphNode `json:"nodes"`
+	Edges []GraphEdge  `json:"edges"`
+}
+
+// Node returns the node with the given ID, or nil if it is not in the model.
+func (m *GraphModel) Node(id string) *GraphNode {
+	for _, n := range m.Nodes {
+		if n.ID == id {
+			return n
+		}
+	}
+	return nil
+}
+
+// Declare conformity with interfaces.
+var _ fyne.Widget = (*GraphCanvas)(nil)
+var _ fyne.Draggable = (*GraphCanvas)(nil)
+var _ fyne.Scrollable = (*GraphCanvas)(nil)
+
+// GraphCanvas is a widget that displays an editable node graph described by a GraphModel.
+// Nodes can be dragged to reposition them and tapped to select them, edges are routed
+// between nodes using the configured EdgeRouting, and the viewport supports panning by
+// dragging the background and zooming with the scroll wheel.
+//
+// Since: 2.6
+type GraphCanvas struct {
+	BaseWidget
+
+	// Model is the graph being displayed. Call Refresh after changing it externally.
+	Model *GraphModel `json:"-"`
+
+	// OnNodeSelected is called when a node is selected by tapping it,
+	// or with an empty ID when the selection is cleared.
+	OnNodeSelected func(id string) `json:"-"`
+	// OnNodeMoved is called when a node drag finishes, with the new model position.
+	OnNodeMoved func(id string, pos fyne.Position) `json:"-"`
+
+	// EdgeRouting determines how edges are routed between nodes.
+	EdgeRouting GraphEdgeRouting
+
+	zoom     float32
+	offset   fyne.Position
+	selected string
+	nodes    map[string]*graphNode
+}
+
+// NewGraphCanvas creates a graph editor widget displaying the given model.
+//
+// Since: 2.6
+func NewGraphCanvas(model *GraphModel) *GraphCanvas {
+	g := &GraphCanvas{Model: model, zoom: 1}
+	g.ExtendBaseWidget(g)
+	return g
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (g *GraphCanvas) CreateRenderer() fyne.WidgetRenderer {
+	g.ExtendBaseWidget(g)
+	r := &graphCanvasRenderer{graph: g}
+	r.Refresh()
+	return r
+}
+
+// Zoom returns the current zoom factor of the viewport, where 1 is actual size.
+func (g *GraphCanvas) Zoom() float32 {
+	return g.zoom
+}
+
+// SetZoom updates the zoom factor of the viewport, clamped to the range 0.25 to 4.
+func (g *GraphCanvas) SetZoom(zoom float32) {
+	if zoom < 0.25 {
+		zoom = 0.25
+	} else if zoom > 4 {
+		zoom = 4
+	}
+	if zoom == g.zoom {
+		return
+	}
+
+	g.zoom = zoom
+	g.Refresh()
+}
+
+// SelectedNode returns the ID of the currently selected node, or an empty string.
+func (g *GraphCanvas) SelectedNode() string {
+	return g.selected
+}
+
+// SelectNode marks the node with the given ID as selected, pass an empty string to
+// clear the selection.
+func (g *GraphCanvas) SelectNode(id string) {
+	if g.selected == id {
+		return
+	}
+
+	g.selected = id
+	if f := g.OnNodeSelected; f != nil {
+		f(id)
+	}
+	g.Refresh()
+}
+
+// Dragged pans the viewport as the background of the graph is dragged.
+func (g *GraphCanvas) Dragged(ev *fyne.DragEvent) {
+	g.offset = g.offset.Add(fyne.NewPos(ev.Dragged.DX, ev.Dragged.DY))
+	g.Refresh()
+}
+
+// DragEnd is called when the viewport pan ends.
+func (g *GraphCanvas) DragEnd() {
+}
+
+// Scrolled zooms the viewport in response to the scroll wheel.
+func (g *GraphCanvas) Scrolled(ev *fyne.ScrollEvent) {
+	if ev.Scrolled.DY > 0 {
+		g.SetZoom(g.zoom * 1.1)
+	} else if ev.Scrolled.DY < 0 {
+		g.SetZoom(g.zoom / 1.1)
+	}
+}
+
+// Tapped clears the node selection when the background is tapped.
+func (g *GraphCanvas) Tapped(*fyne.PointEvent) {
+	g.SelectNode("")
+}
+
+// nodeRect returns the on-screen rectangle of a node after zoom and pan.
+func (g *GraphCanvas) nodeRect(n *GraphNode) (fyne.Position, fyne.Size) {
+	size := n.Size
+	if size.IsZero() {
+		size = fyne.NewSize(120, 60)
+	}
+	pos := fyne.NewPos(n.Position.X*g.zoom+g.offset.X, n.Position.Y*g.zoom+g.offset.Y)
+	return pos, fyne.NewSize(size.Width*g.zoom, size.Height*g.zoom)
+}
+
+// Declare conformity with the WidgetRenderer interface.
+var _ fyne.WidgetRenderer = (*graphCanvasRenderer)(nil)
+
+type graphCanvasRenderer struct {
+	internalWidget.BaseRenderer
+
+	graph *GraphCanvas
+	edges []fyne.CanvasObject
+}
+
+func (r *graphCanvasRenderer) Layout(fyne.Size) {
+	g := r.graph
+	for _, n := range g.Model.Nodes {
+		item, ok := g.nodes[n.ID]
+		if !ok {
+			continue
+		}
+		pos, size := g.nodeRect(n)
+		item.Move(pos)
+		item.Resize(size)
+	}
+	r.layoutEdges()
+}
+
+func (r *graphCanvasRenderer) MinSize() fyne.Size {
+	min := fyne.Size{}
+	for _, n := range r.graph.Model.Nodes {
+		pos, size := r.graph.nodeRect(n)
+		min = min.Max(fyne.NewSize(pos.X+size.Width, pos.Y+size.Height))
+	}
+	return min
+}
+
+func (r *graphCanvasRenderer) Refresh() {
+	g := r.graph
+	if g.nodes == nil {
+		g.nodes = make(map[string]*graphNode)
+	}
+
+	r.edges = r.edges[:0]
+	for _, e := range g.Model.Edges {
+		from, to := g.Model.Node(e.From), g.Model.Node(e.To)
+		if from == nil || to == nil {
+			continue
+		}
+		r.edges = append(r.edges, r.routeEdge(from, to)...)
+	}
+
+	seen := make(map[string]bool, len(g.Model.Nodes))
+	objects := r.edges
+	for _, n := range g.Model.Nodes {
+		item, ok := g.nodes[n.ID]
+		if !ok {
+			item = newGraphNode(g, n)
+			g.nodes[n.ID] = item
+		}
+		seen[n.ID] = true
+		item.Refresh()
+		objects = append(objects, item)
+	}
+	for id := range g.nodes {
+		if !seen[id] {
+			delete(g.nodes, id)
+		}
+	}
+
+	r.SetObjects(objects)
+	r.Layout(g.Size())
+	canvas.Refresh(g.super())
+}
+
+// routeEdge creates the line segments connecting the trailing edge of the from
+// node to the leading edge of the to node.
+func (r *graphCanvasRenderer) routeEdge(from, to *GraphNode) []fyne.CanvasObject {
+	th := r.graph.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	col := th.Color(theme.ColorNameForeground, v)
+
+	fromPos, fromSize := r.graph.nodeRect(from)
+	toPos, toSize := r.graph.nodeRect(to)
+	start := fyne.NewPos(fromPos.X+fromSize.Width, fromPos.Y+fromSize.Height/2)
+	end := fyne.NewPos(toPos.X, toPos.Y+toSize.Height/2)
+
+	line := func(a, b fyne.Position) fyne.CanvasObject {
+		l := canvas.NewLine(col)
+		l.Position1, l.Position2 = a, b
+		return l
+	}
+	if r.graph.EdgeRouting == GraphEdgeStraight {
+		return []fyne.CanvasObject{line(start, end)}
+	}
+
+	midX := (start.X + end.X) / 2
+	return []fyne.CanvasObject{
+		line(start, fyne.NewPos(midX, start.Y)),
+		line(fyne.NewPos(midX, start.Y), fyne.NewPos(midX, end.Y)),
+		line(fyne.NewPos(midX, end.Y), end),
+	}
+}
+
+func (r *graphCanvasRenderer) layoutEdges() {
+	// edge lines carry their own endpoints, nothing further to lay out
+}
+
+// Declare conformity with interfaces.
+var _ fyne.Widget = (*graphNode)(nil)
+var _ fyne.Draggable = (*graphNode)(nil)
+var _ fyne.Tappable = (*graphNode)(nil)
+
+type graphNode struct {
+	BaseWidget
+
+	graph *GraphCanvas
+	node  *GraphNode
+
+	background *canvas.Rectangle
+	label      *Label
+}
+
+func newGraphNode(graph *GraphCanvas, node *GraphNode) *graphNode {
+	n := &graphNode{graph: graph, node: node}
+	n.ExtendBaseWidget(n)
+	return n
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (n *graphNode) CreateRenderer() fyne.WidgetRenderer {
+	th := n.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	n.background = canvas.NewRectangle(th.Color(theme.ColorNameInputBackground, v))
+	n.background.StrokeColor = th.Color(theme.ColorNameInputBorder, v)
+	n.background.StrokeWidth = 1
+	n.background.CornerRadius = th.Size(theme.SizeNameInputRadius)
+	n.label = NewLabel(n.node.Title)
+	n.label.Alignment = fyne.TextAlignCenter
+	n.label.Truncation = fyne.TextTruncateEllipsis
+
+	return &graphNodeRenderer{node: n}
+}
+
+// Dragged moves the node within the graph model, following the pointer.
+func (n *graphNode) Dragged(ev *fyne.DragEvent) {
+	zoom := n.graph.zoom
+	n.node.Position = n.node.Position.Add(fyne.NewPos(ev.Dragged.DX/zoom, ev.Dragged.DY/zoom))
+	n.graph.Refresh()
+}
+
+// DragEnd notifies the graph that a node has settled at its new position.
+func (n *graphNode) DragEnd() {
+	if f := n.graph.OnNodeMoved; f != nil {
+		f(n.node.ID, n.node.Position)
+	}
+}
+
+// Tapped selects this node within the graph.
+func (n *graphNode) Tapped(*fyne.PointEvent) {
+	n.graph.SelectNode(n.node.ID)
+}
+
+// Declare conformity with the WidgetRenderer interface.
+var _ fyne.WidgetRenderer = (*graphNodeRenderer)(nil)
+
+type graphNodeRenderer struct {
+	node *graphNode
+}
+
+func (r *graphNodeRenderer) Destroy() {
+}
+
+func (r *graphNodeRenderer) Layout(size fyne.Size) {
+	r.node.background.Resize(size)
+	r.node.label.Resize(fyne.NewSize(size.Width, r.node.label.MinSize().Height))
+	r.node.label.Move(fyne.NewPos(0, (size.Height-r.node.label.Size().Height)/2))
+}
+
+func (r *graphNodeRenderer) MinSize() fyne.Size {
+	return r.node.label.MinSize()
+}
+
+func (r *graphNodeRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.node.background, r.node.label}
+}
+
+func (r *graphNodeRenderer) Refresh() {
+	th := r.node.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	if r.node.graph.selected == r.node.node.ID {
+		r.node.background.StrokeColor = th.Color(theme.ColorNamePrimary, v)
+		r.node.background.StrokeWidth = 2
+	} else {
+		r.node.background.StrokeColor = th.Color(theme.ColorNameInputBorder, v)
+		r.node.background.StrokeWidth = 1
+	}
+	r.node.background.FillColor = th.Color(theme.ColorNameInputBackground, v)
+	r.node.background.Refresh()
+
+	r.node.label.SetText(r.node.node.Title)
+}
@@ -0,0 +1,75 @@
+package widget_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+)
+
+func graphTestModel() *widget.GraphModel {
+	return &widget.GraphModel{
+		Nodes: []*widget.GraphNode{
+			{ID: "a", Title: "First", Position: fyne.NewPos(10, 10), Size: fyne.NewSize(80, 40)},
+			{ID: "b", Title: "Second", Position: fyne.NewPos(150, 80), Size: fyne.NewSize(80, 40)},
+		},
+		Edges: []widget.GraphEdge{{From: "a", To: "b"}},
+	}
+}
+
+func TestGraphCanvas_Select(t *testing.T) {
+	graph := widget.NewGraphCanvas(graphTestModel())
+	selected := ""
+	graph.OnNodeSelected = func(id string) {
+		selected = id
+	}
+	w := test.NewTempWindow(t, graph)
+	w.Resize(fyne.NewSize(300, 200))
+
+	test.TapCanvas(w.Canvas(), fyne.NewPos(20, 20))
+	assert.Equal(t, "a", graph.SelectedNode())
+	assert.Equal(t, "a", selected)
+
+	test.TapCanvas(w.Canvas(), fyne.NewPos(290, 10))
+	assert.Equal(t, "", graph.SelectedNode())
+	assert.Equal(t, "", selected)
+}
+
+func TestGraphCanvas_DragNode(t *testing.T) {
+	model := graphTestModel()
+	graph := widget.NewGraphCanvas(model)
+	moved := fyne.Position{}
+	graph.OnNodeMoved = func(id string, pos fyne.Position) {
+		assert.Equal(t, "a", id)
+		moved = pos
+	}
+	w := test.NewTempWindow(t, graph)
+	w.Resize(fyne.NewSize(300, 200))
+
+	test.Drag(w.Canvas(), fyne.NewPos(20, 20), 30, 15)
+	assert.Equal(t, fyne.NewPos(40, 25), model.Node("a").Position)
+	assert.Equal(t, fyne.NewPos(40, 25), moved)
+}
+
+func TestGraphCanvas_Zoom(t *testing.T) {
+	graph := widget.NewGraphCanvas(graphTestModel())
+	assert.Equal(t, float32(1), graph.Zoom())
+
+	graph.SetZoom(10)
+	assert.Equal(t, float32(4), graph.Zoom())
+	graph.SetZoom(0.1)
+	assert.Equal(t, float32(0.25), graph.Zoom())
+}
+
+func TestGraphCanvas_Pan(t *testing.T) {
+	graph := widget.NewGraphCanvas(graphTestModel())
+	w := test.NewTempWindow(t, graph)
+	w.Resize(fyne.NewSize(300, 200))
+
+	min := graph.MinSize()
+	graph.Dragged(&fyne.DragEvent{Dragged: fyne.Delta{DX: 25, DY: 10}})
+	assert.Equal(t, min.Add(fyne.NewSize(25, 10)), graph.MinSize())
+}
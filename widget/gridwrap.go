@@ -10,6 +10,7 @@ import (
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/internal/virtualizer"
 	"fyne.io/fyne/v2/internal/widget"
 	"fyne.io/fyne/v2/theme"
 )
@@ -17,6 +18,7 @@ import (
 // Declare conformity with interfaces.
 var _ fyne.Widget = (*GridWrap)(nil)
 var _ fyne.Focusable = (*GridWrap)(nil)
+var _ fyne.Shortcutable = (*GridWrap)(nil)
 
 // GridWrapItemID is the ID of an individual item in the GridWrap widget.
 //
@@ -37,14 +39,61 @@ type GridWrap struct {
 	OnSelected   func(id GridWrapItemID)                         `json:"-"`
 	OnUnselected func(id GridWrapItemID)                         `json:"-"`
 
+	// ItemToolTip returns rich tooltip content that pops up when the pointer
+	// dwells over the specified item, or nil for no tooltip.
+	//
+	// Since: 2.6
+	ItemToolTip func(id GridWrapItemID) fyne.CanvasObject `json:"-"`
+
+	// OnActivated is called when the user presses Return or Enter on the
+	// item that has keyboard focus, for triggering a default action.
+	//
+	// Since: 2.6
+	OnActivated func(id GridWrapItemID) `json:"-"`
+
+	// ItemSize returns the natural size of the item with the given ID. When set
+	// the fixed grid is replaced by a justified layout, where each row scales
+	// its items to a shared height that exactly fills the row width, as a photo
+	// gallery scales its images.
+	//
+	// Since: 2.6
+	ItemSize func(id GridWrapItemID) fyne.Size `json:"-"`
+
+	// KeyForItem returns a stable key identifying the data behind the given
+	// item, independent of its position. When set, Refresh matches the
+	// selection, keyboard focus and scroll position to the new positions of
+	// their items by key, so inserting or removing items does not shift them
+	// to different data.
+	//
+	// Since: 2.6
+	KeyForItem func(id GridWrapItemID) string `json:"-"`
+
 	currentFocus  ListItemID
+	typeAhead     typeAhead
+	typeAheadItem fyne.CanvasObject
 	focused       bool
 	scroller      *widget.Scroll
 	selected      []GridWrapItemID
+
+	justified      []gridWrapRow
+	justifiedValid bool
+
+	banding        bool
+	bandAdd        bool
+	bandStart      fyne.Position
+	bandEnd        fyne.Position
+	bandBase       []GridWrapItemID
+	bandRect       *canvas.Rectangle
+	pendingBandMod fyne.KeyModifier
+
 	itemMin       fyne.Size
 	offsetY       float32
 	offsetUpdated func(fyne.Position)
 	colCountCache int
+
+	itemKeys     map[GridWrapItemID]string
+	anchorItem   GridWrapItemID
+	anchorOffset float32
 }
 
 // NewGridWrap creates and returns a GridWrap widget for displaying items in
@@ -89,9 +138,18 @@ func (l *GridWrap) CreateRenderer() fyne.WidgetRenderer {
 
 	layout := &fyne.Container{Layout: newGridWrapLayout(l)}
 	l.scroller = widget.NewVScroll(layout)
+	l.scroller.OnDragged = l.bandDragged // band selection can start on empty space between items
+	l.scroller.OnDragEnd = l.bandDragEnd
 	layout.Resize(layout.MinSize())
 
-	return newGridWrapRenderer([]fyne.CanvasObject{l.scroller}, l, l.scroller, layout)
+	th := l.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	l.bandRect = canvas.NewRectangle(th.Color(theme.ColorNameSelection, v))
+	l.bandRect.StrokeColor = th.Color(theme.ColorNameFocus, v)
+	l.bandRect.StrokeWidth = 1
+	l.bandRect.Hide()
+
+	return newGridWrapRenderer([]fyne.CanvasObject{l.scroller, l.bandRect}, l, l.scroller, layout)
 }
 
 // FocusGained is called after this GridWrap has gained focus.
@@ -122,6 +180,11 @@ func (l *GridWrap) scrollTo(id GridWrapItemID) {
 		return
 	}
 
+	if l.ItemSize != nil {
+		l.scrollToJustified(id)
+		return
+	}
+
 	pad := l.Theme().Size(theme.SizeNamePadding)
 	row := math.Floor(float64(id) / float64(l.ColumnCount()))
 	y := float32(row)*l.itemMin.Height + float32(row)*pad
@@ -133,6 +196,24 @@ func (l *GridWrap) scrollTo(id GridWrapItemID) {
 	l.offsetUpdated(l.scroller.Offset)
 }
 
+// Refresh causes this widget to be redrawn in its current state. When KeyForItem
+// is set the selection, keyboard focus and scroll position are first matched to
+// the new positions of their items, see remapItemKeys.
+func (l *GridWrap) Refresh() {
+	l.remapItemKeys()
+	l.BaseWidget.Refresh()
+}
+
+// ThemeInvalidated drops the cached column count and justified row layout when
+// the grid is refreshed, as the item size they are built from depends on the
+// theme and rendering scale.
+//
+// Since: 2.6
+func (l *GridWrap) ThemeInvalidated() {
+	l.colCountCache = 0
+	l.justifiedValid = false
+}
+
 // RefreshItem refreshes a single item, specified by the item ID passed in.
 //
 // Since: 2.4
@@ -153,6 +234,7 @@ func (l *GridWrap) RefreshItem(id GridWrapItemID) {
 // Resize is called when this GridWrap should change size. We refresh to ensure invisible items are drawn.
 func (l *GridWrap) Resize(s fyne.Size) {
 	l.colCountCache = 0
+	l.justifiedValid = false
 	l.BaseWidget.Resize(s)
 	l.offsetUpdated(l.scroller.Offset)
 	l.scroller.Content.(*fyne.Container).Layout.(*gridWrapLayout).updateGrid(true)
@@ -243,6 +325,16 @@ func (l *GridWrap) TypedKey(event *fyne.KeyEvent) {
 	switch event.Name {
 	case fyne.KeySpace:
 		l.Select(l.currentFocus)
+	case fyne.KeyReturn, fyne.KeyEnter:
+		if f := l.OnActivated; f != nil {
+			f(l.currentFocus)
+		}
+	case fyne.KeyHome:
+		l.focusItem(0)
+	case fyne.KeyEnd:
+		if f := l.Length; f != nil && f() > 0 {
+			l.focusItem(f() - 1)
+		}
 	case fyne.KeyDown:
 		count := 0
 		if f := l.Length; f != nil {
@@ -294,10 +386,66 @@ func (l *GridWrap) TypedKey(event *fyne.KeyEvent) {
 }
 
 // TypedRune is called if a text event happens while this GridWrap is focused.
+// Typing jumps the keyboard focus to the next item whose text starts with the
+// typed prefix.
 //
 // Implements: fyne.Focusable
-func (l *GridWrap) TypedRune(_ rune) {
-	// intentionally left blank
+func (l *GridWrap) TypedRune(r rune) {
+	prefix := l.typeAhead.add(r)
+	count := 0
+	if f := l.Length; f != nil {
+		count = f()
+	}
+	if count == 0 || l.CreateItem == nil || l.UpdateItem == nil {
+		return
+	}
+	if l.typeAheadItem == nil {
+		l.typeAheadItem = createItemAndApplyThemeScope(l.CreateItem, l)
+	}
+
+	for i := 0; i < count; i++ {
+		id := (l.currentFocus + i) % count
+		l.UpdateItem(id, l.typeAheadItem)
+		if typeAheadMatches(l.typeAheadItem, prefix) {
+			l.focusItem(id)
+			return
+		}
+	}
+}
+
+// TypedShortcut is called if a shortcut event happens while this GridWrap is
+// focused, jumping to the first or last item for Ctrl+Home and Ctrl+End.
+//
+// Implements: fyne.Shortcutable
+//
+// Since: 2.6
+func (l *GridWrap) TypedShortcut(s fyne.Shortcut) {
+	cs, ok := s.(*desktop.CustomShortcut)
+	if !ok || cs.Modifier != fyne.KeyModifierControl {
+		return
+	}
+
+	switch cs.KeyName {
+	case fyne.KeyHome:
+		l.focusItem(0)
+	case fyne.KeyEnd:
+		if f := l.Length; f != nil && f() > 0 {
+			l.focusItem(f() - 1)
+		}
+	}
+}
+
+// focusItem moves the keyboard focus to the given item, scrolling to keep it
+// visible.
+func (l *GridWrap) focusItem(id GridWrapItemID) {
+	if id == l.currentFocus {
+		return
+	}
+
+	l.RefreshItem(l.currentFocus)
+	l.currentFocus = id
+	l.scrollTo(l.currentFocus)
+	l.RefreshItem(l.currentFocus)
 }
 
 // GetScrollOffset returns the current scroll offset position
@@ -340,6 +488,15 @@ func (l *GridWrap) contentMinSize() fyne.Size {
 		return fyne.NewSize(0, 0)
 	}
 
+	if l.ItemSize != nil {
+		rows := l.justifiedRows()
+		if len(rows) == 0 {
+			return fyne.NewSize(0, 0)
+		}
+		last := rows[len(rows)-1]
+		return fyne.NewSize(l.itemMin.Width, last.y+last.height)
+	}
+
 	cols := l.ColumnCount()
 	rows := float32(math.Ceil(float64(l.Length()) / float64(cols)))
 	return fyne.NewSize(l.itemMin.Width, (l.itemMin.Height+padding)*rows-padding)
@@ -376,6 +533,7 @@ func (l *gridWrapRenderer) Refresh() {
 
 		l.list.itemMin = item.MinSize()
 	}
+	l.list.justifiedValid = false
 	l.Layout(l.list.Size())
 	l.scroller.Refresh()
 	l.layout.Layout.(*gridWrapLayout).updateGrid(true)
@@ -400,7 +558,11 @@ type gridWrapItem struct {
 	onTapped          func()
 	background        *canvas.Rectangle
 	child             fyne.CanvasObject
+	parent            *GridWrap
 	hovered, selected bool
+
+	toolTip        func() fyne.CanvasObject
+	toolTipHandler toolTipHandler
 }
 
 func newGridWrapItem(child fyne.CanvasObject, tapped func()) *gridWrapItem {
@@ -435,8 +597,11 @@ func (gw *gridWrapItem) MinSize() fyne.Size {
 }
 
 // MouseIn is called when a desktop pointer enters the widget.
-func (gw *gridWrapItem) MouseIn(*desktop.MouseEvent) {
+func (gw *gridWrapItem) MouseIn(ev *desktop.MouseEvent) {
 	gw.hovered = true
+	if gw.toolTip != nil {
+		gw.toolTipHandler.showAfterDelay(gw, gw.toolTip, ev.AbsolutePosition)
+	}
 	gw.Refresh()
 }
 
@@ -447,11 +612,13 @@ func (gw *gridWrapItem) MouseMoved(*desktop.MouseEvent) {
 // MouseOut is called when a desktop pointer exits the widget.
 func (gw *gridWrapItem) MouseOut() {
 	gw.hovered = false
+	gw.toolTipHandler.dismiss()
 	gw.Refresh()
 }
 
 // Tapped is called when a pointer tapped event is captured and triggers any tap handler.
 func (gw *gridWrapItem) Tapped(*fyne.PointEvent) {
+	gw.toolTipHandler.dismiss()
 	if gw.onTapped != nil {
 		gw.selected = true
 		gw.Refresh()
@@ -554,6 +721,7 @@ func (l *gridWrapLayout) offsetUpdated(pos fyne.Position) {
 }
 
 func (l *gridWrapLayout) setupGridItem(li *gridWrapItem, id GridWrapItemID, focus bool) {
+	li.parent = l.list
 	previousIndicator := li.selected
 	li.selected = false
 	for _, s := range l.list.selected {
@@ -569,6 +737,11 @@ func (l *gridWrapLayout) setupGridItem(li *gridWrapItem, id GridWrapItemID, focu
 		li.hovered = false
 		li.Refresh()
 	}
+	if f := l.list.ItemToolTip; f != nil {
+		li.toolTip = func() fyne.CanvasObject { return f(id) }
+	} else {
+		li.toolTip = nil
+	}
 	if f := l.list.UpdateItem; f != nil {
 		f(id, li.child)
 	}
@@ -604,6 +777,11 @@ func (l *GridWrap) ColumnCount() int {
 }
 
 func (l *gridWrapLayout) updateGrid(refresh bool) {
+	if l.list.ItemSize != nil {
+		l.updateJustified(refresh)
+		return
+	}
+
 	// code here is a mashup of listLayout.updateList and gridWrapLayout.Layout
 	padding := l.list.Theme().Size(theme.SizeNamePadding)
 
@@ -614,12 +792,10 @@ func (l *gridWrapLayout) updateGrid(refresh bool) {
 	}
 
 	colCount := l.list.ColumnCount()
-	visibleRowsCount := int(math.Ceil(float64(l.list.scroller.Size().Height)/float64(l.list.itemMin.Height+padding))) + 1
+	totalRows := int(math.Ceil(float64(length) / float64(colCount)))
 
-	offY := l.list.offsetY - float32(math.Mod(float64(l.list.offsetY), float64(l.list.itemMin.Height+padding)))
-	minRow := int(offY / (l.list.itemMin.Height + padding))
+	minRow, maxRow, offY := virtualizer.OverscanWindow(l.list.offsetY, l.list.scroller.Size().Height, l.list.itemMin.Height, padding, totalRows)
 	minItem := GridWrapItemID(minRow * colCount)
-	maxRow := int(math.Min(float64(minRow+visibleRowsCount), math.Ceil(float64(length)/float64(colCount))))
 	maxItem := GridWrapItemID(math.Min(float64(maxRow*colCount), float64(length-1)))
 
 	if l.list.UpdateItem == nil {
@@ -680,6 +856,7 @@ func (l *gridWrapLayout) updateGrid(refresh bool) {
 	visible = append(visible, l.visible...)
 	l.renderLock.Unlock() // user code should not be locked
 
+	l.list.captureItemKeys(minItem, l.list.offsetY-offY)
 	for _, obj := range visible {
 		l.setupGridItem(obj.item, obj.id, l.list.focused && l.list.currentFocus == obj.id)
 	}
@@ -0,0 +1,189 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// gridWrapRow describes one computed row of a justified GridWrap layout.
+type gridWrapRow struct {
+	y, height float32
+	first     GridWrapItemID
+	widths    []float32
+}
+
+// justifiedRows returns the row layout driven by the ItemSize callback,
+// computing and caching it when the width, data or theme invalidated it.
+// Items are scaled to a shared base height and packed into rows, then each
+// full row is scaled again so its items exactly fill the width.
+func (l *GridWrap) justifiedRows() []gridWrapRow {
+	if l.justifiedValid {
+		return l.justified
+	}
+
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+	pad := l.Theme().Size(theme.SizeNamePadding)
+	width := l.Size().Width
+	base := l.itemMin.Height
+	if base <= 0 {
+		base = 1
+	}
+
+	rows := l.justified[:0]
+	y := float32(0)
+	var widths []float32
+	rowW := float32(0)
+	flush := func(next GridWrapItemID, last bool) {
+		if len(widths) == 0 {
+			return
+		}
+		avail := width - pad*float32(len(widths)-1)
+		scale := float32(1)
+		if rowW > 0 && avail > 0 && (!last || rowW > avail) {
+			scale = avail / rowW
+		}
+		for i := range widths {
+			widths[i] *= scale
+		}
+		height := base * scale
+		rows = append(rows, gridWrapRow{y: y, height: height, first: next - GridWrapItemID(len(widths)), widths: widths})
+		y += height + pad
+		widths = nil
+		rowW = 0
+	}
+	for id := 0; id < length; id++ {
+		size := l.ItemSize(id)
+		w := l.itemMin.Width
+		if size.Height > 0 {
+			w = size.Width * base / size.Height
+		}
+		widths = append(widths, w)
+		rowW += w
+		if rowW+pad*float32(len(widths)-1) >= width {
+			flush(GridWrapItemID(id)+1, false)
+		}
+	}
+	flush(GridWrapItemID(length), true)
+
+	l.justified = rows
+	l.justifiedValid = true
+	return rows
+}
+
+// justifiedRowOf returns the computed row containing the given item.
+func (l *GridWrap) justifiedRowOf(id GridWrapItemID) (gridWrapRow, bool) {
+	for _, row := range l.justifiedRows() {
+		if id < row.first+GridWrapItemID(len(row.widths)) {
+			return row, true
+		}
+	}
+	return gridWrapRow{}, false
+}
+
+// scrollToJustified adjusts the scroll offset to reveal the row of the given
+// item in a justified layout.
+func (l *GridWrap) scrollToJustified(id GridWrapItemID) {
+	row, ok := l.justifiedRowOf(id)
+	if !ok {
+		return
+	}
+	if row.y < l.scroller.Offset.Y {
+		l.scroller.Offset.Y = row.y
+	} else if size := l.scroller.Size(); row.y+row.height > l.scroller.Offset.Y+size.Height {
+		l.scroller.Offset.Y = row.y + row.height - size.Height
+	}
+	l.offsetUpdated(l.scroller.Offset)
+}
+
+// updateJustified is the justified counterpart of updateGrid, laying the pooled
+// items out on the heterogeneous rows computed from the ItemSize callback.
+func (l *gridWrapLayout) updateJustified(refresh bool) {
+	padding := l.list.Theme().Size(theme.SizeNamePadding)
+
+	l.renderLock.Lock()
+	rows := l.list.justifiedRows()
+	viewY := l.list.offsetY
+	viewH := l.list.scroller.Size().Height
+
+	if l.list.UpdateItem == nil {
+		fyne.LogError("Missing UpdateCell callback required for GridWrap", nil)
+	}
+
+	// Keep pointer reference for copying slice header when returning to the pool
+	// https://blog.mike.norgate.xyz/unlocking-go-slice-performance-navigating-sync-pool-for-enhanced-efficiency-7cb63b0b453e
+	wasVisiblePtr := l.slicePool.Get().(*[]gridItemAndID)
+	wasVisible := (*wasVisiblePtr)[:0]
+	wasVisible = append(wasVisible, l.visible...)
+
+	oldVisibleLen := len(l.visible)
+	l.visible = l.visible[:0]
+
+	c := l.list.scroller.Content.(*fyne.Container)
+	oldObjLen := len(c.Objects)
+	c.Objects = c.Objects[:0]
+	for _, row := range rows {
+		if row.y > viewY+viewH {
+			break
+		}
+		if row.y+row.height < viewY {
+			continue
+		}
+		x := float32(0)
+		for i, w := range row.widths {
+			id := row.first + GridWrapItemID(i)
+			item, ok := l.searchVisible(wasVisible, id)
+			if !ok {
+				item = l.getItem()
+				if item == nil {
+					continue
+				}
+			}
+
+			item.Move(fyne.NewPos(x, row.y))
+			item.Resize(fyne.NewSize(w, row.height))
+
+			x += w + padding
+			l.visible = append(l.visible, gridItemAndID{item: item, id: id})
+			c.Objects = append(c.Objects, item)
+		}
+	}
+	l.nilOldSliceData(c.Objects, len(c.Objects), oldObjLen)
+	l.nilOldVisibleSliceData(l.visible, len(l.visible), oldVisibleLen)
+
+	for _, old := range wasVisible {
+		if _, ok := l.searchVisible(l.visible, old.id); !ok {
+			l.itemPool.Release(old.item)
+		}
+	}
+
+	// make a local deep copy of l.visible since rest of this function is unlocked
+	// and cannot safely access l.visible
+	visiblePtr := l.slicePool.Get().(*[]gridItemAndID)
+	visible := (*visiblePtr)[:0]
+	visible = append(visible, l.visible...)
+	l.renderLock.Unlock() // user code should not be locked
+
+	if len(visible) > 0 {
+		if row, ok := l.list.justifiedRowOf(visible[0].id); ok {
+			l.list.captureItemKeys(visible[0].id, viewY-row.y)
+		}
+	}
+	for _, obj := range visible {
+		l.setupGridItem(obj.item, obj.id, l.list.focused && l.list.currentFocus == obj.id)
+	}
+
+	// nil out all references before returning slices to pool
+	for i := 0; i < len(wasVisible); i++ {
+		wasVisible[i].item = nil
+	}
+	for i := 0; i < len(visible); i++ {
+		visible[i].item = nil
+	}
+	*wasVisiblePtr = wasVisible // Copy the slice header over to the heap
+	*visiblePtr = visible
+	l.slicePool.Put(wasVisiblePtr)
+	l.slicePool.Put(visiblePtr)
+}
@@ -0,0 +1,248 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Declare conformity with the Mouseable interface.
+var _ desktop.Mouseable = (*GridWrap)(nil)
+
+// MouseDown is called when a desktop mouse button is pressed over the widget,
+// recording the modifier so a following band drag can extend the selection.
+func (l *GridWrap) MouseDown(ev *desktop.MouseEvent) {
+	if ev.Button == desktop.MouseButtonPrimary {
+		l.pendingBandMod = ev.Modifier
+	}
+}
+
+// MouseUp is called when a desktop mouse button is released over the widget.
+func (l *GridWrap) MouseUp(*desktop.MouseEvent) {
+}
+
+// bandDragged begins or extends a rubber-band selection, selecting every item
+// that intersects the dragged rectangle. When the drag began with the control
+// or command key held the items are added to the existing selection.
+func (l *GridWrap) bandDragged(e *fyne.DragEvent) {
+	pos := l.bandPosition(e)
+	if !l.banding {
+		l.banding = true
+		l.bandStart = pos.SubtractXY(e.Dragged.DX, e.Dragged.DY)
+		l.bandAdd = l.pendingBandMod&(fyne.KeyModifierControl|fyne.KeyModifierSuper) != 0
+		if l.bandAdd {
+			l.bandBase = append([]GridWrapItemID{}, l.selected...)
+		} else {
+			l.bandBase = nil
+		}
+	}
+	l.bandEnd = pos
+	l.updateBand()
+}
+
+// bandDragEnd finishes a rubber-band selection, keeping the selected items.
+func (l *GridWrap) bandDragEnd() {
+	if !l.banding {
+		return
+	}
+	l.banding = false
+	l.bandBase = nil
+	if l.bandRect != nil {
+		l.bandRect.Hide()
+		canvas.Refresh(l.bandRect)
+	}
+}
+
+// bandPosition converts the pointer position of a drag event into content
+// coordinates, so a band is unaffected by the widget scrolling under it.
+func (l *GridWrap) bandPosition(e *fyne.DragEvent) fyne.Position {
+	origin := fyne.CurrentApp().Driver().AbsolutePositionForObject(l.super())
+	pos := e.AbsolutePosition.Subtract(origin)
+	return fyne.NewPos(pos.X, pos.Y+l.offsetY)
+}
+
+// updateBand positions the band rectangle and selects the items it covers.
+func (l *GridWrap) updateBand() {
+	x1, x2 := l.bandStart.X, l.bandEnd.X
+	if x1 > x2 {
+		x1, x2 = x2, x1
+	}
+	y1, y2 := l.bandStart.Y, l.bandEnd.Y
+	if y1 > y2 {
+		y1, y2 = y2, y1
+	}
+
+	l.selectBand(x1, y1, x2, y2)
+	if l.bandRect != nil {
+		l.bandRect.Move(fyne.NewPos(x1, y1-l.offsetY))
+		l.bandRect.Resize(fyne.NewSize(x2-x1, y2-y1))
+		l.bandRect.Show()
+		canvas.Refresh(l.bandRect)
+	}
+}
+
+// selectBand selects every item intersecting the given content rectangle,
+// on top of any selection recorded when the band began.
+func (l *GridWrap) selectBand(x1, y1, x2, y2 float32) {
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+	if length == 0 {
+		return
+	}
+
+	pad := l.Theme().Size(theme.SizeNamePadding)
+	if l.ItemSize != nil { // justified rows have per-item geometry
+		sel := append([]GridWrapItemID{}, l.bandBase...)
+		for _, row := range l.justifiedRows() {
+			if row.y > y2 {
+				break
+			}
+			if row.y+row.height < y1 {
+				continue
+			}
+			x := float32(0)
+			for i, w := range row.widths {
+				if x <= x2 && x+w >= x1 {
+					if id := row.first + GridWrapItemID(i); !containsGridWrapItemID(sel, id) {
+						sel = append(sel, id)
+					}
+				}
+				x += w + pad
+			}
+		}
+		l.replaceSelection(sel)
+		return
+	}
+
+	cols := l.ColumnCount()
+	cellW, cellH := l.itemMin.Width+pad, l.itemMin.Height+pad
+
+	// only the covered rows and columns are visited, so a band stays cheap on large grids
+	startCol, endCol := bandRange(x1, x2, l.itemMin.Width, cellW, cols)
+	startRow, endRow := bandRange(y1, y2, l.itemMin.Height, cellH, (length+cols-1)/cols)
+
+	sel := append([]GridWrapItemID{}, l.bandBase...)
+	for row := startRow; row <= endRow; row++ {
+		for col := startCol; col <= endCol; col++ {
+			id := row*cols + col
+			if id >= length {
+				break
+			}
+			if !containsGridWrapItemID(sel, id) {
+				sel = append(sel, id)
+			}
+		}
+	}
+	l.replaceSelection(sel)
+}
+
+// bandRange returns the first and last cell index intersecting the given span,
+// for cells of the given size laid out on the given stride.
+func bandRange(from, to, size, stride float32, count int) (start, end int) {
+	start = int(from / stride)
+	if float32(start)*stride+size < from {
+		start++
+	}
+	end = int(to / stride)
+	if to < 0 {
+		end = -1
+	}
+	if start < 0 {
+		start = 0
+	}
+	if end >= count {
+		end = count - 1
+	}
+	return start, end
+}
+
+// replaceSelection swaps the current selection for the given items, firing
+// the selection callbacks only for items that changed state.
+func (l *GridWrap) replaceSelection(sel []GridWrapItemID) {
+	old := l.selected
+	if gridWrapSelectionEqual(old, sel) {
+		return
+	}
+	l.selected = sel
+	l.Refresh()
+
+	if f := l.OnUnselected; f != nil {
+		for _, id := range old {
+			if !containsGridWrapItemID(sel, id) {
+				f(id)
+			}
+		}
+	}
+	if f := l.OnSelected; f != nil {
+		for _, id := range sel {
+			if !containsGridWrapItemID(old, id) {
+				f(id)
+			}
+		}
+	}
+}
+
+func gridWrapSelectionEqual(a, b []GridWrapItemID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, id := range a {
+		if b[i] != id {
+			return false
+		}
+	}
+	return true
+}
+
+func containsGridWrapItemID(ids []GridWrapItemID, id GridWrapItemID) bool {
+	for _, s := range ids {
+		if s == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Declare conformity with the Draggable and Mouseable interfaces.
+var _ fyne.Draggable = (*gridWrapItem)(nil)
+var _ desktop.Mouseable = (*gridWrapItem)(nil)
+
+// Dragged forwards drags to the band selection of the owning GridWrap on
+// desktop, or to the scroller on mobile where dragging scrolls.
+func (gw *gridWrapItem) Dragged(e *fyne.DragEvent) {
+	if gw.parent == nil {
+		return
+	}
+	if fyne.CurrentDevice().IsMobile() {
+		gw.parent.scroller.Dragged(e)
+		return
+	}
+	gw.parent.bandDragged(e)
+}
+
+// DragEnd is called when a drag event over the item completes.
+func (gw *gridWrapItem) DragEnd() {
+	if gw.parent == nil {
+		return
+	}
+	if fyne.CurrentDevice().IsMobile() {
+		gw.parent.scroller.DragEnd()
+		return
+	}
+	gw.parent.bandDragEnd()
+}
+
+// MouseDown is called when a desktop mouse button is pressed over the item,
+// recording the modifier so a following band drag can extend the selection.
+func (gw *gridWrapItem) MouseDown(ev *desktop.MouseEvent) {
+	if gw.parent != nil && ev.Button == desktop.MouseButtonPrimary {
+		gw.parent.pendingBandMod = ev.Modifier
+	}
+}
+
+// MouseUp is called when a desktop mouse button is released over the item.
+func (gw *gridWrapItem) MouseUp(*desktop.MouseEvent) {
+}
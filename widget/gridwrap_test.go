@@ -1,9 +1,11 @@
 package widget
 
 import (
+	"fmt"
 	"testing"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/test"
 	"fyne.io/fyne/v2/theme"
 	"github.com/stretchr/testify/assert"
@@ -242,3 +244,166 @@ func TestGridWrap_Selection(t *testing.T) {
 	assert.Equal(t, -1, selected)
 	assert.Equal(t, 9, unselected)
 }
+
+func TestGridWrap_KeyForItem(t *testing.T) {
+	data := make([]string, 20)
+	for i := range data {
+		data[i] = fmt.Sprintf("item %d", i)
+	}
+	grid := NewGridWrap(
+		func() int { return len(data) },
+		func() fyne.CanvasObject { return NewLabel("Template Object") },
+		func(id GridWrapItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(data[id])
+		})
+	grid.KeyForItem = func(id GridWrapItemID) string { return data[id] }
+
+	w := test.NewTempWindow(t, grid)
+	w.Resize(fyne.NewSize(200, 200))
+
+	grid.Select(10)
+
+	// inserting items moves the selection with its data
+	data = append([]string{"x", "y"}, data...)
+	grid.Refresh()
+	assert.Equal(t, []GridWrapItemID{12}, grid.selected)
+
+	// removing the selected item drops it from the selection
+	data = append(data[:12:12], data[13:]...)
+	grid.Refresh()
+	assert.Empty(t, grid.selected)
+}
+
+func TestGridWrap_ThemeInvalidated(t *testing.T) {
+	grid := createGridWrap(40)
+	w := test.NewTempWindow(t, grid)
+	w.Resize(fyne.NewSize(200, 200))
+
+	cols := grid.ColumnCount()
+	grid.colCountCache = cols + 5 // as left stale by a theme size or scale change
+	grid.Refresh()
+	assert.Equal(t, cols, grid.ColumnCount(), "refresh should re-measure the column count")
+}
+
+func TestGridWrap_KeyboardNavigation_HomeEndActivate(t *testing.T) {
+	grid := NewGridWrap(
+		func() int { return 9 },
+		func() fyne.CanvasObject { return NewLabel("placeholder") },
+		func(GridWrapItemID, fyne.CanvasObject) {})
+	activated := -1
+	grid.OnActivated = func(id GridWrapItemID) {
+		activated = id
+	}
+	w := test.NewTempWindow(t, grid)
+	w.Resize(fyne.NewSize(200, 200))
+
+	grid.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEnd})
+	assert.Equal(t, 8, grid.currentFocus)
+
+	grid.TypedKey(&fyne.KeyEvent{Name: fyne.KeyHome})
+	assert.Equal(t, 0, grid.currentFocus)
+
+	grid.TypedShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyEnd, Modifier: fyne.KeyModifierControl})
+	assert.Equal(t, 8, grid.currentFocus)
+
+	grid.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, 8, activated)
+}
+
+func TestGridWrap_TypeAhead(t *testing.T) {
+	values := []string{"apple", "banana", "blueberry", "cherry"}
+	grid := NewGridWrap(
+		func() int { return len(values) },
+		func() fyne.CanvasObject { return NewLabel("placeholder") },
+		func(id GridWrapItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(values[id])
+		})
+	w := test.NewTempWindow(t, grid)
+	w.Resize(fyne.NewSize(200, 200))
+
+	grid.TypedRune('b')
+	assert.Equal(t, 1, grid.currentFocus)
+
+	grid.TypedRune('l') // extends the prefix to "bl" within the timeout
+	assert.Equal(t, 2, grid.currentFocus)
+}
+
+func TestGridWrap_RubberBandSelection(t *testing.T) {
+	grid := NewGridWrap(
+		func() int { return 9 },
+		func() fyne.CanvasObject { return NewLabel("placeholder") },
+		func(GridWrapItemID, fyne.CanvasObject) {})
+	selected := make(map[GridWrapItemID]bool)
+	grid.OnSelected = func(id GridWrapItemID) { selected[id] = true }
+	grid.OnUnselected = func(id GridWrapItemID) { delete(selected, id) }
+	w := test.NewTempWindow(t, grid)
+	w.Resize(fyne.NewSize(200, 200))
+
+	origin := fyne.CurrentApp().Driver().AbsolutePositionForObject(grid)
+	drag := func(from, to fyne.Position) {
+		grid.bandDragged(&fyne.DragEvent{
+			PointEvent: fyne.PointEvent{AbsolutePosition: origin.Add(to)},
+			Dragged:    fyne.Delta{DX: to.X - from.X, DY: to.Y - from.Y}})
+	}
+
+	pad := grid.Theme().Size(theme.SizeNamePadding)
+	cols := grid.ColumnCount()
+	assert.Equal(t, 2, cols)
+
+	drag(fyne.NewPos(1, 1), fyne.NewPos(grid.itemMin.Width+pad+1, grid.itemMin.Height+pad+1))
+	assert.True(t, grid.bandRect.Visible())
+	assert.ElementsMatch(t, []GridWrapItemID{0, 1, cols, cols + 1}, grid.selected)
+
+	grid.bandDragEnd()
+	assert.False(t, grid.bandRect.Visible())
+
+	// control-dragging elsewhere adds to the existing selection
+	grid.MouseDown(&desktop.MouseEvent{Button: desktop.MouseButtonPrimary, Modifier: fyne.KeyModifierControl})
+	y := 2*(grid.itemMin.Height+pad) + 1
+	drag(fyne.NewPos(1, y), fyne.NewPos(2, y+1))
+	grid.bandDragEnd()
+	assert.ElementsMatch(t, []GridWrapItemID{0, 1, cols, cols + 1, 2 * cols}, grid.selected)
+	assert.True(t, selected[2*cols])
+}
+
+func TestGridWrap_Justified(t *testing.T) {
+	sizes := []fyne.Size{
+		{Width: 100, Height: 50}, {Width: 200, Height: 50}, {Width: 50, Height: 100},
+		{Width: 120, Height: 60}, {Width: 80, Height: 40}, {Width: 160, Height: 40},
+	}
+	grid := NewGridWrap(
+		func() int { return len(sizes) },
+		func() fyne.CanvasObject { return NewLabel("item") },
+		func(GridWrapItemID, fyne.CanvasObject) {})
+	grid.ItemSize = func(id GridWrapItemID) fyne.Size {
+		return sizes[id]
+	}
+	w := test.NewTempWindow(t, grid)
+	w.Resize(fyne.NewSize(300, 200))
+
+	rows := grid.justifiedRows()
+	assert.Greater(t, len(rows), 1)
+
+	// every row shares one height and every full row exactly fills the width
+	pad := grid.Theme().Size(theme.SizeNamePadding)
+	width := grid.Size().Width
+	for _, row := range rows[:len(rows)-1] {
+		total := pad * float32(len(row.widths)-1)
+		for _, w := range row.widths {
+			total += w
+		}
+		assert.InDelta(t, width, total, 0.5)
+	}
+
+	// the visible items are laid out on the computed rows
+	lo := grid.scroller.Content.(*fyne.Container).Layout.(*gridWrapLayout)
+	item, ok := lo.searchVisible(lo.visible, 0)
+	assert.True(t, ok)
+	assert.Equal(t, rows[0].height, item.Size().Height)
+	assert.InDelta(t, rows[0].widths[0], item.Size().Width, 0.5)
+
+	grid.ScrollTo(GridWrapItemID(len(sizes) - 1))
+	last, ok := lo.searchVisible(lo.visible, len(sizes)-1)
+	assert.True(t, ok)
+	assert.Equal(t, rows[len(rows)-1].height, last.Size().Height)
+}
@@ -0,0 +1,316 @@
+package widget
+
+import (
+	"fmt"
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+)
+
+const heatmapCellGap = float32(2)
+
+// Declare conformity with interfaces.
+var _ fyne.Widget = (*HeatmapCalendar)(nil)
+var _ fyne.Tappable = (*HeatmapCalendar)(nil)
+var _ desktop.Hoverable = (*HeatmapCalendar)(nil)
+
+// HeatmapCalendar shows one value per day of a year as a grid of colored
+// cells, one column per week, in the style of a contribution graph.
+// Cell colors scale from the theme input background for empty days to the
+// fully saturated primary color for the largest value. Hovering a cell shows
+// the date and value, tapping one calls OnTapped.
+//
+// Since: 2.6
+type HeatmapCalendar struct {
+	BaseWidget
+
+	// Year is the year whose days are shown.
+	Year int
+	// OnTapped is called with the day and current value of a tapped cell.
+	OnTapped func(day time.Time, value float64) `json:"-"`
+
+	values   map[int]float64 // keyed by day of year
+	maxValue float64
+	hovered  int // day of year, or zero when no cell is hovered
+	tooltip  *PopUp
+}
+
+// NewHeatmapCalendar creates a calendar heatmap for the given year with no values set.
+//
+// Since: 2.6
+func NewHeatmapCalendar(year int) *HeatmapCalendar {
+	h := &HeatmapCalendar{Year: year, values: make(map[int]float64)}
+	h.ExtendBaseWidget(h)
+	return h
+}
+
+// SetValue sets the value shown for the given day.
+// Days outside the calendar year are ignored.
+func (h *HeatmapCalendar) SetValue(day time.Time, value float64) {
+	if day.Year() != h.Year {
+		return
+	}
+
+	h.values[day.YearDay()] = value
+	h.updateMaxValue()
+	h.Refresh()
+}
+
+// SetValues replaces all values of the calendar with the given set.
+// Days outside the calendar year are ignored.
+func (h *HeatmapCalendar) SetValues(values map[time.Time]float64) {
+	h.values = make(map[int]float64, len(values))
+	for day, value := range values {
+		if day.Year() != h.Year {
+			continue
+		}
+		h.values[day.YearDay()] = value
+	}
+	h.updateMaxValue()
+	h.Refresh()
+}
+
+// Value returns the value currently shown for the given day.
+func (h *HeatmapCalendar) Value(day time.Time) float64 {
+	if day.Year() != h.Year {
+		return 0
+	}
+	return h.values[day.YearDay()]
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (h *HeatmapCalendar) CreateRenderer() fyne.WidgetRenderer {
+	h.ExtendBaseWidget(h)
+	r := &heatmapCalendarRenderer{heatmap: h}
+	r.rebuild()
+	return r
+}
+
+// Tapped is called when a pointer tapped a cell, reporting its day to OnTapped.
+//
+// Implements: fyne.Tappable
+func (h *HeatmapCalendar) Tapped(ev *fyne.PointEvent) {
+	f := h.OnTapped
+	if f == nil {
+		return
+	}
+	if yearDay := h.yearDayAt(ev.Position); yearDay > 0 {
+		day := h.dayOf(yearDay)
+		f(day, h.values[yearDay])
+	}
+}
+
+// MouseIn is called when a desktop pointer enters the widget.
+//
+// Implements: desktop.Hoverable
+func (h *HeatmapCalendar) MouseIn(ev *desktop.MouseEvent) {
+	h.MouseMoved(ev)
+}
+
+// MouseMoved is called when a desktop pointer hovers over the widget,
+// moving the day tooltip to the hovered cell.
+//
+// Implements: desktop.Hoverable
+func (h *HeatmapCalendar) MouseMoved(ev *desktop.MouseEvent) {
+	yearDay := h.yearDayAt(ev.Position)
+	if yearDay == h.hovered {
+		return
+	}
+	h.hovered = yearDay
+	h.hideTooltip()
+	if yearDay == 0 {
+		h.Refresh()
+		return
+	}
+
+	if c := fyne.CurrentApp().Driver().CanvasForObject(h.super()); c != nil {
+		day := h.dayOf(yearDay)
+		label := NewLabel(fmt.Sprintf("%s: %v", day.Format("January 2, 2006"), h.values[yearDay]))
+		h.tooltip = NewPopUp(label, c)
+		h.tooltip.ShowAtPosition(ev.AbsolutePosition.AddXY(0, h.cellSize()))
+	}
+	h.Refresh()
+}
+
+// MouseOut is called when a desktop pointer exits the widget.
+//
+// Implements: desktop.Hoverable
+func (h *HeatmapCalendar) MouseOut() {
+	h.hovered = 0
+	h.hideTooltip()
+	h.Refresh()
+}
+
+func (h *HeatmapCalendar) hideTooltip() {
+	if h.tooltip != nil {
+		h.tooltip.Hide()
+		h.tooltip = nil
+	}
+}
+
+func (h *HeatmapCalendar) updateMaxValue() {
+	h.maxValue = 0
+	for _, v := range h.values {
+		if v > h.maxValue {
+			h.maxValue = v
+		}
+	}
+}
+
+func (h *HeatmapCalendar) cellSize() float32 {
+	return h.Theme().Size(theme.SizeNameInlineIcon) * 0.75
+}
+
+// labelHeight is the space above the cells that holds the month labels.
+func (h *HeatmapCalendar) labelHeight() float32 {
+	th := h.Theme()
+	return th.Size(theme.SizeNameCaptionText) + th.Size(theme.SizeNamePadding)
+}
+
+// dayCount returns the number of days in the calendar year.
+func (h *HeatmapCalendar) dayCount() int {
+	return time.Date(h.Year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+// weekdayOffset returns the row of January 1st, with weeks starting on Sunday.
+func (h *HeatmapCalendar) weekdayOffset() int {
+	return int(time.Date(h.Year, time.January, 1, 0, 0, 0, 0, time.UTC).Weekday())
+}
+
+func (h *HeatmapCalendar) dayOf(yearDay int) time.Time {
+	return time.Date(h.Year, time.January, yearDay, 0, 0, 0, 0, time.UTC)
+}
+
+// cellPosition returns the top left corner of the cell for the given day of year.
+func (h *HeatmapCalendar) cellPosition(yearDay int) fyne.Position {
+	step := h.cellSize() + heatmapCellGap
+	slot := yearDay - 1 + h.weekdayOffset()
+	return fyne.NewPos(float32(slot/7)*step, h.labelHeight()+float32(slot%7)*step)
+}
+
+// yearDayAt returns the day of year of the cell at the given position, or
+// zero if the position is not over a cell.
+func (h *HeatmapCalendar) yearDayAt(pos fyne.Position) int {
+	step := h.cellSize() + heatmapCellGap
+	y := pos.Y - h.labelHeight()
+	if pos.X < 0 || y < 0 {
+		return 0
+	}
+	col, row := int(pos.X/step), int(y/step)
+	if row > 6 {
+		return 0
+	}
+	yearDay := col*7 + row - h.weekdayOffset() + 1
+	if yearDay < 1 || yearDay > h.dayCount() {
+		return 0
+	}
+	return yearDay
+}
+
+// cellColor returns the color for the given value, blending the primary color
+// over the input background by the value's share of the largest value.
+func (h *HeatmapCalendar) cellColor(value float64) color.Color {
+	th := h.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	if value <= 0 || h.maxValue <= 0 {
+		return th.Color(theme.ColorNameInputBackground, v)
+	}
+
+	alpha := 0x40 + int(value/h.maxValue*float64(0xff-0x40))
+	return diffTint(th.Color(theme.ColorNamePrimary, v), uint8(alpha))
+}
+
+var _ fyne.WidgetRenderer = (*heatmapCalendarRenderer)(nil)
+
+type heatmapCalendarRenderer struct {
+	heatmap *HeatmapCalendar
+	year    int
+	cells   []*canvas.Rectangle
+	months  []*canvas.Text
+	objects []fyne.CanvasObject
+}
+
+// rebuild creates one rectangle per day of the heatmap year and a label per month.
+func (r *heatmapCalendarRenderer) rebuild() {
+	h := r.heatmap
+	r.year = h.Year
+	r.cells = make([]*canvas.Rectangle, h.dayCount())
+	for i := range r.cells {
+		r.cells[i] = canvas.NewRectangle(color.Transparent)
+	}
+	r.months = make([]*canvas.Text, 12)
+	for i := range r.months {
+		month := time.Month(i + 1)
+		r.months[i] = canvas.NewText(month.String()[:3], color.Black)
+	}
+
+	r.objects = make([]fyne.CanvasObject, 0, len(r.cells)+len(r.months))
+	for _, cell := range r.cells {
+		r.objects = append(r.objects, cell)
+	}
+	for _, month := range r.months {
+		r.objects = append(r.objects, month)
+	}
+	r.Refresh()
+}
+
+func (r *heatmapCalendarRenderer) Destroy() {
+	r.heatmap.hideTooltip()
+}
+
+func (r *heatmapCalendarRenderer) Layout(fyne.Size) {
+	h := r.heatmap
+	size := h.cellSize()
+	for i, cell := range r.cells {
+		cell.Move(h.cellPosition(i + 1))
+		cell.Resize(fyne.NewSize(size, size))
+	}
+	for i, month := range r.months {
+		first := time.Date(h.Year, time.Month(i+1), 1, 0, 0, 0, 0, time.UTC)
+		month.Move(fyne.NewPos(h.cellPosition(first.YearDay()).X, 0))
+	}
+}
+
+func (r *heatmapCalendarRenderer) MinSize() fyne.Size {
+	h := r.heatmap
+	step := h.cellSize() + heatmapCellGap
+	weeks := (h.dayCount() + h.weekdayOffset() + 6) / 7
+	return fyne.NewSize(float32(weeks)*step-heatmapCellGap, h.labelHeight()+7*step-heatmapCellGap)
+}
+
+func (r *heatmapCalendarRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *heatmapCalendarRenderer) Refresh() {
+	h := r.heatmap
+	if h.Year != r.year {
+		r.rebuild()
+		return
+	}
+
+	th := h.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	for i, cell := range r.cells {
+		cell.FillColor = h.cellColor(h.values[i+1])
+		cell.CornerRadius = heatmapCellGap
+		if h.hovered == i+1 {
+			cell.StrokeColor = th.Color(theme.ColorNameForeground, v)
+			cell.StrokeWidth = 1
+		} else {
+			cell.StrokeWidth = 0
+		}
+		cell.Refresh()
+	}
+	for _, month := range r.months {
+		month.TextSize = th.Size(theme.SizeNameCaptionText)
+		month.Color = th.Color(theme.ColorNameForeground, v)
+		month.Refresh()
+	}
+	r.Layout(h.Size())
+	canvas.Refresh(h.super())
+}
@@ -0,0 +1,94 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/internal/cache"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+)
+
+func heatmapDay(month time.Month, day int) time.Time {
+	return time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestHeatmapCalendar_Geometry(t *testing.T) {
+	h := NewHeatmapCalendar(2026)
+	w := test.NewTempWindow(t, h)
+	w.Resize(h.MinSize())
+
+	// January 1st 2026 is a Thursday, so the first column holds four days
+	assert.Equal(t, 4, h.weekdayOffset())
+	assert.Equal(t, 365, h.dayCount())
+
+	for _, yearDay := range []int{1, 60, 365} {
+		pos := h.cellPosition(yearDay)
+		centre := pos.AddXY(h.cellSize()/2, h.cellSize()/2)
+		assert.Equal(t, yearDay, h.yearDayAt(centre), "cell %d should map back to its day", yearDay)
+	}
+	assert.Zero(t, h.yearDayAt(fyne.NewPos(0, 0)), "month labels are not cells")
+}
+
+func TestHeatmapCalendar_Colors(t *testing.T) {
+	h := NewHeatmapCalendar(2026)
+	w := test.NewTempWindow(t, h)
+	w.Resize(h.MinSize())
+
+	h.SetValues(map[time.Time]float64{
+		heatmapDay(time.January, 5): 2,
+		heatmapDay(time.March, 1):   4,
+	})
+	assert.Equal(t, float64(4), h.maxValue)
+
+	r := cache.Renderer(h).(*heatmapCalendarRenderer)
+	empty := r.cells[heatmapDay(time.January, 1).YearDay()-1]
+	assert.Equal(t, theme.Color(theme.ColorNameInputBackground), empty.FillColor)
+
+	full := r.cells[heatmapDay(time.March, 1).YearDay()-1]
+	assert.Equal(t, diffTint(theme.Color(theme.ColorNamePrimary), 0xff), full.FillColor)
+
+	half := r.cells[heatmapDay(time.January, 5).YearDay()-1]
+	assert.NotEqual(t, full.FillColor, half.FillColor)
+	assert.NotEqual(t, empty.FillColor, half.FillColor)
+}
+
+func TestHeatmapCalendar_Tapped(t *testing.T) {
+	h := NewHeatmapCalendar(2026)
+	w := test.NewTempWindow(t, h)
+	w.Resize(h.MinSize())
+
+	h.SetValue(heatmapDay(time.February, 14), 3)
+
+	var tappedDay time.Time
+	var tappedValue float64
+	h.OnTapped = func(day time.Time, value float64) {
+		tappedDay = day
+		tappedValue = value
+	}
+
+	pos := h.cellPosition(heatmapDay(time.February, 14).YearDay())
+	h.Tapped(&fyne.PointEvent{Position: pos.AddXY(1, 1)})
+	assert.Equal(t, heatmapDay(time.February, 14), tappedDay)
+	assert.Equal(t, float64(3), tappedValue)
+}
+
+func TestHeatmapCalendar_Hover(t *testing.T) {
+	h := NewHeatmapCalendar(2026)
+	w := test.NewTempWindow(t, h)
+	w.Resize(h.MinSize())
+
+	pos := h.cellPosition(heatmapDay(time.January, 2).YearDay()).AddXY(1, 1)
+	h.MouseIn(&desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: pos, AbsolutePosition: pos}})
+	assert.Equal(t, 2, h.hovered)
+	assert.NotNil(t, h.tooltip)
+	assert.Equal(t, "January 2, 2026: 0", h.tooltip.Content.(*Label).Text)
+
+	h.MouseOut()
+	assert.Zero(t, h.hovered)
+	assert.Nil(t, h.tooltip)
+}
@@ -13,6 +13,7 @@ import (
 
 var _ fyne.Focusable = (*Hyperlink)(nil)
 var _ fyne.Widget = (*Hyperlink)(nil)
+var _ desktop.Mouseable = (*Hyperlink)(nil)
 
 // Hyperlink widget is a text component with appropriate padding and layout.
 // When clicked, the default web browser should open with a URL
@@ -39,8 +40,30 @@ type Hyperlink struct {
 	// Since: 2.2
 	OnTapped func() `json:"-"`
 
+	// OnMiddleTapped overrides middle-click on the link, which conventionally
+	// opens it in a new window or tab. When nil, middle-click falls back to
+	// the same action as a tap.
+	//
+	// Since: 2.6
+	OnMiddleTapped func() `json:"-"`
+
+	// Visited reflects whether this link was followed, showing it in the
+	// visited link color. It is set when the link is activated and may be
+	// preset or cleared by the app.
+	//
+	// Since: 2.6
+	Visited bool
+
+	// TruncateMiddle shortens text that does not fit from the middle, keeping
+	// the start and end visible, which suits long URLs better than a trailing
+	// ellipsis. It takes precedence over Truncation.
+	//
+	// Since: 2.6
+	TruncateMiddle bool
+
 	textSize         fyne.Size // updated in syncSegments
 	focused, hovered bool
+	middleDown       bool
 	provider         RichText
 }
 
@@ -181,6 +204,10 @@ func (hl *Hyperlink) Resize(size fyne.Size) {
 	if len(hl.provider.Segments) == 0 {
 		return // Not initialized yet.
 	}
+	if hl.TruncateMiddle {
+		hl.syncSegments() // re-elide the text for the new width
+		hl.provider.Refresh()
+	}
 	hl.provider.Resize(size)
 }
 
@@ -227,6 +254,7 @@ func (hl *Hyperlink) invokeAction() {
 	hl.propertyLock.RLock()
 	onTapped := hl.OnTapped
 	hl.propertyLock.RUnlock()
+	hl.setVisited()
 
 	if onTapped != nil {
 		onTapped()
@@ -235,6 +263,49 @@ func (hl *Hyperlink) invokeAction() {
 	hl.openURL()
 }
 
+// MouseDown is called on mouse press, tracking middle presses over the link text.
+//
+// Since: 2.6
+func (hl *Hyperlink) MouseDown(e *desktop.MouseEvent) {
+	hl.middleDown = e.Button == desktop.MouseButtonTertiary && hl.isPosOverText(e.Position)
+}
+
+// MouseUp completes a middle-click over the link text, invoking OnMiddleTapped
+// or falling back to the tap action.
+//
+// Since: 2.6
+func (hl *Hyperlink) MouseUp(e *desktop.MouseEvent) {
+	if e.Button != desktop.MouseButtonTertiary || !hl.middleDown {
+		return
+	}
+	hl.middleDown = false
+	if !hl.isPosOverText(e.Position) {
+		return
+	}
+
+	hl.propertyLock.RLock()
+	onMiddleTapped := hl.OnMiddleTapped
+	hl.propertyLock.RUnlock()
+	if onMiddleTapped != nil {
+		hl.setVisited()
+		onMiddleTapped()
+		return
+	}
+	hl.invokeAction()
+}
+
+// setVisited marks the link as followed, updating its color the first time.
+func (hl *Hyperlink) setVisited() {
+	hl.propertyLock.Lock()
+	changed := !hl.Visited
+	hl.Visited = true
+	hl.propertyLock.Unlock()
+
+	if changed {
+		hl.Refresh()
+	}
+}
+
 // TypedRune is a hook called by the input handling logic on text input events if this object is focused.
 func (hl *Hyperlink) TypedRune(rune) {
 }
@@ -267,6 +338,23 @@ func (hl *Hyperlink) syncSegments() {
 
 	hl.provider.Wrapping = hl.Wrapping
 	hl.provider.Truncation = hl.Truncation
+	if hl.TruncateMiddle {
+		hl.provider.Truncation = fyne.TextTruncateClip // keep MinSize small; the text is elided below instead
+	}
+
+	sizeName := hl.SizeName
+	if sizeName == "" {
+		sizeName = theme.SizeNameText
+	}
+	colorName := theme.ColorNameHyperlink
+	if hl.Visited {
+		colorName = theme.ColorNameHyperlinkVisited
+	}
+	text := hl.Text
+	if hl.TruncateMiddle {
+		width := hl.size.Load().Width - th.Size(theme.SizeNameInnerPadding)*2
+		text = truncateTextMiddle(text, width, th.Size(sizeName), hl.TextStyle)
+	}
 
 	if len(hl.provider.Segments) == 0 {
 		hl.provider.Scroll = widget.ScrollNone
@@ -274,26 +362,43 @@ func (hl *Hyperlink) syncSegments() {
 			&TextSegment{
 				Style: RichTextStyle{
 					Alignment: hl.Alignment,
-					ColorName: theme.ColorNameHyperlink,
+					ColorName: colorName,
 					Inline:    true,
 					TextStyle: hl.TextStyle,
 				},
-				Text: hl.Text,
+				Text: text,
 			},
 		}
 	} else {
 		segment := hl.provider.Segments[0].(*TextSegment)
 		segment.Style.Alignment = hl.Alignment
+		segment.Style.ColorName = colorName
 		segment.Style.TextStyle = hl.TextStyle
-		segment.Text = hl.Text
+		segment.Text = text
 	}
 
-	sizeName := hl.SizeName
-	if sizeName == "" {
-		sizeName = theme.SizeNameText
-	}
 	hl.provider.Segments[0].(*TextSegment).Style.SizeName = sizeName
-	hl.textSize = fyne.MeasureText(hl.Text, th.Size(sizeName), hl.TextStyle)
+	hl.textSize = fyne.MeasureText(text, th.Size(sizeName), hl.TextStyle)
+}
+
+// truncateTextMiddle shortens text to fit the given width, eliding runes from
+// the middle so the start and end, such as the host and page of a URL, stay
+// visible.
+func truncateTextMiddle(text string, width float32, textSize float32, style fyne.TextStyle) string {
+	if width <= 0 || fyne.MeasureText(text, textSize, style).Width <= width {
+		return text
+	}
+
+	runes := []rune(text)
+	for keep := len(runes) - 1; keep > 0; keep-- {
+		front := (keep + 1) / 2
+		back := keep / 2
+		short := string(runes[:front]) + "…" + string(runes[len(runes)-back:])
+		if fyne.MeasureText(short, textSize, style).Width <= width {
+			return short
+		}
+	}
+	return "…"
 }
 
 var _ fyne.WidgetRenderer = (*hyperlinkRenderer)(nil)
@@ -347,7 +452,11 @@ func (r *hyperlinkRenderer) Refresh() {
 	r.focus.StrokeColor = th.Color(theme.ColorNameFocus, v)
 	r.focus.Hidden = !r.hl.focused
 	r.focus.Refresh()
-	r.under.FillColor = th.Color(theme.ColorNameHyperlink, v)
+	underColor := theme.ColorNameHyperlink
+	if r.hl.Visited {
+		underColor = theme.ColorNameHyperlinkVisited
+	}
+	r.under.FillColor = th.Color(underColor, v)
 	r.under.Hidden = !r.hl.hovered
 	r.under.Refresh()
 }
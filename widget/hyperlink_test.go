@@ -4,6 +4,7 @@ import (
 	"image"
 	"image/color"
 	"net/url"
+	"strings"
 	"testing"
 
 	"fyne.io/fyne/v2"
@@ -235,3 +236,55 @@ func assertPixelsMatch(t *testing.T, match bool, img1, img2 image.Image) {
 		assert.NotEqual(t, pix1, pix2)
 	}
 }
+
+func TestHyperlink_Visited(t *testing.T) {
+	link := &Hyperlink{Text: "Test", OnTapped: func() {}}
+	assert.False(t, link.Visited)
+
+	test.Tap(link)
+	assert.True(t, link.Visited)
+
+	link.syncSegments()
+	assert.Equal(t, theme.ColorNameHyperlinkVisited, link.provider.Segments[0].(*TextSegment).Style.ColorName)
+
+	link.Visited = false
+	link.syncSegments()
+	assert.Equal(t, theme.ColorNameHyperlink, link.provider.Segments[0].(*TextSegment).Style.ColorName)
+}
+
+func TestHyperlink_MiddleClick(t *testing.T) {
+	middle := 0
+	link := &Hyperlink{Text: "Test"}
+	link.OnMiddleTapped = func() { middle++ }
+	link.CreateRenderer()
+	link.provider.CreateRenderer()
+	link.Resize(fyne.NewSize(100, 30))
+
+	ev := &desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(10, 10)}, Button: desktop.MouseButtonTertiary}
+	link.MouseDown(ev)
+	link.MouseUp(ev)
+	assert.Equal(t, 1, middle)
+	assert.True(t, link.Visited)
+
+	primary := &desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(10, 10)}, Button: desktop.MouseButtonPrimary}
+	link.MouseDown(primary)
+	link.MouseUp(primary)
+	assert.Equal(t, 1, middle, "only middle-click invokes OnMiddleTapped")
+}
+
+func TestHyperlink_TruncateMiddle(t *testing.T) {
+	hyperlink := &Hyperlink{Text: "https://example.com/very/long/path/to/page.html", TruncateMiddle: true}
+	hyperlink.CreateRenderer()
+	hyperlink.Resize(fyne.NewSize(100, 20))
+
+	short := hyperlink.provider.Segments[0].(*TextSegment).Text
+	assert.NotEqual(t, hyperlink.Text, short)
+	if parts := strings.SplitN(short, "…", 2); assert.Len(t, parts, 2, "the text is elided in the middle") {
+		assert.True(t, strings.HasPrefix(hyperlink.Text, parts[0]))
+		assert.True(t, strings.HasSuffix(hyperlink.Text, parts[1]))
+	}
+
+	// growing the link again restores the full text
+	hyperlink.Resize(fyne.NewSize(500, 20))
+	assert.Equal(t, hyperlink.Text, hyperlink.provider.Segments[0].(*TextSegment).Text)
+}
@@ -0,0 +1,53 @@
+package widget
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// ItemStyle collects the per-item appearance overrides applied by the
+// StyleItem, StyleNode and StyleCell callbacks of the collection widgets.
+// A zero value leaves the themed appearance unchanged, so callbacks only set
+// the fields they want to override for conditional formatting.
+//
+// Since: 2.6
+type ItemStyle struct {
+	// BackgroundColor fills the background of the item when it is not
+	// selected or hovered, taking precedence over any alternate row color.
+	BackgroundColor color.Color
+	// SelectionColor overrides the theme selection color while the item is selected.
+	SelectionColor color.Color
+	// TextStyle is applied to every Label and canvas.Text inside the item
+	// template, for emphasis such as bold totals or italic placeholders.
+	TextStyle *fyne.TextStyle
+}
+
+// applyText pushes the text emphasis of this style onto the labels and texts
+// of the given item template.
+func (s *ItemStyle) applyText(obj fyne.CanvasObject) {
+	if s.TextStyle == nil {
+		return
+	}
+	applyItemTextStyle(obj, *s.TextStyle)
+}
+
+func applyItemTextStyle(obj fyne.CanvasObject, style fyne.TextStyle) {
+	switch o := obj.(type) {
+	case *canvas.Text:
+		if o.TextStyle != style {
+			o.TextStyle = style
+			o.Refresh()
+		}
+	case *Label:
+		if o.TextStyle != style {
+			o.TextStyle = style
+			o.Refresh()
+		}
+	case *fyne.Container:
+		for _, child := range o.Objects {
+			applyItemTextStyle(child, style)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package widget
 
 import (
+	"net/url"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/theme"
@@ -23,6 +25,24 @@ type Label struct {
 	// Since: 2.4
 	Importance Importance
 
+	// Selectable defines whether the label text can be selected by the user,
+	// to be read through SelectedText or copied with the standard shortcut.
+	//
+	// Since: 2.6
+	Selectable bool
+
+	// DetectLinks defines whether web addresses in the label text are shown
+	// as tappable hyperlinks, see OnLinkTapped.
+	//
+	// Since: 2.6
+	DetectLinks bool
+
+	// OnLinkTapped is called when a detected hyperlink is tapped, instead of
+	// opening its address.
+	//
+	// Since: 2.6
+	OnLinkTapped func(*url.URL) `json:"-"`
+
 	provider *RichText
 	binder   basicBinder
 }
@@ -103,6 +123,17 @@ func (l *Label) Resize(s fyne.Size) {
 	}
 }
 
+// SelectedText returns the text currently highlighted by the user, if this
+// label is Selectable, or an empty string otherwise.
+//
+// Since: 2.6
+func (l *Label) SelectedText() string {
+	if l.provider == nil { // not created until visible
+		return ""
+	}
+	return l.provider.SelectedText()
+}
+
 // SetText sets the text of the label
 func (l *Label) SetText(text string) {
 	l.propertyLock.Lock()
@@ -143,13 +174,25 @@ func (l *Label) syncSegments() {
 
 	l.provider.Wrapping = l.Wrapping
 	l.provider.Truncation = l.Truncation
-	l.provider.Segments[0].(*TextSegment).Style = RichTextStyle{
+	l.provider.Selectable = l.Selectable
+	l.provider.OnLinkTapped = l.OnLinkTapped
+	style := RichTextStyle{
 		Alignment: l.Alignment,
 		ColorName: color,
 		Inline:    true,
 		TextStyle: l.TextStyle,
 	}
-	l.provider.Segments[0].(*TextSegment).Text = l.Text
+
+	if l.DetectLinks {
+		l.provider.Segments = linkSegments(l.Text, style)
+		return
+	}
+	if seg, ok := l.provider.Segments[0].(*TextSegment); ok && len(l.provider.Segments) == 1 {
+		seg.Style = style
+		seg.Text = l.Text
+	} else { // DetectLinks was switched off since the last sync
+		l.provider.Segments = []RichTextSegment{&TextSegment{Style: style, Text: l.Text}}
+	}
 }
 
 func (l *Label) updateFromData(data binding.DataItem) {
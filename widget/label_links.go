@@ -0,0 +1,45 @@
+package widget
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// linkPattern matches web addresses within label text, see Label.DetectLinks.
+var linkPattern = regexp.MustCompile(`https?://\S+`)
+
+// linkSegments splits the given text into inline text and hyperlink segments,
+// so that the web addresses it contains can be tapped.
+func linkSegments(text string, style RichTextStyle) []RichTextSegment {
+	var segs []RichTextSegment
+	plain := func(s string) {
+		if s != "" {
+			segs = append(segs, &TextSegment{Style: style, Text: s})
+		}
+	}
+
+	for {
+		loc := linkPattern.FindStringIndex(text)
+		if loc == nil {
+			break
+		}
+
+		match := strings.TrimRight(text[loc[0]:loc[1]], `.,;:!?"')`) // punctuation around a link is not part of it
+		end := loc[0] + len(match)
+		plain(text[:loc[0]])
+
+		if u, err := url.Parse(match); err == nil {
+			segs = append(segs, &HyperlinkSegment{Alignment: style.Alignment, Text: match, URL: u})
+		} else {
+			plain(match)
+		}
+		text = text[end:]
+	}
+	plain(text)
+
+	if len(segs) == 0 { // keep the single segment layout for empty text
+		segs = append(segs, &TextSegment{Style: style, Text: ""})
+	}
+	return segs
+}
@@ -1,17 +1,20 @@
 package widget
 
 import (
+	"net/url"
 	"testing"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/internal/cache"
 	"fyne.io/fyne/v2/internal/painter/software"
 	"fyne.io/fyne/v2/test"
 	"fyne.io/fyne/v2/theme"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLabel_Binding(t *testing.T) {
@@ -181,6 +184,101 @@ func TestLabel_CreateRendererDoesNotAffectSize(t *testing.T) {
 	assert.Equal(t, size, text.MinSize())
 }
 
+func TestLabel_DetectLinks(t *testing.T) {
+	label := NewLabel("see https://fyne.io for details")
+	label.DetectLinks = true
+	var tapped *url.URL
+	label.OnLinkTapped = func(u *url.URL) { tapped = u }
+	w := test.NewTempWindow(t, label)
+	w.Resize(fyne.NewSize(300, 50))
+
+	segs := label.provider.Segments
+	require.Len(t, segs, 3)
+	assert.Equal(t, "see ", segs[0].(*TextSegment).Text)
+	assert.Equal(t, "https://fyne.io", segs[1].(*HyperlinkSegment).Text)
+	assert.Equal(t, " for details", segs[2].(*TextSegment).Text)
+
+	// tapping the rendered hyperlink reports through the callback
+	var link *Hyperlink
+	for _, obj := range cache.Renderer(label.provider).Objects() {
+		if c, ok := obj.(*fyne.Container); ok {
+			link = c.Objects[0].(*Hyperlink)
+		}
+	}
+	require.NotNil(t, link)
+	test.TapAt(link, fyne.NewPos(link.Size().Width/2, link.Size().Height/2))
+	require.NotNil(t, tapped)
+	assert.Equal(t, "https://fyne.io", tapped.String())
+}
+
+func TestLabel_DetectLinks_Segments(t *testing.T) {
+	segs := linkSegments("go to https://fyne.io.", RichTextStyle{})
+	require.Len(t, segs, 3)
+	assert.Equal(t, "https://fyne.io", segs[1].(*HyperlinkSegment).Text, "trailing punctuation is not part of the link")
+	assert.Equal(t, ".", segs[2].(*TextSegment).Text)
+
+	segs = linkSegments("no links here", RichTextStyle{})
+	require.Len(t, segs, 1)
+	assert.Equal(t, "no links here", segs[0].(*TextSegment).Text)
+
+	segs = linkSegments("", RichTextStyle{})
+	require.Len(t, segs, 1, "empty text keeps the single segment layout")
+}
+
+func TestLabel_Selectable(t *testing.T) {
+	label := NewLabel("Hello World")
+	label.Selectable = true
+	w := test.NewTempWindow(t, label)
+	w.Resize(fyne.NewSize(200, 50))
+
+	sel := label.provider.selection
+	require.NotNil(t, sel)
+
+	// drag from the start of the text past its end to select the whole row
+	sel.MouseDown(&desktop.MouseEvent{Button: desktop.MouseButtonPrimary})
+	sel.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(500, 0)}})
+	sel.DragEnd()
+	assert.Equal(t, "Hello World", label.SelectedText())
+
+	clipboard := test.NewClipboard()
+	sel.TypedShortcut(&fyne.ShortcutCopy{Clipboard: clipboard})
+	assert.Equal(t, "Hello World", clipboard.Content())
+
+	sel.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	assert.Empty(t, label.SelectedText())
+}
+
+func TestLabel_Selectable_DoubleTapped(t *testing.T) {
+	label := NewLabel("Hello World")
+	label.Selectable = true
+	w := test.NewTempWindow(t, label)
+	w.Resize(fyne.NewSize(200, 50))
+
+	th := label.Theme()
+	inWord := label.provider.lineSizeToColumn(7, 0, th.Size(theme.SizeNameText), th.Size(theme.SizeNameInnerPadding))
+	label.provider.selection.DoubleTapped(&fyne.PointEvent{Position: fyne.NewPos(inWord.Width, 0)})
+	assert.Equal(t, "World", label.SelectedText())
+}
+
+func TestLabel_Selectable_Keyboard(t *testing.T) {
+	label := NewLabel("Hello World")
+	label.Selectable = true
+	w := test.NewTempWindow(t, label)
+	w.Resize(fyne.NewSize(200, 50))
+
+	// shift with the arrow keys extends a selection from the start
+	sel := label.provider.selection
+	sel.KeyDown(&fyne.KeyEvent{Name: desktop.KeyShiftLeft})
+	for i := 0; i < 5; i++ {
+		sel.TypedKey(&fyne.KeyEvent{Name: fyne.KeyRight})
+	}
+	sel.KeyUp(&fyne.KeyEvent{Name: desktop.KeyShiftLeft})
+	assert.Equal(t, "Hello", label.SelectedText())
+
+	sel.TypedShortcut(&fyne.ShortcutSelectAll{})
+	assert.Equal(t, "Hello World", label.SelectedText())
+}
+
 func TestLabel_ChangeTruncate(t *testing.T) {
 	test.NewTempApp(t)
 
@@ -2,7 +2,7 @@ package widget
 
 import (
 	"fmt"
-	"math"
+	"image/color"
 	"sort"
 	"sync"
 
@@ -11,6 +11,7 @@ import (
 	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/internal/cache"
+	"fyne.io/fyne/v2/internal/virtualizer"
 	"fyne.io/fyne/v2/internal/widget"
 	"fyne.io/fyne/v2/theme"
 )
@@ -37,19 +38,189 @@ type List struct {
 	OnSelected   func(id ListItemID)                         `json:"-"`
 	OnUnselected func(id ListItemID)                         `json:"-"`
 
+	// OnActivated is called when an item is activated with a double-tap or the enter
+	// key, separate from selection, so browse-style UIs can select on a single tap
+	// and open on activation.
+	//
+	// Since: 2.6
+	OnActivated func(id ListItemID) `json:"-"`
+
+	// ItemToolTip returns rich tooltip content that pops up when the pointer
+	// dwells over the specified item, or nil for no tooltip.
+	//
+	// Since: 2.6
+	ItemToolTip func(id ListItemID) fyne.CanvasObject `json:"-"`
+
+	// OnFocusChanged is called when keyboard focus moves to a different item.
+	//
+	// Since: 2.6
+	OnFocusChanged func(id ListItemID) `json:"-"`
+
+	// OnItemHovered is called when a desktop pointer moves over an item, so apps
+	// can show preview panes or other hover effects for the row.
+	//
+	// Since: 2.6
+	OnItemHovered func(id ListItemID) `json:"-"`
+	// OnItemUnhovered is called when a desktop pointer leaves an item it was hovering.
+	//
+	// Since: 2.6
+	OnItemUnhovered func(id ListItemID) `json:"-"`
+
+	// DragDataForItem returns the payload carried when the given row is dragged out
+	// of the list, making the list act as a drag source for fyne.DropTarget widgets.
+	// Return nil to prevent a row being dragged. Rows with drag data do not reveal
+	// swipe actions.
+	//
+	// Since: 2.6
+	DragDataForItem func(id ListItemID) *fyne.DragPayload `json:"-"`
+
+	// KeyForItem returns a stable key identifying the data behind the given row,
+	// independent of its position. When set, refreshes match the previously
+	// visible rows to their new positions by key and move the pooled row widgets
+	// instead of rebinding them, preserving state inside the row templates (such
+	// as entry cursors) when items are inserted or removed. The selection,
+	// keyboard focus and scroll position are matched to the new positions of
+	// their items in the same way, so changes above the viewport do not scroll
+	// the view or reselect different rows. UpdateItem is only
+	// called for rows whose key has changed; use RefreshItem to force a rebind
+	// when the data behind a key is mutated in place.
+	//
+	// Since: 2.6
+	KeyForItem func(id ListItemID) string `json:"-"`
+
+	// CreateDetail returns a new template object for the detail panel shown inline
+	// below a row that has been expanded with SetItemExpandable.
+	//
+	// Since: 2.6
+	CreateDetail func() fyne.CanvasObject `json:"-"`
+	// UpdateDetail is called to update a detail panel to show the content of the given item.
+	//
+	// Since: 2.6
+	UpdateDetail func(id ListItemID, detail fyne.CanvasObject) `json:"-"`
+
+	// Columns specifies per-column templates and widths for table-like rows, as an
+	// alternative to the CreateItem and UpdateItem callbacks which take precedence.
+	//
+	// Since: 2.6
+	Columns []ListColumn `json:"-"`
+
+	// OnItemShown is called when an item scrolls into the viewport, so expensive work
+	// such as thumbnail decoding can be started only for visible items.
+	//
+	// Since: 2.6
+	OnItemShown func(id ListItemID) `json:"-"`
+	// OnItemHidden is called when an item scrolls back out of the viewport.
+	//
+	// Since: 2.6
+	OnItemHidden func(id ListItemID) `json:"-"`
+
+	// Entrance selects an optional animation played when rows scroll into the
+	// viewport, with a small stagger when several rows appear together.
+	//
+	// Since: 2.6
+	Entrance ListEntrance
+	// OnItemScrollProgress is called as rows move through the viewport, with
+	// progress running from 0.0 as a row enters at the bottom edge to 1.0 as
+	// it leaves at the top. It can drive parallax or shrinking-header effects,
+	// implementations must be cheap as every visible row reports on every
+	// scroll.
+	//
+	// Since: 2.6
+	OnItemScrollProgress func(id ListItemID, progress float32) `json:"-"`
+
+	// ShowCheckboxes renders a leading check control on every row, backed by the
+	// checked state accessed with SetItemChecked and friends, so templates do not
+	// have to manage their own checkbox state for multi-select UIs.
+	//
+	// Since: 2.6
+	ShowCheckboxes bool
+	// OnItemChecked is called when the checked state of a row changes while
+	// ShowCheckboxes is enabled.
+	//
+	// Since: 2.6
+	OnItemChecked func(id ListItemID, checked bool) `json:"-"`
+
 	// HideSeparators hides the separators between list rows
 	//
 	// Since: 2.5
 	HideSeparators bool
 
-	currentFocus  ListItemID
-	focused       bool
-	scroller      *widget.Scroll
-	selected      []ListItemID
-	itemMin       fyne.Size
-	itemHeights   map[ListItemID]float32
-	offsetY       float32
-	offsetUpdated func(fyne.Position)
+	// Direction overrides the horizontal presentation of rows. The default,
+	// LayoutDirectionAuto, mirrors checkboxes and swipe actions automatically
+	// when a right-to-left system locale is active.
+	//
+	// Since: 2.6
+	Direction LayoutDirection
+
+	// PagingEnabled snaps the scroll offset to an item boundary when a drag scroll
+	// gesture is released, targeting the boundary in the direction of travel, for
+	// carousel style paging UIs.
+	//
+	// Since: 2.6
+	PagingEnabled bool
+
+	// AlternateRowColor tints the background of odd rows for a zebra-striped look,
+	// often used in dense data lists. Selection and hover colors still take
+	// precedence on the affected rows.
+	//
+	// Since: 2.6
+	AlternateRowColor color.Color
+
+	// StyleItem allows per-item appearance overrides such as a background
+	// color, selection color or text emphasis, without rebuilding templates.
+	// It is called after UpdateItem whenever a row is bound to an item, with
+	// a zero ItemStyle to fill in; fields left unset keep the themed look.
+	//
+	// Since: 2.6
+	StyleItem func(id ListItemID, item fyne.CanvasObject, style *ItemStyle) `json:"-"`
+
+	// SeparatorColor overrides the theme color of the separators between rows.
+	//
+	// Since: 2.6
+	SeparatorColor color.Color
+	// SeparatorThickness overrides the theme thickness of the separators between rows
+	// when set to a value above zero.
+	//
+	// Since: 2.6
+	SeparatorThickness float32
+	// SeparatorInset indents the separators from the leading and trailing edges, for
+	// designs where dividers should not span the full row width.
+	//
+	// Since: 2.6
+	SeparatorInset float32
+
+	currentFocus    ListItemID
+	focused         bool
+	scroller        *widget.Scroll
+	selected        []ListItemID
+	itemMin         fyne.Size
+	detailMin       fyne.Size
+	itemHeights     map[ListItemID]float32
+	expanded        map[ListItemID]bool
+	checked         map[ListItemID]bool
+	offsetY         float32
+	offsetUpdated   func(fyne.Position)
+	placeholder     fyne.CanvasObject
+	leadingActions  []ListAction
+	trailingActions []ListAction
+
+	onNearEnd        func()
+	nearEndThreshold int
+	nearEndFired     bool
+	nearEndLength    int
+
+	lastScrollDelta float32
+	pagingAnim      *fyne.Animation
+
+	batching int
+
+	itemKeys     map[ListItemID]string
+	anchorRow    ListItemID
+	anchorOffset float32
+
+	rowOffsets     []float32
+	offsetsValid   bool
+	offsetsPadding float32
 }
 
 // NewList creates and returns a list widget for displaying items in
@@ -85,12 +256,18 @@ func NewListWithData(data binding.DataList, createItem func() fyne.CanvasObject,
 // CreateRenderer is a private method to Fyne which links this widget to its renderer.
 func (l *List) CreateRenderer() fyne.WidgetRenderer {
 	l.ExtendBaseWidget(l)
+	l.applyColumns()
 
 	if f := l.CreateItem; f != nil && l.itemMin.IsZero() {
 		item := createItemAndApplyThemeScope(f, l)
 
 		l.itemMin = item.MinSize()
 	}
+	if f := l.CreateDetail; f != nil && l.detailMin.IsZero() {
+		detail := createItemAndApplyThemeScope(f, l)
+
+		l.detailMin = detail.MinSize()
+	}
 
 	layout := &fyne.Container{Layout: newListLayout(l)}
 	l.scroller = widget.NewVScroll(layout)
@@ -122,11 +299,30 @@ func (l *List) MinSize() fyne.Size {
 	return l.BaseWidget.MinSize()
 }
 
+// Refresh causes this widget to be redrawn in its current state, unless an
+// UpdateBatch is in progress in which case the redraw happens once at its end.
+func (l *List) Refresh() {
+	if l.refreshDeferred() {
+		return
+	}
+	l.remapItemKeys()
+	l.BaseWidget.Refresh()
+}
+
+// ThemeInvalidated drops the cached row offsets when the list is refreshed, as
+// the item heights and padding they are built from depend on the theme and
+// rendering scale.
+//
+// Since: 2.6
+func (l *List) ThemeInvalidated() {
+	l.invalidateRowOffsets()
+}
+
 // RefreshItem refreshes a single item, specified by the item ID passed in.
 //
 // Since: 2.4
 func (l *List) RefreshItem(id ListItemID) {
-	if l.scroller == nil {
+	if l.scroller == nil || l.refreshDeferred() {
 		return
 	}
 	l.BaseWidget.Refresh()
@@ -135,10 +331,187 @@ func (l *List) RefreshItem(id ListItemID) {
 	item, ok := lo.searchVisible(lo.visible, id)
 	lo.renderLock.RUnlock()
 	if ok {
+		item.key = "" // rebind the data even when a stable key still matches
 		lo.setupListItem(item, id, l.focused && l.currentFocus == id)
 	}
 }
 
+// InsertItem animates the item with the given ID sliding into place, once the data
+// backing this list has grown by one item at that position.
+// Selection, focus and custom item heights of the following items are shifted so that
+// the visible item mapping stays stable while the data source changes.
+//
+// Since: 2.6
+func (l *List) InsertItem(id ListItemID) {
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+	if id < 0 || id >= length {
+		return
+	}
+
+	l.propertyLock.Lock()
+	if len(l.itemHeights) > 0 {
+		heights := make(map[ListItemID]float32, len(l.itemHeights))
+		for item, height := range l.itemHeights {
+			if item >= id {
+				item++
+			}
+			heights[item] = height
+		}
+		l.itemHeights = heights
+	}
+	l.propertyLock.Unlock()
+
+	for i, sel := range l.selected {
+		if sel >= id {
+			l.selected[i] = sel + 1
+		}
+	}
+	if l.currentFocus >= id {
+		l.currentFocus++
+	}
+
+	l.animateItemHeight(id, 0, l.itemMin.Height, nil)
+}
+
+// RemoveItem animates the item with the given ID sliding out of place, collapsing the
+// row before the data source shrinks. The item must still be present in the data when
+// this is called and should be removed in the onComplete callback, which fires once
+// the animation has finished.
+// Selection, focus and custom item heights of the following items are shifted so that
+// the visible item mapping stays stable while the data source changes.
+//
+// Since: 2.6
+func (l *List) RemoveItem(id ListItemID, onComplete func()) {
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+	if id < 0 || id >= length {
+		return
+	}
+
+	start := l.itemMin.Height
+	l.propertyLock.Lock()
+	if h, ok := l.itemHeights[id]; ok {
+		start = h
+	}
+	l.propertyLock.Unlock()
+
+	l.animateItemHeight(id, start, 0, func() {
+		l.propertyLock.Lock()
+		if len(l.itemHeights) > 0 {
+			heights := make(map[ListItemID]float32, len(l.itemHeights))
+			for item, height := range l.itemHeights {
+				if item == id {
+					continue
+				}
+				if item > id {
+					item--
+				}
+				heights[item] = height
+			}
+			l.itemHeights = heights
+		}
+		l.propertyLock.Unlock()
+
+		for i := 0; i < len(l.selected); i++ {
+			if l.selected[i] == id {
+				l.selected = append(l.selected[:i], l.selected[i+1:]...)
+				i--
+			} else if l.selected[i] > id {
+				l.selected[i]--
+			}
+		}
+		if l.currentFocus > id {
+			l.currentFocus--
+		}
+
+		if onComplete != nil {
+			onComplete()
+		}
+		l.Refresh()
+	})
+}
+
+// animateItemHeight transitions the height override of the given row, removing the
+// override and notifying onComplete once the target height has been reached.
+func (l *List) animateItemHeight(id ListItemID, from, to float32, onComplete func()) {
+	anim := fyne.NewAnimation(canvas.DurationShort, func(f float32) {
+		done := f == 1
+		l.propertyLock.Lock()
+		if l.itemHeights == nil {
+			l.itemHeights = make(map[ListItemID]float32)
+		}
+		if done {
+			delete(l.itemHeights, id)
+		} else {
+			l.itemHeights[id] = from + (to-from)*f
+		}
+		l.propertyLock.Unlock()
+
+		if done && onComplete != nil {
+			onComplete()
+		}
+		l.Refresh()
+	})
+	anim.Curve = fyne.AnimationEaseOut
+	anim.Start()
+}
+
+// SetPlaceholder sets content that is shown centered over the list while it is empty,
+// supporting "no results" and loading states without stacking widgets in user code.
+// Passing nil removes the placeholder again.
+//
+// Since: 2.6
+func (l *List) SetPlaceholder(obj fyne.CanvasObject) {
+	l.placeholder = obj
+	l.Refresh()
+}
+
+// SetItemExpandable expands or collapses an inline detail panel below the specified
+// row. Expanded rows show an additional object built from the CreateDetail template
+// and filled by UpdateDetail, and the extra height is included in the layout and
+// scrolling calculations.
+//
+// Since: 2.6
+func (l *List) SetItemExpandable(id ListItemID, expand bool) {
+	l.propertyLock.Lock()
+	if l.expanded == nil {
+		l.expanded = make(map[ListItemID]bool)
+	}
+
+	changed := l.expanded[id] != expand
+	if expand {
+		l.expanded[id] = true
+	} else {
+		delete(l.expanded, id)
+	}
+	l.propertyLock.Unlock()
+
+	if changed {
+		l.Refresh()
+	}
+}
+
+func (l *List) isExpanded(id ListItemID) bool {
+	l.propertyLock.RLock()
+	defer l.propertyLock.RUnlock()
+
+	return l.expanded[id]
+}
+
+// detailHeight returns the extra height a row gains when it is expanded.
+func (l *List) detailHeight() float32 {
+	if l.CreateDetail == nil {
+		return 0
+	}
+
+	return l.detailMin.Height
+}
+
 // SetItemHeight supports changing the height of the specified list item. Items normally take the height of the template
 // returned from the CreateItem callback. The height parameter uses the same units as a fyne.Size type and refers
 // to the internal content height not including the divider size.
@@ -153,6 +526,7 @@ func (l *List) SetItemHeight(id ListItemID, height float32) {
 
 	refresh := l.itemHeights[id] != height
 	l.itemHeights[id] = height
+	l.invalidateRowOffsets()
 	l.propertyLock.Unlock()
 
 	if refresh {
@@ -168,17 +542,18 @@ func (l *List) scrollTo(id ListItemID) {
 	separatorThickness := l.Theme().Size(theme.SizeNamePadding)
 	y := float32(0)
 	lastItemHeight := l.itemMin.Height
-	if l.itemHeights == nil || len(l.itemHeights) == 0 {
+	if len(l.itemHeights) == 0 && len(l.expanded) == 0 {
 		y = (float32(id) * l.itemMin.Height) + (float32(id) * separatorThickness)
 	} else {
-		for i := 0; i < id; i++ {
-			height := l.itemMin.Height
-			if h, ok := l.itemHeights[i]; ok {
-				height = h
-			}
-
-			y += height + separatorThickness
-			lastItemHeight = height
+		length := l.Length()
+		offsets := l.ensureRowOffsets(length, separatorThickness)
+		row := id
+		if row > length {
+			row = length
+		}
+		y = offsets[row]
+		if row > 0 {
+			lastItemHeight = offsets[row] - offsets[row-1] - separatorThickness
 		}
 	}
 
@@ -225,6 +600,7 @@ func (l *List) Select(id ListItemID) {
 	}()
 	l.scrollTo(id)
 	l.Refresh()
+	l.announceSelection(id)
 }
 
 // ScrollTo scrolls to the item represented by id
@@ -294,6 +670,104 @@ func (l *List) GetScrollOffset() float32 {
 	return l.offsetY
 }
 
+// ListScrollState captures the exact scroll position of a List - which item is first
+// visible and how far the viewport has scrolled into it, along with the raw offset.
+// Keeping the item identity means the position survives changes to item measures,
+// where a raw offset alone would drift.
+//
+// Since: 2.6
+type ListScrollState struct {
+	offset     float32
+	firstItem  ListItemID
+	itemOffset float32
+}
+
+// ScrollState returns the current scroll position in a form that can be passed to
+// RestoreScrollState, for example after a data reload or window recreation.
+//
+// Since: 2.6
+func (l *List) ScrollState() ListScrollState {
+	state := ListScrollState{offset: l.offsetY}
+	padding := l.Theme().Size(theme.SizeNamePadding)
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+
+	if len(l.itemHeights) == 0 && len(l.expanded) == 0 {
+		paddedItemHeight := l.itemMin.Height + padding
+		if paddedItemHeight > 0 {
+			state.firstItem = int(l.offsetY / paddedItemHeight)
+			state.itemOffset = l.offsetY - float32(state.firstItem)*paddedItemHeight
+		}
+		return state
+	}
+
+	offsets := l.ensureRowOffsets(length, padding)
+	state.firstItem = virtualizer.RowAtOffset(offsets, l.offsetY)
+	state.itemOffset = l.offsetY - offsets[state.firstItem]
+	return state
+}
+
+// RestoreScrollState scrolls the list back to a position previously captured with
+// ScrollState. The first visible item is aligned using the current item measures, so
+// the restore is exact even if row heights have changed since the state was captured.
+//
+// Since: 2.6
+func (l *List) RestoreScrollState(state ListScrollState) {
+	padding := l.Theme().Size(theme.SizeNamePadding)
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+	if length == 0 {
+		l.ScrollToOffset(state.offset)
+		return
+	}
+
+	id := state.firstItem
+	if id >= length {
+		id = length - 1
+	}
+
+	offsets := l.ensureRowOffsets(length, padding)
+	l.ScrollToOffset(offsets[id] + state.itemOffset)
+}
+
+// SetOnNearEnd registers a callback that is fired when the list scrolls to within
+// threshold items of the end of its content, so apps can lazily fetch the next page
+// of data. The callback fires once as the end is approached and is re-armed when the
+// list scrolls back out of the threshold or the data length changes.
+//
+// Since: 2.6
+func (l *List) SetOnNearEnd(threshold int, f func()) {
+	l.nearEndThreshold = threshold
+	l.onNearEnd = f
+	l.nearEndFired = false
+}
+
+func (l *List) checkNearEnd(maxVisible, length int) {
+	f := l.onNearEnd
+	if f == nil || length == 0 {
+		return
+	}
+
+	if length != l.nearEndLength {
+		l.nearEndLength = length
+		l.nearEndFired = false
+	}
+
+	if maxVisible < length-1-l.nearEndThreshold {
+		l.nearEndFired = false
+		return
+	}
+
+	if !l.nearEndFired {
+		l.nearEndFired = true
+		f()
+	}
+}
+
 // TypedKey is called if a key event happens while this List is focused.
 //
 // Implements: fyne.Focusable
@@ -301,25 +775,101 @@ func (l *List) TypedKey(event *fyne.KeyEvent) {
 	switch event.Name {
 	case fyne.KeySpace:
 		l.Select(l.currentFocus)
-	case fyne.KeyDown:
-		if f := l.Length; f != nil && l.currentFocus >= f()-1 {
-			return
+	case fyne.KeyReturn, fyne.KeyEnter:
+		if f := l.OnActivated; f != nil {
+			f(l.currentFocus)
 		}
-		l.RefreshItem(l.currentFocus)
-		l.currentFocus++
-		l.scrollTo(l.currentFocus)
-		l.RefreshItem(l.currentFocus)
+	case fyne.KeyDown:
+		l.moveFocus(1)
 	case fyne.KeyUp:
-		if l.currentFocus <= 0 {
-			return
+		l.moveFocus(-1)
+	case fyne.KeyPageDown:
+		l.moveFocus(l.pageSize())
+	case fyne.KeyPageUp:
+		l.moveFocus(-l.pageSize())
+	case fyne.KeyEnd:
+		if f := l.Length; f != nil {
+			l.moveFocus(f())
 		}
-		l.RefreshItem(l.currentFocus)
-		l.currentFocus--
-		l.scrollTo(l.currentFocus)
-		l.RefreshItem(l.currentFocus)
+	case fyne.KeyHome:
+		l.moveFocus(-l.currentFocus)
 	}
 }
 
+// moveFocus shifts the keyboard focus by delta items, clamping to the list content.
+func (l *List) moveFocus(delta int) {
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+	if length == 0 {
+		return
+	}
+
+	target := l.currentFocus + delta
+	if target < 0 {
+		target = 0
+	} else if target > length-1 {
+		target = length - 1
+	}
+	if target == l.currentFocus {
+		return
+	}
+
+	l.RefreshItem(l.currentFocus)
+	l.currentFocus = target
+	l.scrollTo(l.currentFocus)
+	l.RefreshItem(l.currentFocus)
+	l.focusChanged()
+}
+
+// FocusItem moves keyboard focus to the item with the given ID, scrolling to keep it visible.
+//
+// Since: 2.6
+func (l *List) FocusItem(id ListItemID) {
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+	if id < 0 || id >= length || id == l.currentFocus {
+		return
+	}
+
+	l.RefreshItem(l.currentFocus)
+	l.currentFocus = id
+	l.scrollTo(l.currentFocus)
+	l.RefreshItem(l.currentFocus)
+	l.focusChanged()
+}
+
+// FocusedItem returns the item that has keyboard focus and whether the
+// list itself is currently focused.
+//
+// Since: 2.6
+func (l *List) FocusedItem() (ListItemID, bool) {
+	return l.currentFocus, l.focused
+}
+
+func (l *List) focusChanged() {
+	if f := l.OnFocusChanged; f != nil {
+		f(l.currentFocus)
+	}
+}
+
+// pageSize returns how many items fit in the current viewport, to support paged navigation.
+func (l *List) pageSize() int {
+	if l.scroller == nil || l.itemMin.Height <= 0 {
+		return 1
+	}
+
+	padding := l.Theme().Size(theme.SizeNamePadding)
+	count := int(l.scroller.Size().Height / (l.itemMin.Height + padding))
+	if count < 1 {
+		return 1
+	}
+	return count
+}
+
 // TypedRune is called if a text event happens while this List is focused.
 //
 // Implements: fyne.Focusable
@@ -367,29 +917,17 @@ func (l *List) contentMinSize() fyne.Size {
 	}
 	items := l.Length()
 
-	if l.itemHeights == nil || len(l.itemHeights) == 0 {
+	if len(l.itemHeights) == 0 && len(l.expanded) == 0 {
 		return fyne.NewSize(l.itemMin.Width,
 			(l.itemMin.Height+separatorThickness)*float32(items)-separatorThickness)
 	}
 
-	height := float32(0)
-	totalCustom := 0
-	templateHeight := l.itemMin.Height
-	for id, itemHeight := range l.itemHeights {
-		if id < items {
-			totalCustom++
-			height += itemHeight
-		}
-	}
-	height += float32(items-totalCustom) * templateHeight
-
-	return fyne.NewSize(l.itemMin.Width, height+separatorThickness*float32(items-1))
+	offsets := l.ensureRowOffsets(items, separatorThickness)
+	return fyne.NewSize(l.itemMin.Width, offsets[items]-separatorThickness)
 }
 
 // fills l.visibleRowHeights and also returns offY and minRow
 func (l *listLayout) calculateVisibleRowHeights(itemHeight float32, length int, th fyne.Theme) (offY float32, minRow int) {
-	rowOffset := float32(0)
-	isVisible := false
 	l.visibleRowHeights = l.visibleRowHeights[:0]
 
 	if l.list.scroller.Size().Height <= 0 {
@@ -398,24 +936,9 @@ func (l *listLayout) calculateVisibleRowHeights(itemHeight float32, length int,
 
 	padding := th.Size(theme.SizeNamePadding)
 
-	if len(l.list.itemHeights) == 0 {
-		paddedItemHeight := itemHeight + padding
-
-		offY = float32(math.Floor(float64(l.list.offsetY/paddedItemHeight))) * paddedItemHeight
-		minRow = int(math.Floor(float64(offY / paddedItemHeight)))
-		maxRow := int(math.Ceil(float64((offY + l.list.scroller.Size().Height) / paddedItemHeight)))
-
-		if minRow > length-1 {
-			minRow = length - 1
-		}
-		if minRow < 0 {
-			minRow = 0
-			offY = 0
-		}
-
-		if maxRow > length-1 {
-			maxRow = length - 1
-		}
+	if len(l.list.itemHeights) == 0 && len(l.list.expanded) == 0 {
+		var maxRow int
+		minRow, maxRow, offY = virtualizer.UniformWindow(l.list.offsetY, l.list.scroller.Size().Height, itemHeight, padding, length)
 
 		for i := 0; i <= maxRow-minRow; i++ {
 			l.visibleRowHeights = append(l.visibleRowHeights, itemHeight)
@@ -423,27 +946,16 @@ func (l *listLayout) calculateVisibleRowHeights(itemHeight float32, length int,
 		return
 	}
 
-	for i := 0; i < length; i++ {
-		height := itemHeight
-		if h, ok := l.list.itemHeights[i]; ok {
-			height = h
-		}
-
-		if rowOffset <= l.list.offsetY-height-padding {
-			// before scroll
-		} else if rowOffset <= l.list.offsetY {
-			minRow = i
-			offY = rowOffset
-			isVisible = true
-		}
-		if rowOffset >= l.list.offsetY+l.list.scroller.Size().Height {
+	offsets := l.list.ensureRowOffsets(length, padding)
+	minRow = virtualizer.RowAtOffset(offsets, l.list.offsetY)
+	offY = offsets[minRow]
+	viewBottom := l.list.offsetY + l.list.scroller.Size().Height
+	for i := minRow; i < length; i++ {
+		if offsets[i] >= viewBottom {
 			break
 		}
 
-		rowOffset += height + padding
-		if isVisible {
-			l.visibleRowHeights = append(l.visibleRowHeights, height)
-		}
+		l.visibleRowHeights = append(l.visibleRowHeights, offsets[i+1]-offsets[i]-padding)
 	}
 	return
 }
@@ -454,19 +966,22 @@ var _ fyne.WidgetRenderer = (*listRenderer)(nil)
 type listRenderer struct {
 	widget.BaseRenderer
 
-	list     *List
-	scroller *widget.Scroll
-	layout   *fyne.Container
+	list        *List
+	scroller    *widget.Scroll
+	layout      *fyne.Container
+	placeholder fyne.CanvasObject
 }
 
 func newListRenderer(objects []fyne.CanvasObject, l *List, scroller *widget.Scroll, layout *fyne.Container) *listRenderer {
 	lr := &listRenderer{BaseRenderer: widget.NewBaseRenderer(objects), list: l, scroller: scroller, layout: layout}
 	lr.scroller.OnScrolled = l.offsetUpdated
+	lr.scroller.OnDragEnd = l.snapToPage
 	return lr
 }
 
 func (l *listRenderer) Layout(size fyne.Size) {
 	l.scroller.Resize(size)
+	l.updatePlaceholder()
 }
 
 func (l *listRenderer) MinSize() fyne.Size {
@@ -478,10 +993,15 @@ func (l *listRenderer) Refresh() {
 		item := createItemAndApplyThemeScope(f, l.list)
 		l.list.itemMin = item.MinSize()
 	}
+	if f := l.list.CreateDetail; f != nil {
+		detail := createItemAndApplyThemeScope(f, l.list)
+		l.list.detailMin = detail.MinSize()
+	}
 	l.Layout(l.list.Size())
 	l.scroller.Refresh()
 	layout := l.layout.Layout.(*listLayout)
 	layout.updateList(false)
+	l.updatePlaceholder()
 
 	for _, s := range layout.separators {
 		s.Refresh()
@@ -489,18 +1009,85 @@ func (l *listRenderer) Refresh() {
 	canvas.Refresh(l.list.super())
 }
 
+// updatePlaceholder shows the configured placeholder, centered, whenever the list has
+// no items to display.
+func (l *listRenderer) updatePlaceholder() {
+	p := l.list.placeholder
+	if p != l.placeholder {
+		l.placeholder = p
+		objects := []fyne.CanvasObject{l.scroller}
+		if p != nil {
+			objects = append(objects, p)
+		}
+		l.SetObjects(objects)
+	}
+	if p == nil {
+		return
+	}
+
+	length := 0
+	if f := l.list.Length; f != nil {
+		length = f()
+	}
+	if length > 0 {
+		p.Hide()
+		return
+	}
+
+	min := p.MinSize()
+	size := l.list.Size()
+	p.Resize(min)
+	p.Move(fyne.NewPos((size.Width-min.Width)/2, (size.Height-min.Height)/2))
+	p.Show()
+}
+
 // Declare conformity with interfaces.
 var _ fyne.Widget = (*listItem)(nil)
 var _ fyne.Tappable = (*listItem)(nil)
+var _ fyne.DoubleTappable = (*listItem)(nil)
+var _ fyne.Draggable = (*listItem)(nil)
 var _ desktop.Hoverable = (*listItem)(nil)
 
 type listItem struct {
 	BaseWidget
 
 	onTapped          func()
+	onDoubleTapped    func()
+	onHovered         func()
+	onUnhovered       func()
 	background        *canvas.Rectangle
 	child             fyne.CanvasObject
+	list              *List
+	id                ListItemID
+	key               string
+	check             *Check
+	detail            fyne.CanvasObject
+	detailHeight      float32
+	alternateColor    color.Color
+	style             ItemStyle
 	hovered, selected bool
+	rtl               bool
+
+	leadingActions  []*listSwipeAction
+	trailingActions []*listSwipeAction
+	swipeOffset     float32
+	swipeAnim       *fyne.Animation
+
+	entranceDistance float32
+	entranceOffset   float32
+	entranceFade     float32
+	entranceVeil     *canvas.Rectangle
+	entranceAnim     *fyne.Animation
+
+	dragData    func() *fyne.DragPayload
+	dragPayload *fyne.DragPayload
+	dragPos     fyne.Position
+	dragPreview *fyne.Container
+	dragBadge   *canvas.Text
+	dragEffect  fyne.DragEffect
+
+	toolTip        func() fyne.CanvasObject
+	toolTipHandler toolTipHandler
 }
 
 func newListItem(child fyne.CanvasObject, tapped func()) *listItem {
@@ -535,9 +1122,15 @@ func (li *listItem) MinSize() fyne.Size {
 }
 
 // MouseIn is called when a desktop pointer enters the widget.
-func (li *listItem) MouseIn(*desktop.MouseEvent) {
+func (li *listItem) MouseIn(ev *desktop.MouseEvent) {
 	li.hovered = true
+	if li.toolTip != nil {
+		li.toolTipHandler.showAfterDelay(li, li.toolTip, ev.AbsolutePosition)
+	}
 	li.Refresh()
+	if li.onHovered != nil {
+		li.onHovered()
+	}
 }
 
 // MouseMoved is called when a desktop pointer hovers over the widget.
@@ -547,11 +1140,23 @@ func (li *listItem) MouseMoved(*desktop.MouseEvent) {
 // MouseOut is called when a desktop pointer exits the widget.
 func (li *listItem) MouseOut() {
 	li.hovered = false
+	li.toolTipHandler.dismiss()
 	li.Refresh()
+	if li.onUnhovered != nil {
+		li.onUnhovered()
+	}
+}
+
+// DoubleTapped is called when a pointer double-tapped event is captured and triggers any activation handler.
+func (li *listItem) DoubleTapped(*fyne.PointEvent) {
+	if li.onDoubleTapped != nil {
+		li.onDoubleTapped()
+	}
 }
 
 // Tapped is called when a pointer tapped event is captured and triggers any tap handler.
 func (li *listItem) Tapped(*fyne.PointEvent) {
+	li.toolTipHandler.dismiss()
 	if li.onTapped != nil {
 		li.selected = true
 		li.Refresh()
@@ -577,24 +1182,94 @@ func (li *listItemRenderer) MinSize() fyne.Size {
 // Layout the components of the listItem widget.
 func (li *listItemRenderer) Layout(size fyne.Size) {
 	li.item.background.Resize(size)
-	li.item.child.Resize(size)
+	childHeight := size.Height
+	if li.item.detail != nil && li.item.detail.Visible() {
+		childHeight -= li.item.detailHeight
+		li.item.detail.Move(fyne.NewPos(0, childHeight))
+		li.item.detail.Resize(fyne.NewSize(size.Width, li.item.detailHeight))
+	}
+	childX := float32(0)
+	childWidth := size.Width
+	if li.item.check != nil && li.item.check.Visible() {
+		min := li.item.check.MinSize()
+		li.item.check.Resize(min)
+		checkX := float32(0)
+		if li.item.rtl {
+			checkX = size.Width - min.Width
+		} else {
+			childX = min.Width
+		}
+		li.item.check.Move(fyne.NewPos(checkX, (childHeight-min.Height)/2))
+		childWidth -= min.Width
+	}
+	swipe := li.item.swipeOffset
+	if li.item.rtl {
+		swipe = -swipe
+	}
+	li.item.child.Resize(fyne.NewSize(childWidth, childHeight))
+	li.item.child.Move(fyne.NewPos(childX+swipe, li.item.entranceOffset))
+	if veil := li.item.entranceVeil; veil != nil {
+		veil.Resize(size)
+	}
+	li.item.layoutActions(size)
 }
 
 func (li *listItemRenderer) Refresh() {
 	th := li.item.Theme()
 	v := fyne.CurrentApp().Settings().ThemeVariant()
 
+	objects := []fyne.CanvasObject{li.item.background}
+	for _, a := range li.item.leadingActions {
+		objects = append(objects, a)
+	}
+	for _, a := range li.item.trailingActions {
+		objects = append(objects, a)
+	}
+	if li.item.detail != nil {
+		objects = append(objects, li.item.detail)
+	}
+	if li.item.check != nil {
+		objects = append(objects, li.item.check)
+	}
+	objects = append(objects, li.item.child)
+	if veil := li.item.entranceVeil; veil != nil {
+		objects = append(objects, veil)
+	}
+	li.SetObjects(objects)
+	li.Layout(li.item.Size())
+
 	li.item.background.CornerRadius = th.Size(theme.SizeNameSelectionRadius)
 	if li.item.selected {
-		li.item.background.FillColor = th.Color(theme.ColorNameSelection, v)
+		selection := th.Color(theme.ColorNameSelection, v)
+		if c := li.item.style.SelectionColor; c != nil {
+			selection = c
+		}
+		li.item.background.FillColor = selection
 		li.item.background.Show()
 	} else if li.item.hovered {
 		li.item.background.FillColor = th.Color(theme.ColorNameHover, v)
 		li.item.background.Show()
+	} else if c := li.item.style.BackgroundColor; c != nil {
+		li.item.background.FillColor = c
+		li.item.background.Show()
+	} else if li.item.alternateColor != nil {
+		li.item.background.FillColor = li.item.alternateColor
+		li.item.background.CornerRadius = 0
+		li.item.background.Show()
 	} else {
 		li.item.background.Hide()
 	}
 	li.item.background.Refresh()
+	if veil := li.item.entranceVeil; veil != nil {
+		if fade := li.item.entranceFade; fade > 0 {
+			r, g, b, _ := th.Color(theme.ColorNameBackground, v).RGBA()
+			veil.FillColor = color.NRGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: uint8(fade * 255)}
+			veil.Show()
+			veil.Refresh()
+		} else {
+			veil.Hide()
+		}
+	}
 	canvas.Refresh(li.item.super())
 }
 
@@ -652,11 +1327,18 @@ func (l *listLayout) offsetUpdated(pos fyne.Position) {
 	if l.list.offsetY == pos.Y {
 		return
 	}
+	l.list.lastScrollDelta = pos.Y - l.list.offsetY
 	l.list.offsetY = pos.Y
 	l.updateList(true)
 }
 
 func (l *listLayout) setupListItem(li *listItem, id ListItemID, focus bool) {
+	li.list = l.list
+	li.id = id
+	if rtl := l.list.Direction.isRTL(); li.rtl != rtl {
+		li.rtl = rtl
+		li.Refresh()
+	}
 	previousIndicator := li.selected
 	li.selected = false
 	for _, s := range l.list.selected {
@@ -665,6 +1347,14 @@ func (l *listLayout) setupListItem(li *listItem, id ListItemID, focus bool) {
 			break
 		}
 	}
+	alternate := color.Color(nil)
+	if l.list.AlternateRowColor != nil && id%2 == 1 {
+		alternate = l.list.AlternateRowColor
+	}
+	if li.alternateColor != alternate {
+		li.alternateColor = alternate
+		li.Refresh()
+	}
 	if focus {
 		li.hovered = true
 		li.Refresh()
@@ -672,8 +1362,62 @@ func (l *listLayout) setupListItem(li *listItem, id ListItemID, focus bool) {
 		li.hovered = false
 		li.Refresh()
 	}
+	li.setActions(l.list.leadingActions, l.list.trailingActions, id)
+	if f := l.list.ItemToolTip; f != nil {
+		li.toolTip = func() fyne.CanvasObject { return f(id) }
+	} else {
+		li.toolTip = nil
+	}
+	if l.list.ShowCheckboxes {
+		if li.check == nil {
+			li.check = NewCheck("", nil)
+			if cache.OverrideThemeMatchingScope(li.check, l.list) {
+				li.check.Refresh()
+			}
+		}
+		li.check.OnChanged = func(checked bool) {
+			l.list.setItemChecked(id, checked)
+		}
+		li.check.Checked = l.list.ItemChecked(id)
+		li.check.Show()
+		li.check.Refresh()
+		li.Refresh()
+	} else if li.check != nil && li.check.Visible() {
+		li.check.Hide()
+		li.Refresh()
+	}
+	if l.list.CreateDetail != nil && l.list.isExpanded(id) {
+		if li.detail == nil {
+			li.detail = createItemAndApplyThemeScope(l.list.CreateDetail, l.list)
+		}
+		li.detailHeight = l.list.detailHeight()
+		li.detail.Show()
+		if f := l.list.UpdateDetail; f != nil {
+			f(id, li.detail)
+		}
+		li.Refresh()
+	} else if li.detail != nil && li.detail.Visible() {
+		li.detail.Hide()
+		li.Refresh()
+	}
+	key := ""
+	if kf := l.list.KeyForItem; kf != nil {
+		key = kf(id)
+	}
 	if f := l.list.UpdateItem; f != nil {
-		f(id, li.child)
+		if key == "" || li.key != key { // rows that kept their key are not rebound
+			f(id, li.child)
+		}
+	}
+	li.key = key
+	style := ItemStyle{}
+	if f := l.list.StyleItem; f != nil {
+		f(id, li.child, &style)
+		style.applyText(li.child)
+	}
+	if li.style != style {
+		li.style = style
+		li.Refresh()
 	}
 	li.onTapped = func() {
 		if !fyne.CurrentDevice().IsMobile() {
@@ -682,14 +1426,40 @@ func (l *listLayout) setupListItem(li *listItem, id ListItemID, focus bool) {
 				canvas.Focus(l.list)
 			}
 
-			l.list.currentFocus = id
+			if l.list.currentFocus != id {
+				l.list.currentFocus = id
+				l.list.focusChanged()
+			}
 		}
 
 		l.list.Select(id)
 	}
+	li.onDoubleTapped = func() {
+		if f := l.list.OnActivated; f != nil {
+			f(id)
+		}
+	}
+	li.onHovered = func() {
+		if f := l.list.OnItemHovered; f != nil {
+			f(id)
+		}
+	}
+	if f := l.list.DragDataForItem; f != nil {
+		li.dragData = func() *fyne.DragPayload { return f(id) }
+	} else {
+		li.dragData = nil
+	}
+	li.onUnhovered = func() {
+		if f := l.list.OnItemUnhovered; f != nil {
+			f(id)
+		}
+	}
 }
 
 func (l *listLayout) updateList(newOnly bool) {
+	if l.list.refreshDeferred() {
+		return
+	}
 	th := l.list.Theme()
 	separatorThickness := th.Size(theme.SizeNamePadding)
 	l.renderLock.Lock()
@@ -721,12 +1491,40 @@ func (l *listLayout) updateList(newOnly bool) {
 	oldChildrenLen := len(l.children)
 	l.children = l.children[:0]
 
+	// with stable keys, match the previous rows to their new positions by key so
+	// the same widgets move with their data instead of being rebound
+	var keyed map[string]*listItem
+	var used map[*listItem]bool
+	kf := l.list.KeyForItem
+	if kf != nil {
+		keyed = make(map[string]*listItem, len(wasVisible))
+		used = make(map[*listItem]bool, len(wasVisible))
+		for _, wasVis := range wasVisible {
+			if wasVis.item.key != "" {
+				keyed[wasVis.item.key] = wasVis.item
+			}
+		}
+	}
+
 	y := offY
 	for index, itemHeight := range l.visibleRowHeights {
 		row := index + minRow
 		size := fyne.NewSize(width, itemHeight)
 
-		c, ok := l.searchVisible(wasVisible, row)
+		var c *listItem
+		ok := false
+		if kf != nil {
+			if item, found := keyed[kf(row)]; found && !used[item] {
+				c, ok = item, true
+			} else if item, found := l.searchVisible(wasVisible, row); found && item.key == "" && !used[item] {
+				c, ok = item, true // not yet bound to a key, safe to reuse in place
+			}
+			if ok {
+				used[c] = true
+			}
+		} else {
+			c, ok = l.searchVisible(wasVisible, row)
+		}
 		if !ok {
 			c = l.getItem()
 			if c == nil {
@@ -746,7 +1544,11 @@ func (l *listLayout) updateList(newOnly bool) {
 	l.nilOldVisibleSliceData(l.visible, len(l.visible), oldVisibleLen)
 
 	for _, wasVis := range wasVisible {
-		if _, ok := l.searchVisible(l.visible, wasVis.id); !ok {
+		if used != nil {
+			if !used[wasVis.item] {
+				l.itemPool.Release(wasVis.item)
+			}
+		} else if _, ok := l.searchVisible(l.visible, wasVis.id); !ok {
 			l.itemPool.Release(wasVis.item)
 		}
 	}
@@ -765,8 +1567,27 @@ func (l *listLayout) updateList(newOnly bool) {
 	visiblePtr := l.slicePool.Get().(*[]listItemAndID)
 	visible := (*visiblePtr)[:0]
 	visible = append(visible, l.visible...)
+	maxRow := minRow + len(l.visibleRowHeights) - 1
 	l.renderLock.Unlock() // user code should not be locked
 
+	l.list.captureItemKeys(minRow, l.list.offsetY-offY)
+	l.list.checkNearEnd(maxRow, length)
+
+	if f := l.list.OnItemShown; f != nil {
+		for _, vis := range visible {
+			if _, ok := l.searchVisible(wasVisible, vis.id); !ok {
+				f(vis.id)
+			}
+		}
+	}
+	if f := l.list.OnItemHidden; f != nil {
+		for _, wasVis := range wasVisible {
+			if _, ok := l.searchVisible(visible, wasVis.id); !ok {
+				f(wasVis.id)
+			}
+		}
+	}
+
 	if newOnly {
 		for _, vis := range visible {
 			if _, ok := l.searchVisible(wasVisible, vis.id); !ok {
@@ -779,6 +1600,10 @@ func (l *listLayout) updateList(newOnly bool) {
 		}
 	}
 
+	if l.list.Entrance != ListEntranceNone || l.list.OnItemScrollProgress != nil {
+		l.applyScrollEffects(visible, wasVisible)
+	}
+
 	// nil out all references before returning slices to pool
 	for i := 0; i < len(wasVisible); i++ {
 		wasVisible[i].item = nil
@@ -797,33 +1622,52 @@ func (l *listLayout) updateSeparators() {
 		l.separators = nil
 		return
 	}
-	if lenChildren := len(l.children); lenChildren > 1 {
-		if lenSep := len(l.separators); lenSep > lenChildren {
-			l.separators = l.separators[:lenChildren]
-		} else {
-			for i := lenSep; i < lenChildren; i++ {
 
-				sep := NewSeparator()
-				if cache.OverrideThemeMatchingScope(sep, l.list) {
-					sep.Refresh()
-				}
+	th := l.list.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	custom := l.list.SeparatorColor != nil || l.list.SeparatorThickness > 0 || l.list.SeparatorInset > 0
+	if len(l.separators) > 0 {
+		if _, plain := l.separators[0].(*Separator); plain == custom { // styling toggled, rebuild
+			l.separators = nil
+		}
+	}
 
-				l.separators = append(l.separators, sep)
+	if lenChildren := len(l.children); lenChildren > 1 {
+		l.separators = virtualizer.AdjustLength(l.separators, lenChildren, func() fyne.CanvasObject {
+			if custom {
+				return canvas.NewRectangle(th.Color(theme.ColorNameSeparator, v))
 			}
-		}
+
+			wid := NewSeparator()
+			if cache.OverrideThemeMatchingScope(wid, l.list) {
+				wid.Refresh()
+			}
+			return wid
+		})
 	} else {
 		l.separators = nil
 	}
 
-	th := l.list.Theme()
 	separatorThickness := th.Size(theme.SizeNameSeparatorThickness)
+	if l.list.SeparatorThickness > 0 {
+		separatorThickness = l.list.SeparatorThickness
+	}
+	separatorColor := l.list.SeparatorColor
+	if separatorColor == nil {
+		separatorColor = th.Color(theme.ColorNameSeparator, v)
+	}
+	inset := l.list.SeparatorInset
+
 	dividerOff := (th.Size(theme.SizeNamePadding) + separatorThickness) / 2
 	for i, child := range l.children {
 		if i == 0 {
 			continue
 		}
-		l.separators[i].Move(fyne.NewPos(0, child.Position().Y-dividerOff))
-		l.separators[i].Resize(fyne.NewSize(l.list.Size().Width, separatorThickness))
+		if rect, ok := l.separators[i].(*canvas.Rectangle); ok {
+			rect.FillColor = separatorColor
+		}
+		l.separators[i].Move(fyne.NewPos(inset, child.Position().Y-dividerOff))
+		l.separators[i].Resize(fyne.NewSize(l.list.Size().Width-2*inset, separatorThickness))
 		l.separators[i].Show()
 	}
 }
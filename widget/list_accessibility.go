@@ -0,0 +1,47 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/lang"
+)
+
+// Declare conformity with the accessibility interface.
+var _ fyne.Accessible = (*List)(nil)
+var _ fyne.Accessible = (*listItem)(nil)
+
+// AccessibilityInfo returns the semantics of this list for assistive technology.
+//
+// Since: 2.6
+func (l *List) AccessibilityInfo() fyne.Accessibility {
+	info := fyne.Accessibility{Role: fyne.AccessibilityRoleList}
+	if f := l.Length; f != nil {
+		info.Count = f()
+	}
+	return info
+}
+
+// AccessibilityInfo returns the semantics of this row for assistive technology.
+func (li *listItem) AccessibilityInfo() fyne.Accessibility {
+	info := fyne.Accessibility{
+		Role:     fyne.AccessibilityRoleListItem,
+		Index:    li.id + 1,
+		Selected: li.selected,
+	}
+	if li.list != nil {
+		if f := li.list.Length; f != nil {
+			info.Count = f()
+		}
+	}
+	return info
+}
+
+// announceSelection reports a selection change to any screen reader that is listening.
+func (l *List) announceSelection(id ListItemID) {
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+
+	fyne.Announce(lang.L("Item {{.Index}} of {{.Count}} selected",
+		map[string]any{"Index": id + 1, "Count": length}))
+}
@@ -0,0 +1,32 @@
+package widget
+
+// UpdateBatch applies many changes to this list with a single reconciliation pass.
+// Refreshes and layout recomputation triggered inside the given function - by
+// selection changes, item height updates, data changes and so on - are suppressed,
+// and the list refreshes once when the outermost batch returns. Calls may be nested.
+//
+// Since: 2.6
+func (l *List) UpdateBatch(changes func()) {
+	l.propertyLock.Lock()
+	l.batching++
+	l.propertyLock.Unlock()
+
+	changes()
+
+	l.propertyLock.Lock()
+	l.batching--
+	done := l.batching == 0
+	l.propertyLock.Unlock()
+
+	if done {
+		l.Refresh()
+	}
+}
+
+// refreshDeferred reports whether refreshes are currently suppressed by an UpdateBatch.
+func (l *List) refreshDeferred() bool {
+	l.propertyLock.RLock()
+	defer l.propertyLock.RUnlock()
+
+	return l.batching > 0
+}
@@ -0,0 +1,100 @@
+package widget
+
+import (
+	"sort"
+)
+
+// SetItemChecked sets the checked state of the given row, as shown by the leading
+// check control when ShowCheckboxes is enabled.
+//
+// Since: 2.6
+func (l *List) SetItemChecked(id ListItemID, checked bool) {
+	if l.setItemChecked(id, checked) {
+		l.RefreshItem(id)
+	}
+}
+
+// setItemChecked updates the checked state, notifying any callback, and reports
+// whether the state changed.
+func (l *List) setItemChecked(id ListItemID, checked bool) bool {
+	l.propertyLock.Lock()
+	if l.checked == nil {
+		l.checked = make(map[ListItemID]bool)
+	}
+
+	changed := l.checked[id] != checked
+	if checked {
+		l.checked[id] = true
+	} else {
+		delete(l.checked, id)
+	}
+	l.propertyLock.Unlock()
+
+	if changed {
+		if f := l.OnItemChecked; f != nil {
+			f(id, checked)
+		}
+	}
+	return changed
+}
+
+// ItemChecked returns whether the given row is currently checked.
+//
+// Since: 2.6
+func (l *List) ItemChecked(id ListItemID) bool {
+	l.propertyLock.RLock()
+	defer l.propertyLock.RUnlock()
+
+	return l.checked[id]
+}
+
+// CheckedItems returns the IDs of all checked rows, in ascending order.
+//
+// Since: 2.6
+func (l *List) CheckedItems() []ListItemID {
+	l.propertyLock.RLock()
+	items := make([]ListItemID, 0, len(l.checked))
+	for id := range l.checked {
+		items = append(items, id)
+	}
+	l.propertyLock.RUnlock()
+
+	sort.Ints(items)
+	return items
+}
+
+// SetAllChecked checks or clears every row, supporting a "select all" control.
+//
+// Since: 2.6
+func (l *List) SetAllChecked(checked bool) {
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+
+	for id := 0; id < length; id++ {
+		l.setItemChecked(id, checked)
+	}
+	l.Refresh()
+}
+
+// AllChecked reports the tri-state summary needed by a "select all" control -
+// whether every row is checked and whether any row is checked at all.
+//
+// Since: 2.6
+func (l *List) AllChecked() (all, any bool) {
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+
+	l.propertyLock.RLock()
+	defer l.propertyLock.RUnlock()
+	count := 0
+	for id := range l.checked {
+		if id < length {
+			count++
+		}
+	}
+	return count == length && length > 0, count > 0
+}
@@ -0,0 +1,107 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// ListColumn describes a single column of a List that presents table-like rows.
+// Set the Columns field of a List instead of CreateItem and UpdateItem to have each
+// row built from one cell per column, sharing the normal row recycling machinery.
+//
+// Since: 2.6
+type ListColumn struct {
+	// Width is the fixed width of this column. A width of 0 means the column is
+	// flexible and shares the space left over by the fixed columns.
+	Width float32
+	// CreateCell returns a new template object for this column's cell.
+	CreateCell func() fyne.CanvasObject
+	// UpdateCell is called to update a cell to show the content of the given item.
+	UpdateCell func(id ListItemID, cell fyne.CanvasObject)
+}
+
+// applyColumns synthesizes the CreateItem and UpdateItem callbacks from the Columns
+// field, if columns were specified and no item templates were set directly.
+func (l *List) applyColumns() {
+	if len(l.Columns) == 0 || l.CreateItem != nil {
+		return
+	}
+
+	columns := l.Columns
+	l.CreateItem = func() fyne.CanvasObject {
+		cells := make([]fyne.CanvasObject, len(columns))
+		for i, c := range columns {
+			cells[i] = c.CreateCell()
+		}
+		return &fyne.Container{Layout: &listRowLayout{columns: columns}, Objects: cells}
+	}
+	if l.UpdateItem == nil {
+		l.UpdateItem = func(id ListItemID, item fyne.CanvasObject) {
+			row := item.(*fyne.Container)
+			for i, c := range columns {
+				if f := c.UpdateCell; f != nil && i < len(row.Objects) {
+					f(id, row.Objects[i])
+				}
+			}
+		}
+	}
+}
+
+// Declare conformity with Layout interface.
+var _ fyne.Layout = (*listRowLayout)(nil)
+
+// listRowLayout arranges one cell per column, honouring the fixed column widths and
+// sharing any remaining space between the flexible columns.
+type listRowLayout struct {
+	columns []ListColumn
+}
+
+func (l *listRowLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	padding := theme.Padding()
+
+	size := fyne.NewSize(0, 0)
+	for i, o := range objects {
+		min := o.MinSize()
+		width := min.Width
+		if i < len(l.columns) && l.columns[i].Width > 0 {
+			width = l.columns[i].Width
+		}
+
+		size.Width += width
+		if i > 0 {
+			size.Width += padding
+		}
+		size.Height = fyne.Max(size.Height, min.Height)
+	}
+	return size
+}
+
+func (l *listRowLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	padding := theme.Padding()
+
+	flexSpace := size.Width - padding*float32(len(objects)-1)
+	flexCount := 0
+	for i := range objects {
+		if i < len(l.columns) && l.columns[i].Width > 0 {
+			flexSpace -= l.columns[i].Width
+		} else {
+			flexCount++
+		}
+	}
+	flexWidth := float32(0)
+	if flexCount > 0 && flexSpace > 0 {
+		flexWidth = flexSpace / float32(flexCount)
+	}
+
+	x := float32(0)
+	for i, o := range objects {
+		width := flexWidth
+		if i < len(l.columns) && l.columns[i].Width > 0 {
+			width = l.columns[i].Width
+		}
+
+		o.Move(fyne.NewPos(x, 0))
+		o.Resize(fyne.NewSize(width, size.Height))
+		x += width + padding
+	}
+}
@@ -0,0 +1,46 @@
+package widget
+
+import (
+	"fmt"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_Columns(t *testing.T) {
+	list := &List{
+		Length: func() int { return 5 },
+		Columns: []ListColumn{
+			{
+				Width:      32,
+				CreateCell: func() fyne.CanvasObject { return NewIcon(theme.DocumentIcon()) },
+			},
+			{
+				CreateCell: func() fyne.CanvasObject { return NewLabel("Template") },
+				UpdateCell: func(id ListItemID, cell fyne.CanvasObject) {
+					cell.(*Label).SetText(fmt.Sprintf("Item %d", id))
+				},
+			},
+		},
+	}
+	list.ExtendBaseWidget(list)
+
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 200))
+
+	lo := list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	item, ok := lo.searchVisible(lo.visible, 1)
+	assert.True(t, ok)
+
+	row := item.child.(*fyne.Container)
+	assert.Len(t, row.Objects, 2)
+	assert.Equal(t, "Item 1", row.Objects[1].(*Label).Text)
+
+	assert.Equal(t, float32(32), row.Objects[0].Size().Width)
+	flexible := row.Size().Width - 32 - theme.Padding()
+	assert.Equal(t, flexible, row.Objects[1].Size().Width)
+}
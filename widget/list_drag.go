@@ -0,0 +1,179 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/driver"
+	"fyne.io/fyne/v2/theme"
+)
+
+// dragOut starts or updates a drag-out of this row, tracking the pointer so the
+// payload can be delivered to the drop target under it when the drag ends.
+func (li *listItem) dragOut(e *fyne.DragEvent) {
+	if li.dragPayload == nil {
+		li.dragPayload = li.dragData()
+		if li.dragPayload == nil {
+			return // this row is not draggable
+		}
+
+		li.showDragPreview()
+	}
+
+	li.dragPos = e.AbsolutePosition
+	li.moveDragPreview()
+}
+
+// dropPayload delivers the dragged payload to the accepting fyne.DropTarget under
+// the pointer, if there is one.
+func (li *listItem) dropPayload() {
+	payload := li.dragPayload
+	li.dragPayload = nil
+	li.hideDragPreview()
+
+	c := fyne.CurrentApp().Driver().CanvasForObject(li)
+	if c == nil {
+		return
+	}
+
+	matches := func(object fyne.CanvasObject) bool {
+		target, ok := object.(fyne.DropTarget)
+		return ok && target.AcceptsDragPayload(payload)
+	}
+	var overlay fyne.CanvasObject
+	if o := c.Overlays().Top(); o != nil {
+		overlay = o
+	}
+	object, pos, _ := driver.FindObjectAtPositionMatching(li.dragPos, matches, overlay, c.Content())
+	if target, ok := object.(fyne.DropTarget); ok {
+		target.DragPayloadDropped(pos, payload)
+	}
+}
+
+// showDragPreview places the payload preview, or a default one built from the
+// payload text, in the canvas overlays so it can follow the pointer.
+func (li *listItem) showDragPreview() {
+	c := fyne.CurrentApp().Driver().CanvasForObject(li)
+	if c == nil {
+		return
+	}
+
+	th := li.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+
+	content := li.dragPayload.Preview
+	if content == nil {
+		content = li.defaultDragPreview()
+	}
+	size := content.Size()
+	if size.IsZero() {
+		size = content.MinSize()
+		content.Resize(size)
+	}
+	content.Move(fyne.NewPos(0, 0))
+
+	badge := canvas.NewText("", th.Color(theme.ColorNamePrimary, v))
+	badge.TextStyle.Bold = true
+	badge.Move(fyne.NewPos(size.Width+th.Size(theme.SizeNamePadding), 0))
+
+	li.dragBadge = badge
+	li.dragEffect = fyne.DragEffectMove // matches the empty badge until the first move
+	li.dragPreview = &fyne.Container{Objects: []fyne.CanvasObject{content, badge}}
+	li.dragPreview.Resize(size)
+	c.Overlays().Add(li.dragPreview)
+}
+
+// defaultDragPreview builds a simple preview from the payload text for drag
+// sources that do not supply their own.
+func (li *listItem) defaultDragPreview() fyne.CanvasObject {
+	th := li.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	pad := th.Size(theme.SizeNamePadding)
+
+	text := canvas.NewText(li.dragPayload.Text, th.Color(theme.ColorNameForeground, v))
+	text.Move(fyne.NewPos(pad*2, pad))
+	size := text.MinSize().Add(fyne.NewSize(pad*4, pad*2))
+
+	bg := canvas.NewRectangle(th.Color(theme.ColorNameOverlayBackground, v))
+	bg.CornerRadius = th.Size(theme.SizeNameInputRadius)
+	bg.Resize(size)
+
+	preview := &fyne.Container{Objects: []fyne.CanvasObject{bg, text}}
+	preview.Resize(size)
+	return preview
+}
+
+// moveDragPreview keeps the preview beside the pointer and updates the badge
+// showing the effect a drop on the target underneath would have.
+func (li *listItem) moveDragPreview() {
+	if li.dragPreview == nil {
+		return
+	}
+
+	pad := li.Theme().Size(theme.SizeNamePadding)
+	li.dragPreview.Move(li.dragPos.Add(fyne.NewPos(pad*2, pad*2)))
+
+	effect := li.currentDragEffect()
+	if effect == li.dragEffect {
+		return
+	}
+	li.dragEffect = effect
+	li.dragBadge.Text = dragEffectBadge(effect)
+	li.dragBadge.Refresh()
+}
+
+// hideDragPreview removes the preview from the canvas overlays at the end of a drag.
+func (li *listItem) hideDragPreview() {
+	if li.dragPreview == nil {
+		return
+	}
+
+	if c := fyne.CurrentApp().Driver().CanvasForObject(li); c != nil {
+		c.Overlays().Remove(li.dragPreview)
+	}
+	li.dragPreview = nil
+	li.dragBadge = nil
+	li.dragEffect = fyne.DragEffectNone
+}
+
+// currentDragEffect returns the effect a drop at the current pointer position
+// would have, querying the fyne.DragEffecter target under it if there is one.
+func (li *listItem) currentDragEffect() fyne.DragEffect {
+	c := fyne.CurrentApp().Driver().CanvasForObject(li)
+	if c == nil {
+		return fyne.DragEffectNone
+	}
+
+	matches := func(object fyne.CanvasObject) bool {
+		target, ok := object.(fyne.DropTarget)
+		return ok && target.AcceptsDragPayload(li.dragPayload)
+	}
+	var overlay fyne.CanvasObject
+	for _, o := range c.Overlays().List() {
+		if o != li.dragPreview { // the preview itself must not block the search
+			overlay = o
+		}
+	}
+	object, pos, _ := driver.FindObjectAtPositionMatching(li.dragPos, matches, overlay, c.Content())
+	if effecter, ok := object.(fyne.DragEffecter); ok {
+		return effecter.DragEffect(pos, li.dragPayload)
+	}
+	if _, ok := object.(fyne.DropTarget); ok {
+		return fyne.DragEffectMove
+	}
+	return fyne.DragEffectNone
+}
+
+// dragEffectBadge returns the character shown beside the drag preview for the
+// given effect. A plain move shows no badge.
+func dragEffectBadge(effect fyne.DragEffect) string {
+	switch effect {
+	case fyne.DragEffectCopy:
+		return "+"
+	case fyne.DragEffectLink:
+		return "→"
+	case fyne.DragEffectNone:
+		return "×"
+	}
+
+	return ""
+}
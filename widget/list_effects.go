@@ -0,0 +1,104 @@
+package widget
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// ListEntrance selects the animation a List plays when a row first scrolls
+// into the viewport, see List.Entrance.
+//
+// Since: 2.6
+type ListEntrance int
+
+const (
+	// ListEntranceNone shows new rows immediately, the default.
+	ListEntranceNone ListEntrance = iota
+	// ListEntranceFade fades new rows in from the list background.
+	ListEntranceFade
+	// ListEntranceSlide slides new rows up into their place.
+	ListEntranceSlide
+)
+
+// listEntranceStagger is the extra delay added for each subsequent row when a
+// batch of rows enters the viewport together.
+const listEntranceStagger = 50 * time.Millisecond
+
+// applyScrollEffects reports scroll progress for the visible rows and starts
+// entrance animations for rows that just scrolled into the viewport. Only
+// visible rows are visited so the cost stays constant on long lists.
+func (l *listLayout) applyScrollEffects(visible, wasVisible []listItemAndID) {
+	list := l.list
+	if f := list.OnItemScrollProgress; f != nil {
+		if height := list.scroller.Size().Height; height > 0 {
+			for _, vis := range visible {
+				y := vis.item.Position().Y - list.offsetY
+				span := height + vis.item.Size().Height
+				progress := (height - y) / span
+				if progress < 0 {
+					progress = 0
+				} else if progress > 1 {
+					progress = 1
+				}
+				f(vis.id, progress)
+			}
+		}
+	}
+
+	if list.Entrance == ListEntranceNone {
+		return
+	}
+	entering := 0
+	for _, vis := range visible {
+		if _, ok := l.searchVisible(wasVisible, vis.id); ok {
+			continue
+		}
+		vis.item.animateEntrance(list.Entrance, entering)
+		entering++
+	}
+}
+
+// animateEntrance plays the given entrance effect on this row, delayed by the
+// given index so rows appearing together enter with a stagger.
+func (li *listItem) animateEntrance(style ListEntrance, index int) {
+	if li.entranceAnim != nil {
+		li.entranceAnim.Stop()
+	}
+
+	offset := float32(0)
+	if style == ListEntranceSlide {
+		offset = li.Theme().Size(theme.SizeNameInnerPadding) * 2
+	} else if li.entranceVeil == nil {
+		li.entranceVeil = canvas.NewRectangle(nil)
+	}
+	li.entranceDistance = offset
+
+	delay := time.Duration(index) * listEntranceStagger
+	total := canvas.DurationShort + delay
+	hold := float32(delay) / float32(total)
+	li.setEntranceProgress(0)
+
+	li.entranceAnim = fyne.NewAnimation(total, func(f float32) {
+		p := float32(0)
+		if f > hold {
+			p = (f - hold) / (1 - hold)
+		}
+		if f == 1 {
+			li.entranceAnim = nil
+		}
+		li.setEntranceProgress(p)
+	})
+	li.entranceAnim.Curve = fyne.AnimationEaseOut
+	li.entranceAnim.Start()
+}
+
+// setEntranceProgress applies one frame of the entrance effect, moving the row
+// content and fading the veil covering it as progress runs from 0 to 1.
+func (li *listItem) setEntranceProgress(p float32) {
+	li.entranceOffset = li.entranceDistance * (1 - p)
+	li.entranceFade = 1 - p
+	li.Refresh()
+}
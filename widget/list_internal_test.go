@@ -10,6 +10,7 @@ import (
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/internal/virtualizer"
 	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/test"
 	"fyne.io/fyne/v2/theme"
@@ -565,6 +566,117 @@ func TestList_Focus(t *testing.T) {
 	assert.True(t, children[0].(*listItem).selected)
 }
 
+func TestList_FocusPageAndHomeEnd(t *testing.T) {
+	test.NewTempApp(t)
+	list := createList(100)
+	window := test.NewWindow(list)
+	defer window.Close()
+	window.Resize(fyne.NewSize(200, 200))
+
+	canvas := window.Canvas().(test.WindowlessCanvas)
+	canvas.FocusNext()
+	assert.Equal(t, 0, list.currentFocus)
+
+	list.TypedKey(&fyne.KeyEvent{Name: fyne.KeyPageDown})
+	page := list.pageSize()
+	assert.Greater(t, page, 1)
+	assert.Equal(t, page, list.currentFocus)
+
+	list.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEnd})
+	assert.Equal(t, 99, list.currentFocus)
+
+	list.TypedKey(&fyne.KeyEvent{Name: fyne.KeyPageUp})
+	assert.Equal(t, 99-page, list.currentFocus)
+
+	list.TypedKey(&fyne.KeyEvent{Name: fyne.KeyHome})
+	assert.Equal(t, 0, list.currentFocus)
+	assert.Equal(t, float32(0), list.offsetY)
+}
+
+func TestList_FocusItem(t *testing.T) {
+	test.NewTempApp(t)
+	list := createList(100)
+	window := test.NewWindow(list)
+	defer window.Close()
+	window.Resize(fyne.NewSize(200, 200))
+
+	var focused []ListItemID
+	list.OnFocusChanged = func(id ListItemID) {
+		focused = append(focused, id)
+	}
+
+	list.FocusItem(42)
+	assert.Equal(t, []ListItemID{42}, focused)
+
+	id, hasFocus := list.FocusedItem()
+	assert.Equal(t, 42, id)
+	assert.False(t, hasFocus) // the list widget itself was never focused
+
+	list.FocusItem(42) // no change, no callback
+	assert.Len(t, focused, 1)
+
+	list.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	assert.Equal(t, []ListItemID{42, 43}, focused)
+}
+
+func TestList_SetOnNearEnd(t *testing.T) {
+	list := createList(100)
+	fired := 0
+	list.SetOnNearEnd(5, func() {
+		fired++
+	})
+
+	w := test.NewWindow(list)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 200))
+	assert.Equal(t, 0, fired)
+
+	list.ScrollToBottom()
+	assert.Equal(t, 1, fired)
+
+	list.Refresh() // still near the end, should not fire again
+	assert.Equal(t, 1, fired)
+
+	list.ScrollToTop()
+	list.ScrollToBottom()
+	assert.Equal(t, 2, fired)
+}
+
+func TestList_InsertItem_Animated(t *testing.T) {
+	list := createList(10)
+	w := test.NewWindow(list)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 400))
+
+	list.Select(5)
+	list.SetItemHeight(6, 48)
+
+	list.InsertItem(2)
+	assert.Equal(t, []ListItemID{6}, list.selected)
+	assert.Equal(t, float32(48), list.itemHeights[7])
+}
+
+func TestList_RemoveItem_Animated(t *testing.T) {
+	list := createList(10)
+	w := test.NewWindow(list)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 400))
+
+	list.Select(5)
+	list.SetItemHeight(6, 48)
+
+	completed := false
+	list.RemoveItem(2, func() {
+		completed = true
+	})
+	assert.True(t, completed) // the test driver completes animations immediately
+	assert.Equal(t, []ListItemID{4}, list.selected)
+	assert.Equal(t, float32(48), list.itemHeights[5])
+
+	list.RemoveItem(4, nil)
+	assert.Empty(t, list.selected)
+}
+
 func createList(items int) *List {
 	var data []string
 	for i := 0; i < items; i++ {
@@ -680,3 +792,685 @@ func BenchmarkContentMinSize(b *testing.B) {
 
 	minSize = min
 }
+
+func TestList_SetItemExpandable(t *testing.T) {
+	list := createList(10)
+	list.CreateDetail = func() fyne.CanvasObject {
+		return NewLabel("Detail Template")
+	}
+	var updated []ListItemID
+	list.UpdateDetail = func(id ListItemID, detail fyne.CanvasObject) {
+		updated = append(updated, id)
+		detail.(*Label).SetText(fmt.Sprintf("Detail %d", id))
+	}
+
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 400))
+
+	collapsed := list.contentMinSize().Height
+	list.SetItemExpandable(1, true)
+	assert.Greater(t, list.contentMinSize().Height, collapsed)
+	assert.Contains(t, updated, 1)
+
+	lo := list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	item, ok := lo.searchVisible(lo.visible, 1)
+	assert.True(t, ok)
+	assert.NotNil(t, item.detail)
+	assert.True(t, item.detail.Visible())
+	assert.Equal(t, "Detail 1", item.detail.(*Label).Text)
+
+	list.SetItemExpandable(1, false)
+	assert.Equal(t, collapsed, list.contentMinSize().Height)
+	assert.False(t, item.detail.Visible())
+}
+
+func TestList_ScrollState(t *testing.T) {
+	list := createList(100)
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 200))
+
+	list.ScrollToOffset(500)
+	state := list.ScrollState()
+	assert.Equal(t, float32(500), state.offset)
+	assert.Greater(t, state.firstItem, 0)
+
+	list.ScrollToTop()
+	assert.Equal(t, float32(0), list.GetScrollOffset())
+
+	list.RestoreScrollState(state)
+	assert.Equal(t, float32(500), list.GetScrollOffset())
+}
+
+func TestList_RestoreScrollState_ChangedHeights(t *testing.T) {
+	list := createList(100)
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 200))
+
+	list.ScrollToOffset(300)
+	state := list.ScrollState()
+	first := state.firstItem
+
+	// grow an earlier row; the same item should still end up first visible
+	list.SetItemHeight(0, list.itemMin.Height+50)
+	list.RestoreScrollState(state)
+
+	restored := list.ScrollState()
+	assert.Equal(t, first, restored.firstItem)
+	assert.Equal(t, float32(350), list.GetScrollOffset())
+}
+
+func TestList_OnItemShownHidden(t *testing.T) {
+	shown := make(map[ListItemID]int)
+	hidden := make(map[ListItemID]int)
+	list := NewList(
+		func() int { return 100 },
+		func() fyne.CanvasObject { return NewLabel("Template Object") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(fmt.Sprintf("Test Item %d", id))
+		})
+	list.OnItemShown = func(id ListItemID) { shown[id]++ }
+	list.OnItemHidden = func(id ListItemID) { hidden[id]++ }
+
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 200))
+	shown = map[ListItemID]int{0: shown[0]} // layout churn during setup; track from here
+	hidden = map[ListItemID]int{}
+
+	assert.Equal(t, 1, shown[0])
+	assert.Zero(t, hidden[0])
+
+	list.ScrollToOffset(1000)
+	assert.Equal(t, 1, hidden[0])
+
+	list.ScrollToTop()
+	assert.Equal(t, 2, shown[0])
+}
+
+func TestList_SetPlaceholder(t *testing.T) {
+	var data []string
+	list := NewList(
+		func() int { return len(data) },
+		func() fyne.CanvasObject { return NewLabel("Template Object") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(data[id])
+		})
+	placeholder := NewLabel("No results")
+	list.SetPlaceholder(placeholder)
+
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 200))
+
+	assert.True(t, placeholder.Visible())
+	assert.Equal(t, (list.Size().Width-placeholder.Size().Width)/2, placeholder.Position().X)
+
+	data = append(data, "An item")
+	list.Refresh()
+	assert.False(t, placeholder.Visible())
+
+	data = nil
+	list.Refresh()
+	assert.True(t, placeholder.Visible())
+}
+
+func TestList_OnActivated(t *testing.T) {
+	list := createList(10)
+	activated := -1
+	selected := -1
+	list.OnActivated = func(id ListItemID) { activated = id }
+	list.OnSelected = func(id ListItemID) { selected = id }
+
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 200))
+
+	lo := list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	item, ok := lo.searchVisible(lo.visible, 1)
+	assert.True(t, ok)
+
+	test.DoubleTap(item)
+	assert.Equal(t, 1, activated)
+	assert.Equal(t, -1, selected) // activation does not change the selection
+
+	w.Canvas().Focus(list)
+	list.currentFocus = 3
+	list.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, 3, activated)
+}
+
+func TestList_ShowCheckboxes(t *testing.T) {
+	list := NewList(
+		func() int { return 10 },
+		func() fyne.CanvasObject { return NewLabel("Template Object") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(fmt.Sprintf("Test Item %d", id))
+		})
+	list.ShowCheckboxes = true
+	var toggled []ListItemID
+	list.OnItemChecked = func(id ListItemID, checked bool) {
+		toggled = append(toggled, id)
+	}
+
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 400))
+
+	lo := list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	item, ok := lo.searchVisible(lo.visible, 1)
+	assert.True(t, ok)
+	assert.NotNil(t, item.check)
+	assert.True(t, item.check.Visible())
+
+	test.Tap(item.check)
+	assert.True(t, list.ItemChecked(1))
+	assert.Equal(t, []ListItemID{1}, toggled)
+	assert.Equal(t, []ListItemID{1}, list.CheckedItems())
+
+	all, any := list.AllChecked()
+	assert.False(t, all)
+	assert.True(t, any)
+
+	list.SetAllChecked(true)
+	all, any = list.AllChecked()
+	assert.True(t, all)
+	assert.True(t, any)
+	assert.Len(t, list.CheckedItems(), 10)
+
+	list.SetAllChecked(false)
+	all, any = list.AllChecked()
+	assert.False(t, all)
+	assert.False(t, any)
+}
+
+func TestList_SeparatorStyling(t *testing.T) {
+	list := createList(10)
+	list.SeparatorColor = color.NRGBA{R: 0xFF, A: 0xFF}
+	list.SeparatorThickness = 3
+	list.SeparatorInset = 10
+
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 400))
+	list.Refresh()
+
+	lo := list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	assert.NotEmpty(t, lo.separators)
+	sep, ok := lo.separators[1].(*canvas.Rectangle)
+	assert.True(t, ok)
+	assert.Equal(t, color.NRGBA{R: 0xFF, A: 0xFF}, sep.FillColor)
+	assert.Equal(t, float32(3), sep.Size().Height)
+	assert.Equal(t, float32(10), sep.Position().X)
+	assert.Equal(t, list.Size().Width-20, sep.Size().Width)
+}
+
+func TestList_AlternateRowColor(t *testing.T) {
+	list := NewList(
+		func() int { return 10 },
+		func() fyne.CanvasObject { return NewLabel("Template Object") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(fmt.Sprintf("Item %d", id))
+		})
+	striped := color.NRGBA{R: 0, G: 0, B: 0, A: 0x11}
+	list.AlternateRowColor = striped
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 200))
+
+	lo := list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	even, ok := lo.searchVisible(lo.visible, 0)
+	assert.True(t, ok)
+	odd, ok := lo.searchVisible(lo.visible, 1)
+	assert.True(t, ok)
+
+	assert.False(t, even.background.Visible())
+	assert.True(t, odd.background.Visible())
+	assert.Equal(t, striped, odd.background.FillColor)
+
+	list.Select(1)
+	assert.Equal(t, theme.Color(theme.ColorNameSelection), odd.background.FillColor)
+	list.Unselect(1)
+	assert.Equal(t, striped, odd.background.FillColor)
+}
+
+func TestList_StyleItem(t *testing.T) {
+	bold := fyne.TextStyle{Bold: true}
+	highlight := color.NRGBA{R: 0xff, A: 0x22}
+	selection := color.NRGBA{G: 0xff, A: 0x66}
+	list := NewList(
+		func() int { return 10 },
+		func() fyne.CanvasObject { return NewLabel("Template Object") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(fmt.Sprintf("Item %d", id))
+		})
+	list.StyleItem = func(id ListItemID, item fyne.CanvasObject, style *ItemStyle) {
+		if id != 1 {
+			return
+		}
+		style.BackgroundColor = highlight
+		style.SelectionColor = selection
+		style.TextStyle = &bold
+	}
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 200))
+
+	lo := list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	plain, ok := lo.searchVisible(lo.visible, 0)
+	assert.True(t, ok)
+	styled, ok := lo.searchVisible(lo.visible, 1)
+	assert.True(t, ok)
+
+	assert.False(t, plain.background.Visible())
+	assert.False(t, plain.child.(*Label).TextStyle.Bold)
+	assert.True(t, styled.background.Visible())
+	assert.Equal(t, highlight, styled.background.FillColor)
+	assert.Equal(t, bold, styled.child.(*Label).TextStyle)
+
+	list.Select(1)
+	assert.Equal(t, selection, styled.background.FillColor)
+	list.Unselect(1)
+	assert.Equal(t, highlight, styled.background.FillColor)
+}
+
+func TestList_OnItemHovered(t *testing.T) {
+	list := createList(1000)
+	hovered := make(map[ListItemID]int)
+	unhovered := make(map[ListItemID]int)
+	list.OnItemHovered = func(id ListItemID) {
+		hovered[id]++
+	}
+	list.OnItemUnhovered = func(id ListItemID) {
+		unhovered[id]++
+	}
+
+	children := list.scroller.Content.(*fyne.Container).Objects
+	children[2].(*listItem).MouseIn(&desktop.MouseEvent{})
+	assert.Equal(t, 1, hovered[2])
+	assert.Empty(t, unhovered)
+	children[2].(*listItem).MouseOut()
+	assert.Equal(t, 1, unhovered[2])
+}
+
+type testDropZone struct {
+	BaseWidget
+
+	payload *fyne.DragPayload
+	pos     fyne.Position
+}
+
+func (z *testDropZone) CreateRenderer() fyne.WidgetRenderer {
+	return NewSimpleRenderer(NewLabel("Drop here"))
+}
+
+func (z *testDropZone) AcceptsDragPayload(p *fyne.DragPayload) bool {
+	return p.Text != "rejected"
+}
+
+func (z *testDropZone) DragPayloadDropped(pos fyne.Position, p *fyne.DragPayload) {
+	z.payload = p
+	z.pos = pos
+}
+
+func (z *testDropZone) DragEffect(_ fyne.Position, p *fyne.DragPayload) fyne.DragEffect {
+	if p.Data == "linked" {
+		return fyne.DragEffectLink
+	}
+	return fyne.DragEffectCopy
+}
+
+func TestList_DragDataForItem(t *testing.T) {
+	list := createList(1000)
+	list.DragDataForItem = func(id ListItemID) *fyne.DragPayload {
+		return &fyne.DragPayload{Text: fmt.Sprintf("Item %d", id), Data: id}
+	}
+	list.Refresh() // pick up the new drag callback on existing rows
+	zone := &testDropZone{}
+	zone.ExtendBaseWidget(zone)
+	content := &fyne.Container{Objects: []fyne.CanvasObject{list, zone}}
+	w := test.NewTempWindow(t, content)
+	w.Resize(fyne.NewSize(400, 300))
+	list.Resize(fyne.NewSize(200, 280))
+	zone.Move(fyne.NewPos(200, 0))
+	zone.Resize(fyne.NewSize(180, 280))
+
+	lo := list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	item, ok := lo.searchVisible(lo.visible, 1)
+	assert.True(t, ok)
+
+	target := fyne.CurrentApp().Driver().AbsolutePositionForObject(zone).Add(fyne.NewPos(50, 60))
+	item.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{AbsolutePosition: fyne.NewPos(100, 40)}, Dragged: fyne.Delta{DX: 10}})
+	item.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{AbsolutePosition: target}, Dragged: fyne.Delta{DX: 150}})
+	assert.Zero(t, item.swipeOffset, "drag-out rows should not swipe")
+	item.DragEnd()
+
+	if assert.NotNil(t, zone.payload) {
+		assert.Equal(t, "Item 1", zone.payload.Text)
+		assert.Equal(t, 1, zone.payload.Data)
+	}
+	assert.Less(t, zone.pos.X, zone.Size().Width)
+	assert.Less(t, zone.pos.Y, zone.Size().Height)
+}
+
+func TestList_DragPreviewAndEffect(t *testing.T) {
+	list := createList(1000)
+	list.DragDataForItem = func(id ListItemID) *fyne.DragPayload {
+		return &fyne.DragPayload{Text: fmt.Sprintf("Item %d", id)}
+	}
+	list.Refresh() // pick up the new drag callback on existing rows
+	zone := &testDropZone{}
+	zone.ExtendBaseWidget(zone)
+	content := &fyne.Container{Objects: []fyne.CanvasObject{list, zone}}
+	w := test.NewTempWindow(t, content)
+	w.Resize(fyne.NewSize(400, 300))
+	list.Resize(fyne.NewSize(200, 280))
+	zone.Move(fyne.NewPos(200, 0))
+	zone.Resize(fyne.NewSize(180, 280))
+
+	lo := list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	item, ok := lo.searchVisible(lo.visible, 1)
+	assert.True(t, ok)
+
+	// dragging over the list itself has no accepting target
+	item.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{AbsolutePosition: fyne.NewPos(100, 40)}, Dragged: fyne.Delta{DX: 10}})
+	if assert.NotNil(t, item.dragPreview, "a default preview should follow the drag") {
+		assert.Equal(t, item.dragPreview, w.Canvas().Overlays().Top())
+	}
+	assert.Equal(t, fyne.DragEffectNone, item.dragEffect)
+	assert.Equal(t, "×", item.dragBadge.Text)
+
+	// over the zone the badge reflects the effect the target reports
+	target := fyne.CurrentApp().Driver().AbsolutePositionForObject(zone).Add(fyne.NewPos(50, 60))
+	item.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{AbsolutePosition: target}, Dragged: fyne.Delta{DX: 150}})
+	assert.Equal(t, fyne.DragEffectCopy, item.dragEffect)
+	assert.Equal(t, "+", item.dragBadge.Text)
+
+	item.DragEnd()
+	assert.Nil(t, item.dragPreview, "the preview is removed when the drag ends")
+	assert.Nil(t, w.Canvas().Overlays().Top())
+	if assert.NotNil(t, zone.payload) {
+		assert.Equal(t, "Item 1", zone.payload.Text)
+	}
+
+	// a payload can carry its own preview object
+	custom := canvas.NewRectangle(color.Black)
+	custom.SetMinSize(fyne.NewSize(30, 20))
+	list.DragDataForItem = func(id ListItemID) *fyne.DragPayload {
+		return &fyne.DragPayload{Data: "linked", Preview: custom}
+	}
+	list.Refresh()
+	item, _ = lo.searchVisible(lo.visible, 1)
+	item.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{AbsolutePosition: target}, Dragged: fyne.Delta{DX: 150}})
+	assert.Equal(t, custom, item.dragPreview.Objects[0])
+	assert.Equal(t, "→", item.dragBadge.Text)
+	item.DragEnd()
+}
+
+func TestList_PagingEnabled(t *testing.T) {
+	list := createList(1000)
+	list.PagingEnabled = true
+	pitch := list.itemMin.Height + theme.Padding()
+
+	// a small flick forward should settle on the next item boundary
+	list.scroller.Offset.Y = pitch*2 + 10
+	list.offsetUpdated(list.scroller.Offset)
+	list.snapToPage()
+	assert.Equal(t, pitch*3, list.offsetY)
+
+	// scrolling backwards settles on the boundary of the item under the offset
+	list.scroller.Offset.Y = pitch*2 - 10
+	list.offsetUpdated(list.scroller.Offset)
+	list.snapToPage()
+	assert.Equal(t, pitch, list.offsetY)
+
+	// an offset already on a boundary is left alone
+	list.lastScrollDelta = 1
+	list.snapToPage()
+	assert.Equal(t, pitch, list.offsetY)
+}
+
+func TestList_UpdateBatch(t *testing.T) {
+	lengthCalls := 0
+	list := NewList(
+		func() int {
+			lengthCalls++
+			return 100
+		},
+		func() fyne.CanvasObject { return NewLabel("Template Object") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(fmt.Sprintf("Item %d", id))
+		})
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 200))
+
+	before := lengthCalls
+	list.UpdateBatch(func() {
+		for i := 0; i < 10; i++ {
+			list.Refresh()
+			list.RefreshItem(0)
+		}
+		assert.Equal(t, before, lengthCalls, "refreshes should be suppressed inside a batch")
+	})
+	assert.Greater(t, lengthCalls, before, "a single reconciliation should run at the end")
+
+	list.UpdateBatch(func() {
+		list.Select(5)
+		list.SetItemHeight(5, 80)
+	})
+	assert.Equal(t, []ListItemID{5}, list.selected)
+	assert.Equal(t, float32(80), list.itemHeights[5])
+}
+
+func TestList_RowOffsetCache(t *testing.T) {
+	list := createList(100)
+	list.SetItemHeight(10, 50)
+
+	pitch := list.itemMin.Height + theme.Padding()
+	offsets := list.ensureRowOffsets(100, theme.Padding())
+	assert.Len(t, offsets, 101)
+	assert.Equal(t, pitch*10, offsets[10])
+	assert.Equal(t, pitch*10+50+theme.Padding(), offsets[11])
+	assert.Equal(t, 10, virtualizer.RowAtOffset(offsets, pitch*10+25))
+
+	// the cache rebuilds after a height change
+	list.SetItemHeight(10, 80)
+	offsets = list.ensureRowOffsets(100, theme.Padding())
+	assert.Equal(t, pitch*10+80+theme.Padding(), offsets[11])
+
+	min := list.contentMinSize()
+	assert.Equal(t, offsets[100]-theme.Padding(), min.Height)
+}
+
+func TestList_Accessibility(t *testing.T) {
+	list := createList(100)
+
+	info := list.AccessibilityInfo()
+	assert.Equal(t, fyne.AccessibilityRoleList, info.Role)
+	assert.Equal(t, 100, info.Count)
+
+	list.Select(1)
+	lo := list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	item, ok := lo.searchVisible(lo.visible, 1)
+	assert.True(t, ok)
+
+	info = item.AccessibilityInfo()
+	assert.Equal(t, fyne.AccessibilityRoleListItem, info.Role)
+	assert.Equal(t, 2, info.Index)
+	assert.Equal(t, 100, info.Count)
+	assert.True(t, info.Selected)
+
+	item, ok = lo.searchVisible(lo.visible, 2)
+	assert.True(t, ok)
+	assert.False(t, item.AccessibilityInfo().Selected)
+
+	test.AssertAnnounced(t, "Item 6 of 100 selected", func() {
+		list.Select(5)
+	})
+}
+
+func TestList_Direction(t *testing.T) {
+	list := NewList(
+		func() int { return 10 },
+		func() fyne.CanvasObject { return NewLabel("Template Object") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(fmt.Sprintf("Test Item %d", id))
+		})
+	list.ShowCheckboxes = true
+	list.Direction = LayoutDirectionRightToLeft
+
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 400))
+
+	lo := list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	item, ok := lo.searchVisible(lo.visible, 1)
+	assert.True(t, ok)
+	assert.True(t, item.rtl)
+
+	// the checkbox mirrors to the trailing (right) edge and the content starts at zero
+	checkWidth := item.check.MinSize().Width
+	assert.Equal(t, item.Size().Width-checkWidth, item.check.Position().X)
+	assert.Equal(t, float32(0), item.child.Position().X)
+
+	// an explicit left-to-right override restores the default presentation
+	list.Direction = LayoutDirectionLeftToRight
+	list.Refresh()
+	item, ok = lo.searchVisible(lo.visible, 1)
+	assert.True(t, ok)
+	assert.False(t, item.rtl)
+	assert.Equal(t, float32(0), item.check.Position().X)
+	assert.Equal(t, checkWidth, item.child.Position().X)
+}
+
+func TestList_KeyForItem(t *testing.T) {
+	data := []string{"a", "b", "c", "d"}
+	updated := []string{}
+	list := NewList(
+		func() int { return len(data) },
+		func() fyne.CanvasObject { return NewLabel("Template Object") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			updated = append(updated, data[id])
+			item.(*Label).SetText(data[id])
+		})
+	list.KeyForItem = func(id ListItemID) string { return data[id] }
+
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 400))
+
+	lo := list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	itemB, ok := lo.searchVisible(lo.visible, 1)
+	assert.True(t, ok)
+	assert.Equal(t, "b", itemB.child.(*Label).Text)
+
+	// inserting an item only binds the new row, the existing rows move with their key
+	updated = nil
+	data = []string{"x", "a", "b", "c", "d"}
+	list.Refresh()
+	assert.Equal(t, []string{"x"}, updated)
+
+	moved, ok := lo.searchVisible(lo.visible, 2)
+	assert.True(t, ok)
+	assert.Same(t, itemB, moved, "the pooled item should move with its key")
+	assert.Equal(t, "b", moved.child.(*Label).Text)
+
+	// a forced refresh rebinds even though the key still matches
+	updated = nil
+	list.RefreshItem(2)
+	assert.Equal(t, []string{"b"}, updated)
+}
+
+func TestList_KeyForItem_PreservesSelectionAndScroll(t *testing.T) {
+	data := make([]string, 30)
+	for i := range data {
+		data[i] = fmt.Sprintf("item %d", i)
+	}
+	list := NewList(
+		func() int { return len(data) },
+		func() fyne.CanvasObject { return NewLabel("Template Object") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(data[id])
+		})
+	list.KeyForItem = func(id ListItemID) string { return data[id] }
+
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 200))
+
+	list.Select(10)
+	list.FocusItem(10)
+	offset := list.GetScrollOffset()
+	assert.Greater(t, offset, float32(0))
+
+	// insert three items above the viewport, the same data stays selected and in view
+	data = append([]string{"x", "y", "z"}, data...)
+	list.Refresh()
+
+	padded := list.itemMin.Height + list.Theme().Size(theme.SizeNamePadding)
+	assert.Equal(t, []ListItemID{13}, list.selected)
+	assert.Equal(t, 13, list.currentFocus)
+	assert.Equal(t, offset+3*padded, list.GetScrollOffset())
+
+	// removing the selected item drops it from the selection
+	data = append(data[:13:13], data[14:]...)
+	list.Refresh()
+	assert.Empty(t, list.selected)
+}
+
+func TestList_ThemeInvalidated(t *testing.T) {
+	list := createList(10)
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 400))
+
+	// build the row offsets from a stale item height, as left behind by a
+	// theme size or scale change
+	padding := list.Theme().Size(theme.SizeNamePadding)
+	list.itemMin.Height += 10
+	list.invalidateRowOffsets()
+	list.ensureRowOffsets(10, padding)
+
+	list.BaseWidget.Refresh()
+	offsets := list.ensureRowOffsets(10, padding)
+	assert.Equal(t, list.itemMin.Height+padding, offsets[1], "refresh should rebuild the row offsets")
+}
+
+func TestList_OnItemScrollProgress(t *testing.T) {
+	list := createList(100)
+	progress := make(map[ListItemID]float32)
+	list.OnItemScrollProgress = func(id ListItemID, p float32) {
+		progress[id] = p
+	}
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 200))
+
+	list.ScrollToOffset(50)
+	assert.NotEmpty(t, progress)
+	for id, p := range progress {
+		assert.GreaterOrEqual(t, p, float32(0), "row %d", id)
+		assert.LessOrEqual(t, p, float32(1), "row %d", id)
+	}
+	assert.Greater(t, progress[0], progress[2], "rows closer to the top have travelled further")
+}
+
+func TestList_EntranceAnimation(t *testing.T) {
+	list := NewList(
+		func() int { return 100 },
+		func() fyne.CanvasObject { return NewLabel("placeholder") },
+		func(id ListItemID, item fyne.CanvasObject) {
+			item.(*Label).SetText(fmt.Sprintf("Item %d", id))
+		})
+	list.Entrance = ListEntranceSlide // set before the rows are first laid out
+	w := test.NewTempWindow(t, list)
+	w.Resize(fyne.NewSize(200, 200))
+
+	// the test driver completes animations immediately, so drive frames by hand
+	lo := list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	item := lo.visible[0].item
+	assert.Greater(t, item.entranceDistance, float32(0), "the row was armed for a slide entrance")
+	assert.Equal(t, float32(0), item.entranceOffset, "the completed animation restored the resting state")
+
+	item.setEntranceProgress(0)
+	assert.Equal(t, item.entranceDistance, item.entranceOffset)
+	item.setEntranceProgress(1)
+	assert.Equal(t, float32(0), item.entranceOffset)
+
+	list.Entrance = ListEntranceFade
+	list.ScrollToBottom()
+	item, ok := lo.searchVisible(lo.visible, 99)
+	assert.True(t, ok)
+	assert.NotNil(t, item.entranceVeil)
+	item.setEntranceProgress(0.5)
+	assert.True(t, item.entranceVeil.Visible())
+	item.setEntranceProgress(1)
+	assert.False(t, item.entranceVeil.Visible())
+}
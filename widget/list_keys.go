@@ -0,0 +1,99 @@
+package widget
+
+import "fyne.io/fyne/v2/theme"
+
+// captureItemKeys records the keys of the selected, focused and first visible
+// items at the end of each render, while the item ids still match the data they
+// were captured against. remapItemKeys consumes the snapshot on the next refresh.
+func (l *List) captureItemKeys(anchor ListItemID, anchorOffset float32) {
+	kf := l.KeyForItem
+	if kf == nil {
+		return
+	}
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+
+	keys := make(map[ListItemID]string, len(l.selected)+2)
+	record := func(id ListItemID) {
+		if id >= 0 && id < length {
+			keys[id] = kf(id)
+		}
+	}
+	for _, id := range l.selected {
+		record(id)
+	}
+	record(l.currentFocus)
+	record(anchor)
+
+	l.itemKeys = keys
+	l.anchorRow = anchor
+	l.anchorOffset = anchorOffset
+}
+
+// remapItemKeys moves the selection, keyboard focus and scroll position to the
+// new ids of the items they were captured against, matched by KeyForItem, so
+// that inserting or removing items above the viewport keeps the same data
+// selected and in view instead of whatever now occupies the old row numbers.
+func (l *List) remapItemKeys() {
+	kf := l.KeyForItem
+	if kf == nil || len(l.itemKeys) == 0 {
+		return
+	}
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+
+	moved := false
+	for id, key := range l.itemKeys {
+		if id >= length || kf(id) != key {
+			moved = true
+			break
+		}
+	}
+	if !moved { // every tracked item is still where it was, the common case
+		return
+	}
+
+	ids := make(map[string]ListItemID, length)
+	for id := 0; id < length; id++ {
+		ids[kf(id)] = id
+	}
+	// relocate returns the new id of the item that was at id when the keys were
+	// captured, reporting false when its key has left the data set. Ids without
+	// a captured key, such as a selection made since the last render, are kept.
+	relocate := func(id ListItemID) (ListItemID, bool) {
+		key, captured := l.itemKeys[id]
+		if !captured || (id < length && kf(id) == key) {
+			return id, true
+		}
+		newID, ok := ids[key]
+		return newID, ok
+	}
+
+	selected := l.selected[:0]
+	for _, id := range l.selected {
+		if newID, ok := relocate(id); ok {
+			selected = append(selected, newID)
+		}
+	}
+	l.selected = selected
+	if newID, ok := relocate(l.currentFocus); ok {
+		l.currentFocus = newID
+	}
+
+	// at the very top the viewport stays put so that new items are revealed,
+	// anywhere else the anchor item keeps its place on screen
+	anchor := l.anchorRow
+	if newAnchor, ok := relocate(l.anchorRow); ok && l.offsetY > 0 {
+		anchor = newAnchor
+	}
+	if l.scroller != nil && anchor != l.anchorRow {
+		offsets := l.ensureRowOffsets(length, l.Theme().Size(theme.SizeNamePadding))
+		l.scroller.Offset.Y = offsets[anchor] + l.anchorOffset
+		l.offsetUpdated(l.scroller.Offset)
+	}
+	l.captureItemKeys(anchor, l.anchorOffset)
+}
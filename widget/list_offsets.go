@@ -0,0 +1,43 @@
+package widget
+
+// ensureRowOffsets returns cumulative row offsets for a list with custom item
+// heights or expanded rows, rebuilding the cached prefix array if it has been
+// invalidated. Entry i holds the content offset of row i and the final entry the
+// total content height including the trailing separator, so offset lookups during
+// scrolling are O(1) instead of O(n) per frame.
+func (l *List) ensureRowOffsets(length int, padding float32) []float32 {
+	if l.offsetsValid && len(l.rowOffsets) == length+1 && l.offsetsPadding == padding {
+		return l.rowOffsets
+	}
+
+	offsets := l.rowOffsets
+	if cap(offsets) < length+1 {
+		offsets = make([]float32, 0, length+1)
+	}
+	offsets = append(offsets[:0], 0)
+
+	y := float32(0)
+	detail := l.detailHeight()
+	for i := 0; i < length; i++ {
+		height := l.itemMin.Height
+		if h, ok := l.itemHeights[i]; ok {
+			height = h
+		}
+		if l.expanded[i] {
+			height += detail
+		}
+
+		y += height + padding
+		offsets = append(offsets, y)
+	}
+
+	l.rowOffsets = offsets
+	l.offsetsValid = true
+	l.offsetsPadding = padding
+	return offsets
+}
+
+// invalidateRowOffsets drops the cached row offsets after a height affecting change.
+func (l *List) invalidateRowOffsets() {
+	l.offsetsValid = false
+}
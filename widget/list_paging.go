@@ -0,0 +1,65 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// snapToPage animates the scroll offset to an item boundary when PagingEnabled is
+// set, called as a drag scroll gesture on the list is released.
+func (l *List) snapToPage() {
+	if !l.PagingEnabled || l.scroller == nil {
+		return
+	}
+
+	target := l.pageOffset()
+	start := l.offsetY
+	if target == start {
+		return
+	}
+
+	if l.pagingAnim != nil {
+		l.pagingAnim.Stop()
+	}
+	l.pagingAnim = fyne.NewAnimation(canvas.DurationShort, func(f float32) {
+		l.scroller.Offset.Y = start + (target-start)*f
+		l.offsetUpdated(l.scroller.Offset)
+		l.scroller.Refresh()
+	})
+	l.pagingAnim.Curve = fyne.AnimationEaseOut
+	l.pagingAnim.Start()
+}
+
+// pageOffset returns the offset of the item boundary to snap to, using the
+// direction of the last scroll movement to pick the target so that even a small
+// flick advances to the next item.
+func (l *List) pageOffset() float32 {
+	separatorThickness := l.Theme().Size(theme.SizeNamePadding)
+	offset := l.offsetY
+
+	y := float32(0)
+	length := 0
+	if f := l.Length; f != nil {
+		length = f()
+	}
+	for id := 0; id < length; id++ {
+		height := l.itemMin.Height
+		if h, ok := l.itemHeights[id]; ok {
+			height = h
+		}
+		if l.expanded[id] {
+			height += l.detailHeight()
+		}
+
+		next := y + height + separatorThickness
+		if offset < next {
+			if l.lastScrollDelta > 0 && offset > y {
+				return next // finish the scroll towards the next item
+			}
+			return y
+		}
+		y = next
+	}
+	return y
+}
@@ -0,0 +1,265 @@
+package widget
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/theme"
+)
+
+// ListAction describes a single swipe-to-reveal action attached to a list row.
+// Actions are revealed when the user drags a row horizontally, see List.SetSwipeActions.
+//
+// Since: 2.6
+type ListAction struct {
+	Icon        fyne.Resource
+	Color       color.Color
+	OnActivated func(id ListItemID) `json:"-"`
+}
+
+// SetSwipeActions specifies actions that are revealed when a list row is dragged horizontally.
+// The leading actions appear at the start edge when the row is swiped towards the end,
+// the trailing actions at the end edge when it is swiped towards the start.
+// Rows translate under the pointer and snap open or closed with an animation when released.
+// Passing nil for both slices removes any previously set actions.
+//
+// Since: 2.6
+func (l *List) SetSwipeActions(leading, trailing []ListAction) {
+	l.leadingActions = leading
+	l.trailingActions = trailing
+	l.Refresh()
+}
+
+// Declare conformity with interfaces.
+var _ fyne.Widget = (*listSwipeAction)(nil)
+var _ fyne.Tappable = (*listSwipeAction)(nil)
+
+// listSwipeAction displays a single ListAction as a colored block with a centered icon.
+type listSwipeAction struct {
+	BaseWidget
+
+	action   ListAction
+	onTapped func()
+}
+
+func newListSwipeAction(action ListAction, tapped func()) *listSwipeAction {
+	a := &listSwipeAction{action: action, onTapped: tapped}
+	a.ExtendBaseWidget(a)
+	return a
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (a *listSwipeAction) CreateRenderer() fyne.WidgetRenderer {
+	background := canvas.NewRectangle(a.action.Color)
+	icon := canvas.NewImageFromResource(a.action.Icon)
+	icon.FillMode = canvas.ImageFillContain
+
+	objects := []fyne.CanvasObject{background, icon}
+	return &listSwipeActionRenderer{widget.NewBaseRenderer(objects), a, background, icon}
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (a *listSwipeAction) MinSize() fyne.Size {
+	th := a.Theme()
+	pad := th.Size(theme.SizeNamePadding)
+	iconSize := th.Size(theme.SizeNameInlineIcon)
+	return fyne.NewSize(iconSize+pad*4, iconSize+pad*2)
+}
+
+// Tapped is called when a pointer tapped event is captured and triggers any tap handler.
+func (a *listSwipeAction) Tapped(*fyne.PointEvent) {
+	if a.onTapped != nil {
+		a.onTapped()
+	}
+}
+
+// Declare conformity with the WidgetRenderer interface.
+var _ fyne.WidgetRenderer = (*listSwipeActionRenderer)(nil)
+
+type listSwipeActionRenderer struct {
+	widget.BaseRenderer
+
+	action     *listSwipeAction
+	background *canvas.Rectangle
+	icon       *canvas.Image
+}
+
+func (r *listSwipeActionRenderer) Layout(size fyne.Size) {
+	r.background.Resize(size)
+	iconSize := r.action.Theme().Size(theme.SizeNameInlineIcon)
+	r.icon.Resize(fyne.NewSize(iconSize, iconSize))
+	r.icon.Move(fyne.NewPos((size.Width-iconSize)/2, (size.Height-iconSize)/2))
+}
+
+func (r *listSwipeActionRenderer) MinSize() fyne.Size {
+	return r.action.MinSize()
+}
+
+func (r *listSwipeActionRenderer) Refresh() {
+	r.background.FillColor = r.action.action.Color
+	r.background.Refresh()
+	r.icon.Resource = r.action.action.Icon
+	r.icon.Refresh()
+}
+
+// setActions rebuilds the swipe action widgets shown behind this row, closing any open swipe.
+func (li *listItem) setActions(leading, trailing []ListAction, id ListItemID) {
+	if li.swipeOffset != 0 {
+		li.swipeOffset = 0
+	}
+	if len(leading) == 0 && len(trailing) == 0 && len(li.leadingActions) == 0 && len(li.trailingActions) == 0 {
+		return
+	}
+
+	li.leadingActions = make([]*listSwipeAction, 0, len(leading))
+	for _, action := range leading {
+		li.leadingActions = append(li.leadingActions, li.newActionWidget(action, id))
+	}
+	li.trailingActions = make([]*listSwipeAction, 0, len(trailing))
+	for _, action := range trailing {
+		li.trailingActions = append(li.trailingActions, li.newActionWidget(action, id))
+	}
+	li.Refresh()
+}
+
+func (li *listItem) newActionWidget(action ListAction, id ListItemID) *listSwipeAction {
+	onActivated := action.OnActivated
+	return newListSwipeAction(action, func() {
+		li.snapSwipe(0)
+		if onActivated != nil {
+			onActivated(id)
+		}
+	})
+}
+
+func (li *listItem) leadingActionWidth() float32 {
+	width := float32(0)
+	for _, a := range li.leadingActions {
+		width += a.MinSize().Width
+	}
+	return width
+}
+
+func (li *listItem) trailingActionWidth() float32 {
+	width := float32(0)
+	for _, a := range li.trailingActions {
+		width += a.MinSize().Width
+	}
+	return width
+}
+
+// Dragged is called when the pointer drags across this row, translating it to reveal actions.
+//
+// Implements: fyne.Draggable
+func (li *listItem) Dragged(e *fyne.DragEvent) {
+	if li.dragData != nil {
+		li.dragOut(e)
+		return
+	}
+	if len(li.leadingActions) == 0 && len(li.trailingActions) == 0 {
+		return
+	}
+	if li.swipeAnim != nil {
+		li.swipeAnim.Stop()
+		li.swipeAnim = nil
+	}
+
+	dx := e.Dragged.DX
+	if li.rtl {
+		dx = -dx
+	}
+	offset := li.swipeOffset + dx
+	offset = fyne.Max(offset, -li.trailingActionWidth())
+	offset = fyne.Min(offset, li.leadingActionWidth())
+	if offset == li.swipeOffset {
+		return
+	}
+	li.swipeOffset = offset
+	li.Refresh()
+}
+
+// DragEnd is called when the drag on this row completes, snapping the swipe open or closed.
+//
+// Implements: fyne.Draggable
+func (li *listItem) DragEnd() {
+	if li.dragPayload != nil {
+		li.dropPayload()
+		return
+	}
+	if li.swipeOffset == 0 {
+		return
+	}
+
+	target := float32(0)
+	if leading := li.leadingActionWidth(); li.swipeOffset > leading/2 {
+		target = leading
+	} else if trailing := li.trailingActionWidth(); li.swipeOffset < -trailing/2 {
+		target = -trailing
+	}
+	li.snapSwipe(target)
+}
+
+func (li *listItem) snapSwipe(target float32) {
+	if li.swipeAnim != nil {
+		li.swipeAnim.Stop()
+	}
+	start := li.swipeOffset
+	if start == target {
+		return
+	}
+
+	li.swipeAnim = fyne.NewAnimation(canvas.DurationShort, func(f float32) {
+		li.swipeOffset = start + (target-start)*f
+		li.Refresh()
+	})
+	li.swipeAnim.Curve = fyne.AnimationEaseOut
+	li.swipeAnim.Start()
+}
+
+// layoutActions positions the action widgets at the row edges and shows only the
+// revealed side. In a right-to-left presentation the leading edge is the right one.
+func (li *listItem) layoutActions(size fyne.Size) {
+	x := float32(0)
+	if li.rtl {
+		x = size.Width
+	}
+	for _, a := range li.leadingActions {
+		w := a.MinSize().Width
+		a.Resize(fyne.NewSize(w, size.Height))
+		if li.rtl {
+			x -= w
+			a.Move(fyne.NewPos(x, 0))
+		} else {
+			a.Move(fyne.NewPos(x, 0))
+			x += w
+		}
+		if li.swipeOffset > 0 {
+			a.Show()
+		} else {
+			a.Hide()
+		}
+	}
+
+	x = size.Width
+	if li.rtl {
+		x = 0
+	}
+	for _, a := range li.trailingActions {
+		w := a.MinSize().Width
+		a.Resize(fyne.NewSize(w, size.Height))
+		if li.rtl {
+			a.Move(fyne.NewPos(x, 0))
+			x += w
+		} else {
+			x -= w
+			a.Move(fyne.NewPos(x, 0))
+		}
+		if li.swipeOffset < 0 {
+			a.Show()
+		} else {
+			a.Hide()
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_SetSwipeActions(t *testing.T) {
+	list := createList(10)
+	activated := -1
+	list.SetSwipeActions([]ListAction{
+		{Icon: theme.DeleteIcon(), Color: color.NRGBA{R: 0xff, A: 0xff}, OnActivated: func(id ListItemID) { activated = id }},
+	}, nil)
+
+	w := test.NewWindow(list)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 200))
+
+	item := list.scroller.Content.(*fyne.Container).Layout.(*listLayout).children[0].(*listItem)
+	assert.Len(t, item.leadingActions, 1)
+	assert.Empty(t, item.trailingActions)
+
+	item.Dragged(&fyne.DragEvent{Dragged: fyne.Delta{DX: 500}})
+	assert.Equal(t, item.leadingActionWidth(), item.swipeOffset)
+
+	item.leadingActions[0].Tapped(nil)
+	assert.Equal(t, 0, activated)
+}
+
+func TestList_SwipeSnapClosed(t *testing.T) {
+	list := createList(10)
+	list.SetSwipeActions(nil, []ListAction{
+		{Icon: theme.DeleteIcon(), Color: color.NRGBA{R: 0xff, A: 0xff}},
+	})
+
+	w := test.NewWindow(list)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 200))
+
+	item := list.scroller.Content.(*fyne.Container).Layout.(*listLayout).children[0].(*listItem)
+	item.Dragged(&fyne.DragEvent{Dragged: fyne.Delta{DX: -2}})
+	assert.Equal(t, float32(-2), item.swipeOffset)
+	assert.True(t, item.swipeOffset > -item.trailingActionWidth()/2)
+
+	item.DragEnd() // not dragged past half way so the row animates closed
+	assert.NotNil(t, item.swipeAnim)
+}
@@ -0,0 +1,380 @@
+package widget
+
+import (
+	"image/color"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+const logViewDefaultMaxLines = 100000
+
+// Declare conformity with the widget interface.
+var _ fyne.Widget = (*LogView)(nil)
+
+// LogView is a widget for displaying a large stream of appended log lines.
+// Lines are held in a fixed capacity ring buffer and rendered through a
+// virtualized list, so appending millions of lines stays cheap. ANSI SGR color
+// sequences in the input are rendered as colored text, a follow-tail mode keeps
+// the newest lines in view until the user scrolls away from the bottom, and
+// matches of a search term can be highlighted with SetSearch.
+//
+// Since: 2.6
+type LogView struct {
+	BaseWidget
+
+	// MaxLines caps how many lines are retained, the oldest are dropped beyond
+	// this. Changing it only affects lines appended afterwards.
+	MaxLines int
+
+	// OnFollowChanged is called when follow-tail mode is enabled or disabled,
+	// including when following pauses because the user scrolled up.
+	OnFollowChanged func(following bool) `json:"-"`
+
+	list   *List
+	buf    []string
+	head   int
+	count  int
+	follow bool
+	search string
+}
+
+// NewLogView creates an empty log viewer with follow-tail enabled.
+//
+// Since: 2.6
+func NewLogView() *LogView {
+	l := &LogView{MaxLines: logViewDefaultMaxLines, follow: true}
+	l.ExtendBaseWidget(l)
+
+	l.list = NewList(
+		l.Length,
+		func() fyne.CanvasObject {
+			return newLogLine(l)
+		},
+		func(id ListItemID, item fyne.CanvasObject) {
+			line := item.(*logLine)
+			line.segments = parseANSI(l.Line(id))
+			line.Refresh()
+		})
+	l.list.HideSeparators = true
+	return l
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (l *LogView) CreateRenderer() fyne.WidgetRenderer {
+	l.ExtendBaseWidget(l)
+	return NewSimpleRenderer(l.list)
+}
+
+// Length returns the number of lines currently retained.
+func (l *LogView) Length() int {
+	return l.count
+}
+
+// Line returns the text of the given retained line, oldest first, with any ANSI
+// escape sequences intact.
+func (l *LogView) Line(i int) string {
+	if i < 0 || i >= l.count {
+		return ""
+	}
+	return l.buf[(l.head+i)%len(l.buf)]
+}
+
+// Append adds a single line to the end of the log.
+func (l *LogView) Append(line string) {
+	l.AppendLines([]string{line})
+}
+
+// AppendLines adds many lines to the end of the log with a single refresh.
+func (l *LogView) AppendLines(lines []string) {
+	max := l.MaxLines
+	if max <= 0 {
+		max = logViewDefaultMaxLines
+	}
+	if len(l.buf) != max {
+		l.resize(max)
+	}
+
+	wasAtTail := l.atTail()
+	for _, line := range lines {
+		if l.count == len(l.buf) {
+			l.buf[l.head] = line
+			l.head = (l.head + 1) % len(l.buf)
+		} else {
+			l.buf[(l.head+l.count)%len(l.buf)] = line
+			l.count++
+		}
+	}
+
+	if l.follow && !wasAtTail {
+		l.SetFollow(false) // the user scrolled away from the bottom
+	}
+	l.list.Refresh()
+	if l.follow {
+		l.list.ScrollToBottom()
+	}
+}
+
+// Clear removes all retained lines.
+func (l *LogView) Clear() {
+	l.head, l.count = 0, 0
+	l.list.Refresh()
+}
+
+// Following returns whether the view is keeping the newest lines visible.
+func (l *LogView) Following() bool {
+	return l.follow
+}
+
+// SetFollow enables or disables follow-tail mode, scrolling to the end when enabled.
+func (l *LogView) SetFollow(follow bool) {
+	if l.follow == follow {
+		return
+	}
+
+	l.follow = follow
+	if f := l.OnFollowChanged; f != nil {
+		f(follow)
+	}
+	if follow {
+		l.list.ScrollToBottom()
+	}
+}
+
+// SetSearch highlights all occurrences of the given term in the log, pass an
+// empty string to clear the highlight.
+func (l *LogView) SetSearch(term string) {
+	if l.search == term {
+		return
+	}
+
+	l.search = term
+	l.list.Refresh()
+}
+
+// resize re-homes the ring buffer into a buffer of the new capacity, keeping the
+// newest lines.
+func (l *LogView) resize(max int) {
+	buf := make([]string, max)
+	start := 0
+	if l.count > max {
+		start = l.count - max
+	}
+	n := 0
+	for i := start; i < l.count; i++ {
+		buf[n] = l.Line(i)
+		n++
+	}
+	l.buf, l.head, l.count = buf, 0, n
+}
+
+// atTail returns whether the view is currently scrolled to (or near) the newest line.
+func (l *LogView) atTail() bool {
+	if l.list.scroller == nil {
+		return true
+	}
+
+	bottom := l.list.scroller.Content.MinSize().Height - l.list.scroller.Size().Height
+	if bottom <= 0 {
+		return true // everything fits in the viewport
+	}
+	return l.list.offsetY >= bottom-l.list.itemMin.Height
+}
+
+// ansiSegment is a run of log text with a single style.
+type ansiSegment struct {
+	text string
+	col  color.Color // nil for the theme foreground
+	bold bool
+}
+
+// ansiPalette holds the standard and bright ANSI terminal colors.
+var ansiPalette = [16]color.Color{
+	color.NRGBA{R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+	color.NRGBA{R: 0xcd, G: 0x3c, B: 0x3c, A: 0xff},
+	color.NRGBA{R: 0x3c, G: 0xcd, B: 0x3c, A: 0xff},
+	color.NRGBA{R: 0xcd, G: 0xcd, B: 0x3c, A: 0xff},
+	color.NRGBA{R: 0x3c, G: 0x6c, B: 0xcd, A: 0xff},
+	color.NRGBA{R: 0xcd, G: 0x3c, B: 0xcd, A: 0xff},
+	color.NRGBA{R: 0x3c, G: 0xcd, B: 0xcd, A: 0xff},
+	color.NRGBA{R: 0xe5, G: 0xe5, B: 0xe5, A: 0xff},
+	color.NRGBA{R: 0x7f, G: 0x7f, B: 0x7f, A: 0xff},
+	color.NRGBA{R: 0xff, G: 0x5c, B: 0x5c, A: 0xff},
+	color.NRGBA{R: 0x5c, G: 0xff, B: 0x5c, A: 0xff},
+	color.NRGBA{R: 0xff, G: 0xff, B: 0x5c, A: 0xff},
+	color.NRGBA{R: 0x5c, G: 0x8c, B: 0xff, A: 0xff},
+	color.NRGBA{R: 0xff, G: 0x5c, B: 0xff, A: 0xff},
+	color.NRGBA{R: 0x5c, G: 0xff, B: 0xff, A: 0xff},
+	color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+}
+
+// parseANSI splits a log line into styled segments, interpreting the common SGR
+// color and bold escape sequences and stripping any other escapes.
+func parseANSI(line string) []ansiSegment {
+	segments := []ansiSegment{}
+	current := ansiSegment{}
+	flush := func() {
+		if current.text != "" {
+			segments = append(segments, current)
+			current.text = ""
+		}
+	}
+
+	for len(line) > 0 {
+		esc := strings.Index(line, "\x1b[")
+		if esc == -1 {
+			current.text += line
+			break
+		}
+		current.text += line[:esc]
+		line = line[esc+2:]
+
+		end := strings.IndexFunc(line, func(r rune) bool {
+			return (r < '0' || r > '9') && r != ';'
+		})
+		if end == -1 {
+			break
+		}
+		params, terminator := line[:end], line[end]
+		line = line[end+1:]
+		if terminator != 'm' {
+			continue // not an SGR sequence, drop it
+		}
+
+		flush()
+		if params == "" {
+			params = "0"
+		}
+		for _, param := range strings.Split(params, ";") {
+			code, err := strconv.Atoi(param)
+			if err != nil {
+				continue
+			}
+			switch {
+			case code == 0:
+				current.col, current.bold = nil, false
+			case code == 1:
+				current.bold = true
+			case code >= 30 && code <= 37:
+				current.col = ansiPalette[code-30]
+			case code == 39:
+				current.col = nil
+			case code >= 90 && code <= 97:
+				current.col = ansiPalette[code-90+8]
+			}
+		}
+	}
+
+	flush()
+	return segments
+}
+
+// Declare conformity with the widget interface.
+var _ fyne.Widget = (*logLine)(nil)
+
+// logLine renders one log line as a row of colored monospace text runs with
+// optional search match highlighting.
+type logLine struct {
+	BaseWidget
+
+	view     *LogView
+	segments []ansiSegment
+}
+
+func newLogLine(view *LogView) *logLine {
+	l := &logLine{view: view}
+	l.ExtendBaseWidget(l)
+	return l
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (l *logLine) CreateRenderer() fyne.WidgetRenderer {
+	return &logLineRenderer{line: l}
+}
+
+// Declare conformity with the WidgetRenderer interface.
+var _ fyne.WidgetRenderer = (*logLineRenderer)(nil)
+
+type logLineRenderer struct {
+	line    *logLine
+	objects []fyne.CanvasObject
+	min     fyne.Size
+}
+
+func (r *logLineRenderer) Destroy() {
+}
+
+func (r *logLineRenderer) Layout(fyne.Size) {
+}
+
+func (r *logLineRenderer) MinSize() fyne.Size {
+	if r.min.IsZero() {
+		r.Refresh()
+	}
+	return r.min
+}
+
+func (r *logLineRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *logLineRenderer) Refresh() {
+	th := r.line.view.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	textSize := th.Size(theme.SizeNameText)
+	pad := th.Size(theme.SizeNamePadding)
+	search := r.line.view.search
+
+	r.objects = r.objects[:0]
+	x := pad
+	height := float32(0)
+	run := func(text string, col color.Color, bold, match bool) {
+		if text == "" {
+			return
+		}
+		style := fyne.TextStyle{Monospace: true, Bold: bold}
+		size := fyne.MeasureText(text, textSize, style)
+		if match {
+			rect := canvas.NewRectangle(th.Color(theme.ColorNamePrimary, v))
+			rect.Move(fyne.NewPos(x, pad/2))
+			rect.Resize(size)
+			r.objects = append(r.objects, rect)
+		}
+
+		if col == nil {
+			col = th.Color(theme.ColorNameForeground, v)
+		}
+		t := canvas.NewText(text, col)
+		t.TextSize = textSize
+		t.TextStyle = style
+		t.Move(fyne.NewPos(x, pad/2))
+		r.objects = append(r.objects, t)
+		x += size.Width
+		height = fyne.Max(height, size.Height)
+	}
+
+	for _, seg := range r.line.segments {
+		text := seg.text
+		if search != "" {
+			for {
+				at := strings.Index(text, search)
+				if at == -1 {
+					break
+				}
+				run(text[:at], seg.col, seg.bold, false)
+				run(text[at:at+len(search)], seg.col, seg.bold, true)
+				text = text[at+len(search):]
+			}
+		}
+		run(text, seg.col, seg.bold, false)
+	}
+
+	if height == 0 {
+		height = fyne.MeasureText(" ", textSize, fyne.TextStyle{Monospace: true}).Height
+	}
+	r.min = fyne.NewSize(x+pad, height+pad)
+	canvas.Refresh(r.line.super())
+}
@@ -0,0 +1,111 @@
+package widget
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/cache"
+	"fyne.io/fyne/v2/test"
+)
+
+func TestLogView_RingBuffer(t *testing.T) {
+	log := NewLogView()
+	log.MaxLines = 5
+	test.NewTempWindow(t, log)
+
+	for i := 0; i < 8; i++ {
+		log.Append(fmt.Sprintf("line %d", i))
+	}
+
+	assert.Equal(t, 5, log.Length())
+	assert.Equal(t, "line 3", log.Line(0))
+	assert.Equal(t, "line 7", log.Line(4))
+	assert.Equal(t, "", log.Line(5))
+
+	log.Clear()
+	assert.Zero(t, log.Length())
+}
+
+func TestLogView_FollowPausesOnScroll(t *testing.T) {
+	log := NewLogView()
+	following := true
+	log.OnFollowChanged = func(f bool) {
+		following = f
+	}
+	w := test.NewTempWindow(t, log)
+	w.Resize(fyne.NewSize(200, 100))
+
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i)
+	}
+	log.AppendLines(lines)
+	assert.True(t, log.Following())
+	assert.Greater(t, log.list.offsetY, float32(0), "follow mode should scroll to the tail")
+
+	log.list.ScrollToTop()
+	log.Append("another")
+	assert.False(t, log.Following())
+	assert.False(t, following)
+	assert.Zero(t, log.list.offsetY, "paused follow should not scroll")
+
+	log.SetFollow(true)
+	assert.Greater(t, log.list.offsetY, float32(0))
+}
+
+func TestParseANSI(t *testing.T) {
+	segments := parseANSI("plain \x1b[31mred\x1b[0m \x1b[1;32mbold green\x1b[m end")
+	assert.Equal(t, []ansiSegment{
+		{text: "plain "},
+		{text: "red", col: ansiPalette[1]},
+		{text: " "},
+		{text: "bold green", col: ansiPalette[2], bold: true},
+		{text: " end"},
+	}, segments)
+
+	// unknown escapes are stripped, bright colors use the second palette half
+	segments = parseANSI("\x1b[2Kcleared \x1b[91mbright\x1b[39m done")
+	assert.Equal(t, []ansiSegment{
+		{text: "cleared "},
+		{text: "bright", col: ansiPalette[9]},
+		{text: " done"},
+	}, segments)
+}
+
+func TestLogView_SearchHighlight(t *testing.T) {
+	log := NewLogView()
+	w := test.NewTempWindow(t, log)
+	w.Resize(fyne.NewSize(200, 100))
+	log.Append("find the needle here")
+	log.SetSearch("needle")
+
+	lo := log.list.scroller.Content.(*fyne.Container).Layout.(*listLayout)
+	item, ok := lo.searchVisible(lo.visible, 0)
+	assert.True(t, ok)
+
+	line := item.child.(*logLine)
+	rects, texts := 0, 0
+	for _, o := range cache.Renderer(line).Objects() {
+		switch o.(type) {
+		case *canvas.Rectangle:
+			rects++
+		case *canvas.Text:
+			texts++
+		}
+	}
+	assert.Equal(t, 1, rects, "one highlight behind the match")
+	assert.Equal(t, 3, texts, "text split around the match")
+
+	log.SetSearch("")
+	rects = 0
+	for _, o := range cache.Renderer(line).Objects() {
+		if _, isRect := o.(*canvas.Rectangle); isRect {
+			rects++
+		}
+	}
+	assert.Zero(t, rects)
+}
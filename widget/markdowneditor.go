@@ -0,0 +1,217 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/theme"
+)
+
+// MarkdownEditor is a widget for writing markdown source with a live rendered
+// preview beside the entry. The preview is parsed with the same markdown
+// support as RichText, follows the entry as it scrolls, and a toolbar above
+// both panes applies common formatting to the selection.
+//
+// Since: 2.6
+type MarkdownEditor struct {
+	BaseWidget
+
+	// OnChanged is called when the markdown source is edited.
+	OnChanged func(string) `json:"-"`
+
+	text          string
+	entry         *Entry
+	preview       *RichText
+	previewScroll *widget.Scroll
+	toolbar       *Toolbar
+}
+
+// NewMarkdownEditor creates a markdown editor with the given initial source.
+//
+// Since: 2.6
+func NewMarkdownEditor(text string) *MarkdownEditor {
+	e := &MarkdownEditor{text: text}
+	e.ExtendBaseWidget(e)
+	return e
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (e *MarkdownEditor) CreateRenderer() fyne.WidgetRenderer {
+	e.ExtendBaseWidget(e)
+
+	e.entry = NewMultiLineEntry()
+	e.entry.Wrapping = fyne.TextWrapWord
+	e.entry.Text = e.text
+	e.entry.OnChanged = e.sourceChanged
+
+	e.preview = NewRichTextFromMarkdown(e.text)
+	e.preview.Wrapping = fyne.TextWrapWord
+	e.previewScroll = widget.NewScroll(e.preview)
+
+	e.toolbar = NewToolbar(
+		newMarkdownFormatItem("B", func() { e.wrapSelection("**", "**") }),
+		newMarkdownFormatItem("I", func() { e.wrapSelection("*", "*") }),
+		newMarkdownFormatItem("Code", func() { e.wrapSelection("`", "`") }),
+		newMarkdownFormatItem("Link", func() { e.wrapSelection("[", "](url)") }),
+		NewToolbarSeparator(),
+		newMarkdownFormatItem("Heading", func() { e.prefixLine("# ") }),
+		newMarkdownFormatItem("List", func() { e.prefixLine("- ") }),
+	)
+
+	return &markdownEditorRenderer{editor: e,
+		objects: []fyne.CanvasObject{e.toolbar, e.entry, e.previewScroll}}
+}
+
+// Text returns the current markdown source.
+func (e *MarkdownEditor) Text() string {
+	if e.entry != nil {
+		return e.entry.Text
+	}
+	return e.text
+}
+
+// SetText replaces the markdown source, updating the preview.
+func (e *MarkdownEditor) SetText(text string) {
+	e.text = text
+	if e.entry != nil {
+		e.entry.SetText(text) // the preview follows through OnChanged
+	}
+}
+
+// sourceChanged re-renders the preview whenever the source is edited.
+func (e *MarkdownEditor) sourceChanged(text string) {
+	e.text = text
+	e.preview.ParseMarkdown(text)
+	e.syncPreviewScroll(fyne.Position{})
+	if f := e.OnChanged; f != nil {
+		f(text)
+	}
+}
+
+// syncPreviewScroll moves the preview to the same relative position as the
+// entry, so the rendered output stays close to the lines being edited.
+func (e *MarkdownEditor) syncPreviewScroll(fyne.Position) {
+	src := e.entry.scroll
+	dst := e.previewScroll
+	if src == nil || src.Content == nil || dst.Content == nil {
+		return
+	}
+
+	max := src.Content.MinSize().Height - src.Size().Height
+	dstMax := dst.Content.MinSize().Height - dst.Size().Height
+	if max <= 0 || dstMax <= 0 {
+		return
+	}
+
+	dst.Offset.Y = src.Offset.Y / max * dstMax
+	dst.Refresh()
+}
+
+// wrapSelection surrounds the selected source with the given markers, or
+// inserts an empty pair at the cursor when nothing is selected.
+func (e *MarkdownEditor) wrapSelection(prefix, suffix string) {
+	e.entry.propertyLock.RLock()
+	start, end := e.entry.selection()
+	if start < 0 {
+		start = e.entry.cursorTextPos()
+		end = start
+	}
+	selected := string([]rune(e.entry.Text)[start:end])
+	e.entry.propertyLock.RUnlock()
+
+	e.entry.ReplaceAt(TextRange{Start: start, End: end}, prefix+selected+suffix)
+	e.focusEntry()
+}
+
+// prefixLine inserts the given markers at the start of the line under the cursor.
+func (e *MarkdownEditor) prefixLine(prefix string) {
+	e.entry.propertyLock.RLock()
+	pos := e.entry.textPosFromRowCol(e.entry.CursorRow, 0)
+	e.entry.propertyLock.RUnlock()
+
+	e.entry.ReplaceAt(TextRange{Start: pos, End: pos}, prefix)
+	e.focusEntry()
+}
+
+// focusEntry returns keyboard focus to the source after a toolbar action.
+func (e *MarkdownEditor) focusEntry() {
+	if c := fyne.CurrentApp().Driver().CanvasForObject(e.super()); c != nil {
+		c.Focus(e.entry)
+	}
+}
+
+// markdownFormatItem is a toolbar item labelled with text rather than an icon,
+// as the formatting actions have no standard theme icons.
+type markdownFormatItem struct {
+	button *Button
+}
+
+func newMarkdownFormatItem(label string, onActivated func()) *markdownFormatItem {
+	button := NewButton(label, onActivated)
+	button.Importance = LowImportance
+	return &markdownFormatItem{button: button}
+}
+
+// ToolbarObject gets the button to render this toolbar item.
+func (m *markdownFormatItem) ToolbarObject() fyne.CanvasObject {
+	return m.button
+}
+
+var _ fyne.WidgetRenderer = (*markdownEditorRenderer)(nil)
+
+type markdownEditorRenderer struct {
+	editor  *MarkdownEditor
+	objects []fyne.CanvasObject
+}
+
+func (r *markdownEditorRenderer) Destroy() {
+}
+
+func (r *markdownEditorRenderer) Layout(size fyne.Size) {
+	e := r.editor
+	pad := e.Theme().Size(theme.SizeNamePadding)
+
+	barHeight := e.toolbar.MinSize().Height
+	e.toolbar.Resize(fyne.NewSize(size.Width, barHeight))
+	e.toolbar.Move(fyne.Position{})
+
+	paneWidth := (size.Width - pad) / 2
+	paneHeight := size.Height - barHeight - pad
+	e.entry.Resize(fyne.NewSize(paneWidth, paneHeight))
+	e.entry.Move(fyne.NewPos(0, barHeight+pad))
+	e.previewScroll.Resize(fyne.NewSize(paneWidth, paneHeight))
+	e.previewScroll.Move(fyne.NewPos(paneWidth+pad, barHeight+pad))
+
+	r.linkScroll()
+}
+
+func (r *markdownEditorRenderer) MinSize() fyne.Size {
+	e := r.editor
+	pad := e.Theme().Size(theme.SizeNamePadding)
+	bar := e.toolbar.MinSize()
+	entry := e.entry.MinSize()
+	preview := e.previewScroll.MinSize()
+
+	width := fyne.Max(bar.Width, entry.Width+pad+preview.Width)
+	return fyne.NewSize(width, bar.Height+pad+fyne.Max(entry.Height, preview.Height))
+}
+
+func (r *markdownEditorRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *markdownEditorRenderer) Refresh() {
+	r.linkScroll()
+	r.editor.toolbar.Refresh()
+	r.editor.entry.Refresh()
+	r.editor.previewScroll.Refresh()
+	canvas.Refresh(r.editor.super())
+}
+
+// linkScroll hooks the preview to the scroller inside the entry, which only
+// exists once the entry has been rendered.
+func (r *markdownEditorRenderer) linkScroll() {
+	if s := r.editor.entry.scroll; s != nil && s.OnScrolled == nil {
+		s.OnScrolled = r.editor.syncPreviewScroll
+	}
+}
@@ -0,0 +1,58 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMarkdownEditor(t *testing.T) {
+	editor := NewMarkdownEditor("# Title")
+	w := test.NewTempWindow(t, editor)
+	w.Resize(fyne.NewSize(400, 200))
+
+	assert.Equal(t, "# Title", editor.Text())
+	require.NotEmpty(t, editor.preview.Segments)
+	assert.Equal(t, "Title", editor.preview.Segments[0].(*TextSegment).Text)
+
+	editor.SetText("plain")
+	assert.Equal(t, "plain", editor.Text())
+	assert.Equal(t, "plain", editor.preview.Segments[0].(*TextSegment).Text)
+}
+
+func TestMarkdownEditor_Preview(t *testing.T) {
+	editor := NewMarkdownEditor("")
+	w := test.NewTempWindow(t, editor)
+	w.Resize(fyne.NewSize(400, 200))
+
+	changed := ""
+	editor.OnChanged = func(text string) { changed = text }
+
+	w.Canvas().Focus(editor.entry)
+	test.Type(editor.entry, "hello")
+	assert.Equal(t, "hello", changed)
+	assert.Equal(t, "hello", editor.preview.Segments[0].(*TextSegment).Text)
+}
+
+func TestMarkdownEditor_Toolbar(t *testing.T) {
+	editor := NewMarkdownEditor("bold")
+	w := test.NewTempWindow(t, editor)
+	w.Resize(fyne.NewSize(400, 200))
+
+	// select the whole word and wrap it with the bold action
+	editor.entry.selecting = true
+	editor.entry.selectRow, editor.entry.selectColumn = 0, 0
+	editor.entry.CursorColumn = 4
+	test.Tap(editor.toolbar.Items[0].(*markdownFormatItem).button)
+	assert.Equal(t, "**bold**", editor.Text())
+
+	// with no selection the heading action marks up the current line
+	test.Tap(editor.toolbar.Items[5].(*markdownFormatItem).button)
+	assert.Equal(t, "# **bold**", editor.Text())
+	assert.Equal(t, "bold", editor.preview.Segments[0].(*TextSegment).Text)
+	assert.True(t, editor.preview.Segments[0].(*TextSegment).Style.TextStyle.Bold)
+}
@@ -0,0 +1,55 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// ObjectPool recycles canvas objects created from a template function, in the way that
+// List and other virtualized widgets reuse their row items. Third-party widgets that
+// display many similar objects can obtain items from the pool as they scroll into view
+// and release them again once off screen, avoiding repeated construction cost.
+//
+// Since: 2.6
+type ObjectPool struct {
+	pool   syncPool
+	create func() fyne.CanvasObject
+	scope  fyne.Widget
+}
+
+// NewObjectPool returns a pool that will call create to build new objects when none
+// are available for reuse.
+//
+// Since: 2.6
+func NewObjectPool(create func() fyne.CanvasObject) *ObjectPool {
+	return &ObjectPool{create: create}
+}
+
+// SetThemeScope specifies a widget whose theme override, if any, will be applied to
+// objects the pool creates. This should be the widget that the pooled objects are
+// displayed inside, so they pick up any container-scoped theme.
+//
+// Since: 2.6
+func (p *ObjectPool) SetThemeScope(scope fyne.Widget) {
+	p.scope = scope
+}
+
+// Obtain returns an object from the pool, creating a new one from the template
+// function if the pool is empty.
+func (p *ObjectPool) Obtain() fyne.CanvasObject {
+	if item := p.pool.Obtain(); item != nil {
+		return item
+	}
+	if p.create == nil {
+		return nil
+	}
+
+	if p.scope != nil {
+		return createItemAndApplyThemeScope(p.create, p.scope)
+	}
+	return p.create()
+}
+
+// Release adds an object into the pool so a later Obtain call can reuse it.
+func (p *ObjectPool) Release(item fyne.CanvasObject) {
+	p.pool.Release(item)
+}
@@ -0,0 +1,38 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectPool(t *testing.T) {
+	created := 0
+	pool := NewObjectPool(func() fyne.CanvasObject {
+		created++
+		return NewLabel("Template")
+	})
+
+	first := pool.Obtain()
+	assert.NotNil(t, first)
+	assert.Equal(t, 1, created)
+
+	pool.Release(first)
+	assert.Equal(t, first, pool.Obtain())
+	assert.Equal(t, 1, created)
+
+	second := pool.Obtain()
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, 2, created)
+}
+
+func TestObjectPool_NoTemplate(t *testing.T) {
+	pool := NewObjectPool(nil)
+	assert.Nil(t, pool.Obtain())
+
+	item := NewLabel("Released")
+	pool.Release(item)
+	assert.Equal(t, item, pool.Obtain())
+}
@@ -0,0 +1,130 @@
+package widget
+
+import (
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/widget"
+)
+
+// OverlayOptions configures how content added with ShowOverlay behaves on the
+// canvas overlay stack.
+//
+// Since: 2.6
+type OverlayOptions struct {
+	// Modal swallows input outside the content instead of passing it through.
+	// Non-modal overlays are dismissed by a tap outside their content.
+	Modal bool
+	// ZOrder orders overlays shown through this API on the same canvas, higher
+	// values appear above lower ones regardless of the order they were shown in.
+	ZOrder int
+	// OnDismissed is called after the overlay is removed from the canvas, whether
+	// dismissed by a tap outside or with Dismiss.
+	OnDismissed func()
+}
+
+// Overlay is a handle to a canvas object displayed above all other canvas content,
+// as returned by ShowOverlay. It can be used to build custom popups, toasts, drag
+// images and similar floating elements without the decoration of a PopUp.
+//
+// Since: 2.6
+type Overlay struct {
+	content   fyne.CanvasObject
+	canvas    fyne.Canvas
+	opts      OverlayOptions
+	container *widget.OverlayContainer
+	shown     bool
+}
+
+var (
+	overlaysLock  sync.Mutex
+	shownOverlays = map[fyne.Canvas][]*Overlay{}
+)
+
+// ShowOverlay displays the given content above all other content of the canvas and
+// returns a handle that can move, resize or dismiss it.
+//
+// Since: 2.6
+func ShowOverlay(content fyne.CanvasObject, canvas fyne.Canvas, opts OverlayOptions) *Overlay {
+	o := &Overlay{content: content, canvas: canvas, opts: opts}
+	var onDismiss func()
+	if !opts.Modal {
+		onDismiss = o.Dismiss
+	}
+	o.container = widget.NewOverlayContainer(content, canvas, onDismiss)
+	o.Show()
+	return o
+}
+
+// Content returns the canvas object this overlay displays.
+func (o *Overlay) Content() fyne.CanvasObject {
+	return o.content
+}
+
+// Show adds the overlay back to the canvas after it has been dismissed.
+func (o *Overlay) Show() {
+	overlaysLock.Lock()
+	defer overlaysLock.Unlock()
+	if o.shown {
+		return
+	}
+
+	// re-stack any overlays that should stay above this one, top-most first
+	shown := shownOverlays[o.canvas]
+	higher := []*Overlay{}
+	for i := len(shown) - 1; i >= 0; i-- {
+		if shown[i].opts.ZOrder > o.opts.ZOrder {
+			higher = append(higher, shown[i])
+			shown[i].container.Hide()
+		}
+	}
+
+	o.container.Show()
+	o.shown = true
+	at := len(shown) - len(higher)
+	shown = append(shown[:at:at], o)
+	for i := len(higher) - 1; i >= 0; i-- {
+		higher[i].container.Show()
+		shown = append(shown, higher[i])
+	}
+	shownOverlays[o.canvas] = shown
+}
+
+// Dismiss removes the overlay from the canvas, notifying OnDismissed.
+func (o *Overlay) Dismiss() {
+	overlaysLock.Lock()
+	if !o.shown {
+		overlaysLock.Unlock()
+		return
+	}
+
+	o.shown = false
+	o.container.Hide()
+	shown := shownOverlays[o.canvas]
+	for i, other := range shown {
+		if other == o {
+			shown = append(shown[:i], shown[i+1:]...)
+			break
+		}
+	}
+	if len(shown) == 0 {
+		delete(shownOverlays, o.canvas)
+	} else {
+		shownOverlays[o.canvas] = shown
+	}
+	overlaysLock.Unlock()
+
+	if f := o.opts.OnDismissed; f != nil {
+		f()
+	}
+}
+
+// Move positions the overlay content relative to the canvas origin.
+func (o *Overlay) Move(pos fyne.Position) {
+	o.content.Move(pos)
+}
+
+// Resize updates the size of the overlay content.
+func (o *Overlay) Resize(size fyne.Size) {
+	o.content.Resize(size)
+}
@@ -0,0 +1,69 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+)
+
+func TestShowOverlay_Dismiss(t *testing.T) {
+	w := test.NewTempWindow(t, NewLabel("Content"))
+	w.Resize(fyne.NewSize(200, 200))
+	c := w.Canvas()
+
+	dismissed := 0
+	o := ShowOverlay(NewLabel("Toast"), c, OverlayOptions{
+		OnDismissed: func() { dismissed++ },
+	})
+	assert.Len(t, c.Overlays().List(), 1)
+
+	o.Dismiss()
+	assert.Empty(t, c.Overlays().List())
+	assert.Equal(t, 1, dismissed)
+
+	o.Dismiss() // no-op when already gone
+	assert.Equal(t, 1, dismissed)
+}
+
+func TestShowOverlay_TapOutside(t *testing.T) {
+	w := test.NewTempWindow(t, NewLabel("Content"))
+	w.Resize(fyne.NewSize(200, 200))
+	c := w.Canvas()
+
+	dismissed := 0
+	o := ShowOverlay(NewLabel("Popup"), c, OverlayOptions{
+		OnDismissed: func() { dismissed++ },
+	})
+	o.Move(fyne.NewPos(50, 50))
+
+	test.TapCanvas(c, fyne.NewPos(190, 190))
+	assert.Empty(t, c.Overlays().List())
+	assert.Equal(t, 1, dismissed)
+
+	// modal overlays swallow outside taps instead
+	modal := ShowOverlay(NewLabel("Modal"), c, OverlayOptions{Modal: true})
+	test.TapCanvas(c, fyne.NewPos(190, 190))
+	assert.Len(t, c.Overlays().List(), 1)
+	modal.Dismiss()
+}
+
+func TestShowOverlay_ZOrder(t *testing.T) {
+	w := test.NewTempWindow(t, NewLabel("Content"))
+	w.Resize(fyne.NewSize(200, 200))
+	c := w.Canvas()
+
+	top := ShowOverlay(NewLabel("Top"), c, OverlayOptions{ZOrder: 10})
+	below := ShowOverlay(NewLabel("Below"), c, OverlayOptions{ZOrder: 1})
+
+	overlays := c.Overlays().List()
+	assert.Len(t, overlays, 2)
+	assert.Equal(t, below.container, overlays[0])
+	assert.Equal(t, top.container, overlays[1])
+
+	top.Dismiss()
+	below.Dismiss()
+	assert.Empty(t, c.Overlays().List())
+}
@@ -1,9 +1,8 @@
 package widget
 
 import (
-	"sync"
-
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/virtualizer"
 )
 
 type pool interface {
@@ -13,20 +12,5 @@ type pool interface {
 
 var _ pool = (*syncPool)(nil)
 
-type syncPool struct {
-	sync.Pool
-}
-
-// Obtain returns an item from the pool for use
-func (p *syncPool) Obtain() (item fyne.CanvasObject) {
-	o := p.Get()
-	if o != nil {
-		item = o.(fyne.CanvasObject)
-	}
-	return
-}
-
-// Release adds an item into the pool to be used later
-func (p *syncPool) Release(item fyne.CanvasObject) {
-	p.Put(item)
-}
+// syncPool is the shared item pool of the virtualized collection widgets.
+type syncPool = virtualizer.Pool
@@ -0,0 +1,153 @@
+package widget
+
+import (
+	"image"
+
+	qr "github.com/skip2/go-qrcode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+var _ fyne.Widget = (*QRCode)(nil)
+
+// QRCode is a widget that displays its content encoded as a QR code. The code is
+// regenerated at the displayed pixel size so it stays crisp at any scale, and it is
+// drawn in the theme foreground and background colours.
+//
+// Since: 2.6
+type QRCode struct {
+	BaseWidget
+
+	// Content is the text encoded by the QR code.
+	Content string
+
+	raster *canvas.Raster
+	code   *qr.QRCode
+}
+
+// NewQRCode returns a widget encoding the given content as a QR code.
+//
+// Since: 2.6
+func NewQRCode(content string) *QRCode {
+	q := &QRCode{Content: content}
+	q.ExtendBaseWidget(q)
+	return q
+}
+
+// SetContent updates the text encoded by the QR code.
+func (q *QRCode) SetContent(content string) {
+	q.Content = content
+	q.Refresh()
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (q *QRCode) MinSize() fyne.Size {
+	q.ExtendBaseWidget(q)
+	return q.BaseWidget.MinSize()
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (q *QRCode) CreateRenderer() fyne.WidgetRenderer {
+	q.ExtendBaseWidget(q)
+	q.updateCode()
+	q.raster = canvas.NewRaster(q.draw)
+	return &qrCodeRenderer{code: q}
+}
+
+// updateCode regenerates the encoded modules after the content has changed.
+func (q *QRCode) updateCode() {
+	if q.Content == "" {
+		q.code = nil
+		return
+	}
+
+	code, err := qr.New(q.Content, qr.Medium)
+	if err != nil {
+		fyne.LogError("Failed to encode QR code content", err)
+		q.code = nil
+		return
+	}
+	code.DisableBorder = true
+	q.code = code
+}
+
+// draw renders the modules into the requested pixel size, keeping the modules square
+// and the code centered.
+func (q *QRCode) draw(w, h int) image.Image {
+	th := q.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	fg := th.Color(theme.ColorNameForeground, v)
+	bg := th.Color(theme.ColorNameBackground, v)
+
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, bg)
+		}
+	}
+	if q.code == nil {
+		return img
+	}
+
+	modules := q.code.Bitmap()
+	count := len(modules)
+	if count == 0 {
+		return img
+	}
+	pix := w
+	if h < pix {
+		pix = h
+	}
+	moduleSize := pix / count
+	if moduleSize < 1 {
+		moduleSize = 1
+	}
+	offX := (w - moduleSize*count) / 2
+	offY := (h - moduleSize*count) / 2
+
+	for my, row := range modules {
+		for mx, set := range row {
+			if !set {
+				continue
+			}
+			for y := 0; y < moduleSize; y++ {
+				for x := 0; x < moduleSize; x++ {
+					img.Set(offX+mx*moduleSize+x, offY+my*moduleSize+y, fg)
+				}
+			}
+		}
+	}
+	return img
+}
+
+var _ fyne.WidgetRenderer = (*qrCodeRenderer)(nil)
+
+type qrCodeRenderer struct {
+	code *QRCode
+}
+
+func (q *qrCodeRenderer) Destroy() {
+}
+
+func (q *qrCodeRenderer) Layout(size fyne.Size) {
+	q.code.raster.Resize(size)
+}
+
+func (q *qrCodeRenderer) MinSize() fyne.Size {
+	modules := float32(21) // smallest QR version
+	if q.code.code != nil {
+		modules = float32(len(q.code.code.Bitmap()))
+	}
+	return fyne.NewSquareSize(fyne.Max(modules*2, q.code.Theme().Size(theme.SizeNameInlineIcon)))
+}
+
+func (q *qrCodeRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{q.code.raster}
+}
+
+func (q *qrCodeRenderer) Refresh() {
+	q.code.updateCode()
+	q.code.raster.Refresh()
+}
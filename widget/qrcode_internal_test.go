@@ -0,0 +1,53 @@
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQRCode(t *testing.T) {
+	code := NewQRCode("https://fyne.io")
+	w := test.NewTempWindow(t, code)
+	w.Resize(fyne.NewSize(120, 120))
+
+	assert.NotNil(t, code.code)
+	img := code.draw(100, 100)
+	assert.Greater(t, countForeground(code, img), 0)
+}
+
+func TestQRCode_SetContent(t *testing.T) {
+	code := NewQRCode("")
+	w := test.NewTempWindow(t, code)
+	w.Resize(fyne.NewSize(120, 120))
+
+	img := code.draw(100, 100)
+	assert.Zero(t, countForeground(code, img)) // nothing encoded yet
+
+	code.SetContent("https://fyne.io")
+	img = code.draw(100, 100)
+	assert.Greater(t, countForeground(code, img), 0)
+}
+
+func countForeground(code *QRCode, img image.Image) int {
+	th := code.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	fr, fg, fb, _ := th.Color(theme.ColorNameForeground, v).RGBA()
+
+	count := 0
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, _ := img.At(x, y).RGBA()
+			if r == fr && g == fg && b == fb {
+				count++
+			}
+		}
+	}
+	return count
+}
@@ -3,6 +3,7 @@ package widget
 import (
 	"image/color"
 	"math"
+	"net/url"
 	"strings"
 	"sync"
 	"unicode"
@@ -38,6 +39,18 @@ type RichText struct {
 	// Since: 2.4
 	Truncation fyne.TextTruncation
 
+	// Selectable defines whether the text can be selected by the user, to be
+	// read through SelectedText or copied with the standard shortcut.
+	//
+	// Since: 2.6
+	Selectable bool
+
+	// OnLinkTapped is called when a hyperlink segment that has no OnTapped of
+	// its own is tapped, instead of opening its URL.
+	//
+	// Since: 2.6
+	OnLinkTapped func(*url.URL) `json:"-"`
+
 	inset     fyne.Size     // this varies due to how the widget works (entry with scroller vs others with padding)
 	rowBounds []rowBoundary // cache for boundaries
 	scr       *widget.Scroll
@@ -46,6 +59,7 @@ type RichText struct {
 	visualCache map[RichTextSegment][]fyne.CanvasObject
 	cacheLock   sync.Mutex
 	minCache    fyne.Size
+	selection   *textSelection // overlay handling events and highlight when Selectable
 }
 
 // NewRichText returns a new RichText widget that renders the given text and segments.
@@ -256,6 +270,7 @@ func (t *RichText) cleanVisualCache() {
 func (t *RichText) insertAt(pos int, runes []rune) {
 	index := 0
 	start := 0
+	segStart := 0
 	var into *TextSegment
 	for i, seg := range t.Segments {
 		if _, ok := seg.(*TextSegment); !ok {
@@ -264,6 +279,7 @@ func (t *RichText) insertAt(pos int, runes []rune) {
 		end := start + len([]rune(seg.(*TextSegment).Text))
 		into = seg.(*TextSegment)
 		index = i
+		segStart = start
 		if end > pos {
 			break
 		}
@@ -274,6 +290,7 @@ func (t *RichText) insertAt(pos int, runes []rune) {
 	if into == nil {
 		return
 	}
+	pos -= segStart // make the position relative to the segment receiving the text
 	r := ([]rune)(into.Text)
 	if pos > len(r) { // safety in case position is out of bounds for the segment
 		pos = len(r)
@@ -351,16 +368,21 @@ func (t *RichText) row(row int) []rune {
 	bound := t.rowBounds[row]
 	var ret []rune
 	for i, seg := range bound.segments {
-		if text, ok := seg.(*TextSegment); ok {
-			if i == 0 {
-				if len(bound.segments) == 1 {
-					ret = append(ret, []rune(text.Text)[bound.begin:bound.end]...)
-				} else {
-					ret = append(ret, []rune(text.Text)[bound.begin:]...)
-				}
-			} else if i == len(bound.segments)-1 && len(bound.segments) > 1 && bound.end != 0 {
-				ret = append(ret, []rune(text.Text)[:bound.end]...)
-			}
+		text, ok := seg.(*TextSegment)
+		if !ok {
+			continue
+		}
+
+		r := []rune(text.Text)
+		begin, end := 0, len(r)
+		if i == 0 {
+			begin = bound.begin
+		}
+		if i == len(bound.segments)-1 {
+			end = bound.end // may be 0 when the row ends before this segment
+		}
+		if end >= begin {
+			ret = append(ret, r[begin:end]...)
 		}
 	}
 	return ret
@@ -582,6 +604,12 @@ func (r *textRenderer) Layout(size fyne.Size) {
 			yPos += lineSpacing
 		}
 	}
+
+	if sel := r.obj.selection; sel != nil && r.obj.Selectable {
+		sel.Resize(size.Max(r.obj.prop.MinSize()))
+		sel.Move(fyne.Position{})
+		sel.Refresh() // the highlight follows the text positions
+	}
 }
 
 // MinSize calculates the minimum size of a rich text widget.
@@ -704,6 +732,12 @@ func (r *textRenderer) Refresh() {
 			if _, ok := seg.(*TextSegment); !ok {
 				obj := r.obj.cachedSegmentVisual(seg, 0)
 				seg.Update(obj)
+				if link, ok := seg.(*HyperlinkSegment); ok {
+					if f := r.obj.OnLinkTapped; f != nil && link.OnTapped == nil {
+						u := link.URL
+						obj.(*fyne.Container).Objects[0].(*Hyperlink).OnTapped = func() { f(u) }
+					}
+				}
 				objs = append(objs, obj)
 				continue
 			}
@@ -739,6 +773,13 @@ func (r *textRenderer) Refresh() {
 		}
 	}
 
+	if r.obj.Selectable {
+		if r.obj.selection == nil {
+			r.obj.selection = newTextSelection(r.obj)
+		}
+		objs = append(objs, r.obj.selection)
+	}
+
 	r.obj.propertyLock.Lock()
 	if r.obj.scr != nil {
 		r.obj.scr.Content = &fyne.Container{Layout: layout.NewStackLayout(), Objects: []fyne.CanvasObject{
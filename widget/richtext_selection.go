@@ -0,0 +1,436 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/theme"
+)
+
+// SelectedText returns the text currently highlighted by the user, if this
+// widget is Selectable, or an empty string otherwise.
+//
+// Since: 2.6
+func (t *RichText) SelectedText() string {
+	if t.selection == nil {
+		return ""
+	}
+	return t.selection.selectedText()
+}
+
+var _ desktop.Cursorable = (*textSelection)(nil)
+var _ desktop.Keyable = (*textSelection)(nil)
+var _ desktop.Mouseable = (*textSelection)(nil)
+var _ fyne.Disableable = (*textSelection)(nil)
+var _ fyne.Draggable = (*textSelection)(nil)
+var _ fyne.DoubleTappable = (*textSelection)(nil)
+var _ fyne.Shortcutable = (*textSelection)(nil)
+
+// textSelection is the overlay a RichText renders over its content when it is
+// Selectable. It handles the pointer and keyboard interactions and draws the
+// highlight boxes, which the text shows through as the selection color is
+// translucent.
+type textSelection struct {
+	BaseWidget
+	text *RichText
+
+	// selection corners in row and column coordinates, anchorRow is -1 while
+	// nothing is selected and the anchor is the end the drag started from
+	anchorRow, anchorCol int
+	caretRow, caretCol   int
+	shiftDown            bool
+}
+
+func newTextSelection(text *RichText) *textSelection {
+	s := &textSelection{text: text, anchorRow: -1}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (s *textSelection) CreateRenderer() fyne.WidgetRenderer {
+	return &textSelectionRenderer{sel: s}
+}
+
+// Cursor returns the text cursor to indicate that the content can be selected.
+func (s *textSelection) Cursor() desktop.Cursor {
+	if !s.text.Selectable {
+		return desktop.DefaultCursor
+	}
+	return desktop.TextCursor
+}
+
+// Disable has no effect, the overlay follows the Selectable field of its text.
+func (s *textSelection) Disable() {
+}
+
+// Disabled reports the overlay as disabled while the text is not selectable,
+// which keeps it out of the keyboard focus chain.
+func (s *textSelection) Disabled() bool {
+	return !s.text.Selectable
+}
+
+// DoubleTapped selects the word under the pointer.
+func (s *textSelection) DoubleTapped(ev *fyne.PointEvent) {
+	if !s.text.Selectable {
+		return
+	}
+
+	row, col := s.getRowCol(ev.Position)
+	text := s.text.row(row)
+	start, end := col, col
+	for start > 0 && isWordRune(text[start-1]) {
+		start--
+	}
+	for end < len(text) && isWordRune(text[end]) {
+		end++
+	}
+
+	s.anchorRow, s.anchorCol = row, start
+	s.caretRow, s.caretCol = row, end
+	s.focusSelf()
+	s.Refresh()
+}
+
+// DragEnd is called at the end of a selection drag, the selection remains.
+func (s *textSelection) DragEnd() {
+}
+
+// Dragged extends the selection to follow the pointer.
+func (s *textSelection) Dragged(ev *fyne.DragEvent) {
+	if !s.text.Selectable {
+		return
+	}
+	if s.anchorRow < 0 { // a touch drag starts without a mouse press
+		start := ev.Position.Subtract(fyne.NewPos(ev.Dragged.DX, ev.Dragged.DY))
+		s.anchorRow, s.anchorCol = s.getRowCol(start)
+		s.focusSelf()
+	}
+
+	s.caretRow, s.caretCol = s.getRowCol(ev.Position)
+	s.Refresh()
+}
+
+// Enable has no effect, the overlay follows the Selectable field of its text.
+func (s *textSelection) Enable() {
+}
+
+// FocusGained is called when the overlay gained the keyboard focus.
+func (s *textSelection) FocusGained() {
+}
+
+// FocusLost is called when the overlay lost the keyboard focus, the selection
+// remains so it can still be read through SelectedText.
+func (s *textSelection) FocusLost() {
+}
+
+// KeyDown tracks the shift state used to extend the selection with the arrow keys.
+func (s *textSelection) KeyDown(key *fyne.KeyEvent) {
+	if key.Name == desktop.KeyShiftLeft || key.Name == desktop.KeyShiftRight {
+		s.shiftDown = true
+	}
+}
+
+// KeyUp tracks the shift state used to extend the selection with the arrow keys.
+func (s *textSelection) KeyUp(key *fyne.KeyEvent) {
+	if key.Name == desktop.KeyShiftLeft || key.Name == desktop.KeyShiftRight {
+		s.shiftDown = false
+	}
+}
+
+// MouseDown starts a new selection at the pointer, collapsing any previous one.
+func (s *textSelection) MouseDown(m *desktop.MouseEvent) {
+	if !s.text.Selectable || m.Button != desktop.MouseButtonPrimary {
+		return
+	}
+
+	s.focusSelf()
+	row, col := s.getRowCol(m.Position)
+	s.anchorRow, s.anchorCol = row, col
+	s.caretRow, s.caretCol = row, col
+	s.Refresh()
+}
+
+// MouseUp is called when the mouse button is released over the overlay.
+func (s *textSelection) MouseUp(*desktop.MouseEvent) {
+}
+
+// TypedKey moves the selection caret, extending the selection while shift is held.
+func (s *textSelection) TypedKey(key *fyne.KeyEvent) {
+	if !s.text.Selectable {
+		return
+	}
+	if key.Name == fyne.KeyEscape {
+		s.anchorRow = -1
+		s.Refresh()
+		return
+	}
+	if !s.shiftDown {
+		return // without shift there is no caret to show moving
+	}
+	if s.anchorRow < 0 {
+		s.anchorRow, s.anchorCol = s.caretRow, s.caretCol
+	}
+
+	switch key.Name {
+	case fyne.KeyLeft:
+		if s.caretCol > 0 {
+			s.caretCol--
+		} else if s.caretRow > 0 {
+			s.caretRow--
+			s.caretCol = s.text.rowLength(s.caretRow)
+		}
+	case fyne.KeyRight:
+		if s.caretCol < s.text.rowLength(s.caretRow) {
+			s.caretCol++
+		} else if s.caretRow < s.text.rows()-1 {
+			s.caretRow++
+			s.caretCol = 0
+		}
+	case fyne.KeyUp:
+		if s.caretRow > 0 {
+			s.caretRow--
+			s.clampCaretCol()
+		}
+	case fyne.KeyDown:
+		if s.caretRow < s.text.rows()-1 {
+			s.caretRow++
+			s.clampCaretCol()
+		}
+	case fyne.KeyHome:
+		s.caretCol = 0
+	case fyne.KeyEnd:
+		s.caretCol = s.text.rowLength(s.caretRow)
+	default:
+		return
+	}
+	s.Refresh()
+}
+
+// TypedRune is called when printable characters are typed, the text cannot be edited.
+func (s *textSelection) TypedRune(rune) {
+}
+
+// TypedShortcut copies the selection to the clipboard, or selects all of the text.
+func (s *textSelection) TypedShortcut(shortcut fyne.Shortcut) {
+	if !s.text.Selectable {
+		return
+	}
+
+	switch sh := shortcut.(type) {
+	case *fyne.ShortcutCopy:
+		if text := s.selectedText(); text != "" {
+			sh.Clipboard.SetContent(text)
+		}
+	case *fyne.ShortcutSelectAll:
+		s.selectAll()
+	}
+}
+
+// clampCaretCol keeps the caret column within the row it moved to.
+func (s *textSelection) clampCaretCol() {
+	if l := s.text.rowLength(s.caretRow); s.caretCol > l {
+		s.caretCol = l
+	}
+}
+
+// focusSelf requests the keyboard focus so the shortcuts reach this overlay.
+func (s *textSelection) focusSelf() {
+	if c := fyne.CurrentApp().Driver().CanvasForObject(s.super()); c != nil {
+		c.Focus(s)
+	}
+}
+
+// getRowCol returns the row and column of the text under the given position,
+// clamped to the closest row or column for positions outside the text.
+func (s *textSelection) getRowCol(p fyne.Position) (int, int) {
+	th := s.text.Theme()
+	textSize := th.Size(theme.SizeNameText)
+	innerPad := th.Size(theme.SizeNameInnerPadding)
+
+	row := s.text.rows() - 1
+	s.walkRows(func(r int, y, height float32) bool {
+		if p.Y < y+height {
+			row = r
+			return false
+		}
+		return true
+	})
+	if row < 0 {
+		return 0, 0
+	}
+
+	length := s.text.rowLength(row)
+	for col := 0; col < length; col++ {
+		wid := s.text.lineSizeToColumn(col, row, textSize, innerPad).Width
+		next := s.text.lineSizeToColumn(col+1, row, textSize, innerPad).Width
+		if p.X < wid+(next-wid)/2 {
+			return row, col
+		}
+	}
+	return row, length
+}
+
+// normalized returns the selection corners ordered from the top of the text,
+// reporting false while no selection is active.
+func (s *textSelection) normalized() (startRow, startCol, endRow, endCol int, ok bool) {
+	if s.anchorRow < 0 {
+		return 0, 0, 0, 0, false
+	}
+	if s.anchorRow > s.caretRow || (s.anchorRow == s.caretRow && s.anchorCol > s.caretCol) {
+		return s.caretRow, s.caretCol, s.anchorRow, s.anchorCol, true
+	}
+	return s.anchorRow, s.anchorCol, s.caretRow, s.caretCol, true
+}
+
+// selectAll extends the selection over all of the text.
+func (s *textSelection) selectAll() {
+	rows := s.text.rows()
+	if rows == 0 {
+		return
+	}
+
+	s.anchorRow, s.anchorCol = 0, 0
+	s.caretRow, s.caretCol = rows-1, s.text.rowLength(rows-1)
+	s.Refresh()
+}
+
+// selectedText returns the text between the selection corners.
+func (s *textSelection) selectedText() string {
+	startRow, startCol, endRow, endCol, ok := s.normalized()
+	if !ok || (startRow == endRow && startCol == endCol) {
+		return ""
+	}
+
+	runes := []rune(s.text.String())
+	start := s.textPos(startRow, startCol)
+	end := s.textPos(endRow, endCol)
+	if end > len(runes) { // the selection may outlive a text change
+		end = len(runes)
+	}
+	if start >= end {
+		return ""
+	}
+	return string(runes[start:end])
+}
+
+// textPos converts a row and column into a position in the whole text, so that
+// wrapped rows read back as the continuous text they were built from.
+func (s *textSelection) textPos(row, col int) int {
+	b := s.text.rowBoundary(row)
+	if b == nil || len(b.segments) == 0 {
+		return col
+	}
+
+	pos := b.begin + col // the boundary offsets are relative to the row's first segment
+	found := false
+	var walk func(segs []RichTextSegment)
+	walk = func(segs []RichTextSegment) {
+		for _, seg := range segs {
+			if found {
+				return
+			}
+			if parent, ok := seg.(RichTextBlock); ok {
+				walk(parent.Segments())
+				continue
+			}
+			if seg == b.segments[0] {
+				found = true
+				return
+			}
+			pos += len([]rune(seg.Textual()))
+		}
+	}
+	walk(s.text.Segments)
+	return pos
+}
+
+// walkRows calls fn for every text row with its vertical offset and height,
+// mirroring the positions used by the text layout. Returning false stops the walk.
+func (s *textSelection) walkRows(fn func(row int, y, height float32) bool) {
+	th := s.text.Theme()
+	textSize := th.Size(theme.SizeNameText)
+	innerPad := th.Size(theme.SizeNameInnerPadding)
+	lineSpacing := th.Size(theme.SizeNameLineSpacing)
+
+	y := innerPad - s.text.inset.Height
+	rows := s.text.rows()
+	for row := 0; row < rows; row++ {
+		height := s.text.lineSizeToColumn(s.text.rowLength(row), row, textSize, innerPad).Height
+		if !fn(row, y, height) {
+			return
+		}
+
+		y += height
+		b := s.text.rowBoundary(row)
+		next := s.text.rowBoundary(row + 1)
+		if b == nil || len(b.segments) == 0 || next == nil || len(next.segments) == 0 {
+			continue
+		}
+		lastSeg := b.segments[len(b.segments)-1]
+		if !lastSeg.Inline() && next.segments[0] != lastSeg { // ignore wrapped lines, as the layout does
+			y += lineSpacing
+		}
+	}
+}
+
+var _ fyne.WidgetRenderer = (*textSelectionRenderer)(nil)
+
+type textSelectionRenderer struct {
+	widget.BaseRenderer
+	sel *textSelection
+}
+
+func (r *textSelectionRenderer) Layout(fyne.Size) {
+}
+
+func (r *textSelectionRenderer) MinSize() fyne.Size {
+	return fyne.Size{}
+}
+
+func (r *textSelectionRenderer) Refresh() {
+	r.SetObjects(r.buildSelection())
+	canvas.Refresh(r.sel.super())
+}
+
+// buildSelection returns a highlight rectangle for each row the selection covers.
+func (r *textSelectionRenderer) buildSelection() []fyne.CanvasObject {
+	s := r.sel
+	startRow, startCol, endRow, endCol, ok := s.normalized()
+	if !ok || (startRow == endRow && startCol == endCol) {
+		return nil
+	}
+
+	th := s.text.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	textSize := th.Size(theme.SizeNameText)
+	innerPad := th.Size(theme.SizeNameInnerPadding)
+
+	var boxes []fyne.CanvasObject
+	s.walkRows(func(row int, y, height float32) bool {
+		if row < startRow {
+			return true
+		}
+		if row > endRow {
+			return false
+		}
+
+		first, last := 0, s.text.rowLength(row)
+		if row == startRow {
+			first = startCol
+		}
+		if row == endRow {
+			last = endCol
+		}
+
+		x1 := s.text.lineSizeToColumn(first, row, textSize, innerPad).Width
+		x2 := s.text.lineSizeToColumn(last, row, textSize, innerPad).Width
+		box := canvas.NewRectangle(th.Color(theme.ColorNameSelection, v))
+		box.Resize(fyne.NewSize(x2-x1+1, height))
+		box.Move(fyne.NewPos(x1-1, y))
+		boxes = append(boxes, box)
+		return true
+	})
+	return boxes
+}
@@ -31,6 +31,25 @@ func trailingBoldErrorSegment() *TextSegment {
 	}}
 }
 
+func TestRichText_Selectable(t *testing.T) {
+	text := NewRichTextWithText("first\nsecond")
+	text.Selectable = true
+	w := test.NewTempWindow(t, text)
+	w.Resize(fyne.NewSize(200, 100))
+
+	sel := text.selection
+	sel.selectAll()
+	assert.Equal(t, "first\nsecond", text.SelectedText())
+
+	// a selection across a row boundary keeps the line break
+	sel.anchorRow, sel.anchorCol = 0, 3
+	sel.caretRow, sel.caretCol = 1, 3
+	assert.Equal(t, "st\nsec", text.SelectedText())
+
+	text.Selectable = false
+	assert.Equal(t, "", NewRichTextWithText("none").SelectedText())
+}
+
 func TestRichText_Hyperlink_Endline(t *testing.T) {
 	u, _ := url.Parse("https://github.com/fyne-io/fyne")
 	r := NewRichText(
@@ -0,0 +1,41 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// ScrollOffsetter describes collection widgets whose vertical scroll offset can be
+// read and restored, such as List and GridWrap.
+//
+// Since: 2.6
+type ScrollOffsetter interface {
+	GetScrollOffset() float32
+	ScrollToOffset(float32)
+}
+
+// Declare conformity with the ScrollOffsetter interface.
+var _ ScrollOffsetter = (*List)(nil)
+var _ ScrollOffsetter = (*GridWrap)(nil)
+
+// SaveScrollPosition stores the current scroll offset of the given collection widget
+// into prefs under the specified key, so it can be restored in a later session with
+// RestoreScrollPosition.
+//
+// Since: 2.6
+func SaveScrollPosition(w ScrollOffsetter, prefs fyne.Preferences, key string) {
+	prefs.SetFloat(key, float64(w.GetScrollOffset()))
+}
+
+// RestoreScrollPosition scrolls the given collection widget to an offset previously
+// stored with SaveScrollPosition. Widgets that were not saved before are left at
+// their current position.
+//
+// Since: 2.6
+func RestoreScrollPosition(w ScrollOffsetter, prefs fyne.Preferences, key string) {
+	offset := prefs.FloatWithFallback(key, -1)
+	if offset < 0 { // offsets are never negative, so this key was not saved before
+		return
+	}
+
+	w.ScrollToOffset(float32(offset))
+}
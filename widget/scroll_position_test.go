@@ -0,0 +1,34 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveRestoreScrollPosition(t *testing.T) {
+	test.NewTempApp(t)
+	prefs := internal.NewInMemoryPreferences()
+
+	list := createList(100)
+	w := test.NewWindow(list)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 200))
+
+	list.ScrollToOffset(120)
+	SaveScrollPosition(list, prefs, "main-list")
+
+	list.ScrollToTop()
+	assert.Equal(t, float32(0), list.GetScrollOffset())
+
+	RestoreScrollPosition(list, prefs, "main-list")
+	assert.Equal(t, float32(120), list.GetScrollOffset())
+
+	// restoring an unsaved key leaves the position unchanged
+	RestoreScrollPosition(list, prefs, "other-list")
+	assert.Equal(t, float32(120), list.GetScrollOffset())
+}
@@ -0,0 +1,22 @@
+package widget
+
+// SelectionMode describes how many items of a collection widget may be
+// selected at the same time.
+//
+// Since: 2.6
+type SelectionMode int
+
+const (
+	// SelectionSingle allows at most one item to be selected at a time,
+	// this is the default mode.
+	//
+	// Since: 2.6
+	SelectionSingle SelectionMode = iota
+
+	// SelectionMultiple allows many items to be selected at once.
+	// On desktop Ctrl (or Super) clicking toggles an item in and out of the
+	// selection and Shift clicking extends it to a range.
+	//
+	// Since: 2.6
+	SelectionMultiple
+)
@@ -0,0 +1,479 @@
+package widget
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/theme"
+)
+
+// SheetGridStyle describes the appearance of a single SheetGrid cell.
+//
+// Since: 2.6
+type SheetGridStyle struct {
+	// Background fills the cell with a color, nil leaves the grid background visible.
+	Background color.Color
+	// BorderColor outlines the cell when set, using BorderWidth (or 1 if unset).
+	BorderColor color.Color
+	BorderWidth float32
+	// TextColor overrides the theme foreground color for the cell text.
+	TextColor color.Color
+	Alignment fyne.TextAlign
+	TextStyle fyne.TextStyle
+}
+
+// SheetGridRange identifies a rectangular range of SheetGrid cells, inclusive of
+// both corners.
+//
+// Since: 2.6
+type SheetGridRange struct {
+	StartRow, StartCol int
+	EndRow, EndCol     int
+}
+
+// Normalized returns the range with the start corner above and left of the end corner.
+func (r SheetGridRange) Normalized() SheetGridRange {
+	if r.StartRow > r.EndRow {
+		r.StartRow, r.EndRow = r.EndRow, r.StartRow
+	}
+	if r.StartCol > r.EndCol {
+		r.StartCol, r.EndCol = r.EndCol, r.StartCol
+	}
+	return r
+}
+
+// contains returns whether the given cell lies inside this range.
+func (r SheetGridRange) contains(row, col int) bool {
+	n := r.Normalized()
+	return row >= n.StartRow && row <= n.EndRow && col >= n.StartCol && col <= n.EndCol
+}
+
+// Declare conformity with the widget interface.
+var _ fyne.Widget = (*SheetGrid)(nil)
+
+// SheetGrid is a virtualized grid of text cells designed for spreadsheet scale data.
+// Unlike Table it renders cells with canvas primitives instead of widget templates,
+// so it stays responsive with millions of cells and scrolls smoothly in both axes
+// at once. It supports frozen leading rows and columns, per-cell styling, range
+// selection by dragging, and a fill handle on the selection corner that reports
+// fill gestures through OnFillRange. Number and date formatting is applied by the
+// CellText callback, which returns the display text for each cell.
+//
+// Since: 2.6
+type SheetGrid struct {
+	BaseWidget
+
+	// Length returns the dimensions of the grid in rows and columns.
+	Length func() (rows, cols int) `json:"-"`
+	// CellText returns the display text of the given cell.
+	CellText func(row, col int) string `json:"-"`
+	// CellStyle returns the style of the given cell, nil for the default style.
+	CellStyle func(row, col int) *SheetGridStyle `json:"-"`
+
+	// OnSelectionChanged is called whenever the selected range changes.
+	OnSelectionChanged func(sel SheetGridRange) `json:"-"`
+	// OnFillRange is called when the fill handle is dragged, with the source
+	// selection and the destination range the fill should extend over.
+	OnFillRange func(src, dst SheetGridRange) `json:"-"`
+
+	// FrozenRows and FrozenCols pin the given number of leading rows and columns
+	// so they stay visible while the rest of the grid scrolls.
+	FrozenRows, FrozenCols int
+
+	// CellSize sets the size of every cell, defaulting to a theme derived size.
+	CellSize fyne.Size
+
+	scroller  *widget.Scroll
+	content   *fyne.Container
+	selection SheetGridRange
+	selecting bool
+	filling   bool
+	fillDst   SheetGridRange
+}
+
+// NewSheetGrid creates a grid of the given dimensions where each cell displays the
+// text returned by the text callback.
+//
+// Since: 2.6
+func NewSheetGrid(length func() (rows, cols int), text func(row, col int) string) *SheetGrid {
+	g := &SheetGrid{Length: length, CellText: text}
+	g.ExtendBaseWidget(g)
+	return g
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (g *SheetGrid) CreateRenderer() fyne.WidgetRenderer {
+	g.ExtendBaseWidget(g)
+
+	g.content = &fyne.Container{Layout: newSheetGridLayout(g)}
+	g.content.Resize(g.content.MinSize())
+	g.scroller = widget.NewScroll(g.content)
+	g.scroller.OnScrolled = func(fyne.Position) {
+		g.refreshCells()
+	}
+
+	return &sheetGridRenderer{BaseRenderer: widget.NewBaseRenderer([]fyne.CanvasObject{g.scroller}), grid: g}
+}
+
+// Selection returns the currently selected range of cells.
+func (g *SheetGrid) Selection() SheetGridRange {
+	return g.selection.Normalized()
+}
+
+// SelectRange updates the selected range of cells, notifying OnSelectionChanged.
+func (g *SheetGrid) SelectRange(sel SheetGridRange) {
+	g.selection = sel
+	if f := g.OnSelectionChanged; f != nil {
+		f(sel.Normalized())
+	}
+	g.refreshCells()
+}
+
+// ScrollToCell scrolls the viewport so that the given cell is visible.
+func (g *SheetGrid) ScrollToCell(row, col int) {
+	if g.scroller == nil {
+		return
+	}
+
+	size := g.cellSize()
+	x, y := float32(col)*size.Width, float32(row)*size.Height
+	view := g.scroller.Size()
+	if x < g.scroller.Offset.X {
+		g.scroller.Offset.X = x
+	} else if x+size.Width > g.scroller.Offset.X+view.Width {
+		g.scroller.Offset.X = x + size.Width - view.Width
+	}
+	if y < g.scroller.Offset.Y {
+		g.scroller.Offset.Y = y
+	} else if y+size.Height > g.scroller.Offset.Y+view.Height {
+		g.scroller.Offset.Y = y + size.Height - view.Height
+	}
+	g.scroller.Refresh()
+	g.refreshCells()
+}
+
+// cellSize returns the size of each cell, applying the theme default if not set.
+func (g *SheetGrid) cellSize() fyne.Size {
+	if !g.CellSize.IsZero() {
+		return g.CellSize
+	}
+
+	th := g.Theme()
+	pad := th.Size(theme.SizeNamePadding)
+	return fyne.NewSize(112, th.Size(theme.SizeNameText)+pad*3)
+}
+
+// cellAt maps a position in grid content coordinates to a cell, taking the frozen
+// panes into account.
+func (g *SheetGrid) cellAt(pos fyne.Position) (row, col int) {
+	size := g.cellSize()
+	x, y := pos.X, pos.Y
+	if g.scroller != nil {
+		if x-g.scroller.Offset.X < float32(g.FrozenCols)*size.Width {
+			x -= g.scroller.Offset.X
+		}
+		if y-g.scroller.Offset.Y < float32(g.FrozenRows)*size.Height {
+			y -= g.scroller.Offset.Y
+		}
+	}
+
+	rows, cols := g.Length()
+	row = int(y / size.Height)
+	col = int(x / size.Width)
+	if row >= rows {
+		row = rows - 1
+	}
+	if col >= cols {
+		col = cols - 1
+	}
+	if row < 0 {
+		row = 0
+	}
+	if col < 0 {
+		col = 0
+	}
+	return row, col
+}
+
+func (g *SheetGrid) refreshCells() {
+	if g.content == nil {
+		return
+	}
+	g.content.Layout.(*sheetGridLayout).refresh()
+}
+
+// Refresh causes this widget to be redrawn in its current state.
+func (g *SheetGrid) Refresh() {
+	g.refreshCells()
+	g.BaseWidget.Refresh()
+}
+
+// Declare conformity with the WidgetRenderer interface.
+var _ fyne.WidgetRenderer = (*sheetGridRenderer)(nil)
+
+type sheetGridRenderer struct {
+	widget.BaseRenderer
+
+	grid *SheetGrid
+}
+
+func (r *sheetGridRenderer) Layout(size fyne.Size) {
+	r.grid.scroller.Resize(size)
+	r.grid.refreshCells()
+}
+
+func (r *sheetGridRenderer) MinSize() fyne.Size {
+	return r.grid.scroller.MinSize()
+}
+
+func (r *sheetGridRenderer) Refresh() {
+	r.grid.refreshCells()
+	r.grid.scroller.Refresh()
+}
+
+// Declare conformity with Layout interface.
+var _ fyne.Layout = (*sheetGridLayout)(nil)
+
+// sheetGridLayout renders the visible cells of a SheetGrid using canvas primitives,
+// recycling text and rectangle objects as the viewport moves.
+type sheetGridLayout struct {
+	grid     *SheetGrid
+	interact *sheetGridInteract
+}
+
+func newSheetGridLayout(grid *SheetGrid) *sheetGridLayout {
+	l := &sheetGridLayout{grid: grid}
+	l.interact = newSheetGridInteract(grid)
+	return l
+}
+
+func (l *sheetGridLayout) Layout([]fyne.CanvasObject, fyne.Size) {
+	l.refresh()
+}
+
+func (l *sheetGridLayout) MinSize([]fyne.CanvasObject) fyne.Size {
+	if l.grid.Length == nil {
+		return fyne.Size{}
+	}
+
+	rows, cols := l.grid.Length()
+	size := l.grid.cellSize()
+	return fyne.NewSize(float32(cols)*size.Width, float32(rows)*size.Height)
+}
+
+// visibleCells returns the half-open ranges of rows and columns inside the viewport.
+func (l *sheetGridLayout) visibleCells() (minRow, maxRow, minCol, maxCol int) {
+	g := l.grid
+	rows, cols := g.Length()
+	size := g.cellSize()
+	view := g.scroller.Size()
+	offset := g.scroller.Offset
+
+	minRow = int(offset.Y / size.Height)
+	maxRow = int((offset.Y+view.Height)/size.Height) + 1
+	minCol = int(offset.X / size.Width)
+	maxCol = int((offset.X+view.Width)/size.Width) + 1
+	if maxRow > rows {
+		maxRow = rows
+	}
+	if maxCol > cols {
+		maxCol = cols
+	}
+	return minRow, maxRow, minCol, maxCol
+}
+
+// refresh rebuilds the canvas objects for the cells currently in the viewport.
+func (l *sheetGridLayout) refresh() {
+	g := l.grid
+	if g.Length == nil || g.scroller == nil {
+		return
+	}
+
+	th := g.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	size := g.cellSize()
+	pad := th.Size(theme.SizeNamePadding)
+	offset := g.scroller.Offset
+
+	minRow, maxRow, minCol, maxCol := l.visibleCells()
+	objects := make([]fyne.CanvasObject, 0, (maxRow-minRow)*(maxCol-minCol)+4)
+
+	cell := func(row, col int, pos fyne.Position) {
+		var style *SheetGridStyle
+		if f := g.CellStyle; f != nil {
+			style = f(row, col)
+		}
+		if style == nil {
+			style = &SheetGridStyle{}
+		}
+
+		background := style.Background
+		if g.selection.contains(row, col) {
+			background = th.Color(theme.ColorNameSelection, v)
+		} else if g.filling && g.fillDst.contains(row, col) {
+			background = th.Color(theme.ColorNameHover, v)
+		}
+		if background != nil || style.BorderColor != nil {
+			rect := canvas.NewRectangle(background)
+			if style.BorderColor != nil {
+				rect.StrokeColor = style.BorderColor
+				rect.StrokeWidth = style.BorderWidth
+				if rect.StrokeWidth == 0 {
+					rect.StrokeWidth = 1
+				}
+			}
+			rect.Move(pos)
+			rect.Resize(size)
+			objects = append(objects, rect)
+		}
+
+		text := canvas.NewText(g.CellText(row, col), th.Color(theme.ColorNameForeground, v))
+		if style.TextColor != nil {
+			text.Color = style.TextColor
+		}
+		text.TextSize = th.Size(theme.SizeNameText)
+		text.TextStyle = style.TextStyle
+		text.Alignment = style.Alignment
+		text.Move(pos.Add(fyne.NewPos(pad, pad/2)))
+		text.Resize(fyne.NewSize(size.Width-pad*2, size.Height-pad))
+		objects = append(objects, text)
+	}
+
+	// scrolling cells, skipping those beneath the frozen panes
+	for row := maxInt(minRow, g.FrozenRows); row < maxRow; row++ {
+		for col := maxInt(minCol, g.FrozenCols); col < maxCol; col++ {
+			cell(row, col, fyne.NewPos(float32(col)*size.Width, float32(row)*size.Height))
+		}
+	}
+
+	// frozen columns track vertical scrolling only, frozen rows horizontal only and
+	// the frozen corner is pinned entirely
+	for row := maxInt(minRow, g.FrozenRows); row < maxRow; row++ {
+		for col := 0; col < g.FrozenCols && col < maxCol; col++ {
+			cell(row, col, fyne.NewPos(offset.X+float32(col)*size.Width, float32(row)*size.Height))
+		}
+	}
+	for row := 0; row < g.FrozenRows && row < maxRow; row++ {
+		for col := maxInt(minCol, g.FrozenCols); col < maxCol; col++ {
+			cell(row, col, fyne.NewPos(float32(col)*size.Width, offset.Y+float32(row)*size.Height))
+		}
+	}
+	for row := 0; row < g.FrozenRows && row < maxRow; row++ {
+		for col := 0; col < g.FrozenCols && col < maxCol; col++ {
+			cell(row, col, fyne.NewPos(offset.X+float32(col)*size.Width, offset.Y+float32(row)*size.Height))
+		}
+	}
+
+	// the fill handle sits on the bottom-trailing corner of the selection
+	sel := g.selection.Normalized()
+	handle := canvas.NewRectangle(th.Color(theme.ColorNamePrimary, v))
+	handle.Resize(fyne.NewSize(pad*2, pad*2))
+	handle.Move(fyne.NewPos(float32(sel.EndCol+1)*size.Width-pad, float32(sel.EndRow+1)*size.Height-pad))
+	objects = append(objects, handle)
+
+	l.interact.Resize(l.MinSize(nil))
+	objects = append(objects, l.interact)
+
+	g.content.Objects = objects
+}
+
+// Declare conformity with interfaces.
+var _ fyne.Widget = (*sheetGridInteract)(nil)
+var _ fyne.Tappable = (*sheetGridInteract)(nil)
+var _ fyne.Draggable = (*sheetGridInteract)(nil)
+
+// sheetGridInteract is a transparent widget covering the grid content that maps
+// pointer gestures onto cell selection and fill handle drags.
+type sheetGridInteract struct {
+	BaseWidget
+
+	grid *SheetGrid
+}
+
+func newSheetGridInteract(grid *SheetGrid) *sheetGridInteract {
+	i := &sheetGridInteract{grid: grid}
+	i.ExtendBaseWidget(i)
+	return i
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (i *sheetGridInteract) CreateRenderer() fyne.WidgetRenderer {
+	return NewSimpleRenderer(canvas.NewRectangle(color.Transparent))
+}
+
+// Tapped selects the cell under the pointer.
+func (i *sheetGridInteract) Tapped(ev *fyne.PointEvent) {
+	row, col := i.grid.cellAt(ev.Position)
+	i.grid.SelectRange(SheetGridRange{StartRow: row, StartCol: col, EndRow: row, EndCol: col})
+}
+
+// Dragged extends the selection, or the fill destination when the drag started on
+// the fill handle.
+func (i *sheetGridInteract) Dragged(ev *fyne.DragEvent) {
+	g := i.grid
+	row, col := g.cellAt(ev.Position)
+
+	if !g.selecting && !g.filling {
+		start := ev.Position.Subtract(fyne.NewPos(ev.Dragged.DX, ev.Dragged.DY))
+		if i.onFillHandle(start) {
+			g.filling = true
+			g.fillDst = g.selection.Normalized()
+		} else {
+			g.selecting = true
+			startRow, startCol := g.cellAt(start)
+			g.selection = SheetGridRange{StartRow: startRow, StartCol: startCol, EndRow: startRow, EndCol: startCol}
+		}
+	}
+
+	if g.filling {
+		dst := g.selection.Normalized()
+		if row > dst.EndRow {
+			dst.EndRow = row
+		}
+		if col > dst.EndCol {
+			dst.EndCol = col
+		}
+		g.fillDst = dst
+		g.refreshCells()
+		return
+	}
+
+	g.selection.EndRow, g.selection.EndCol = row, col
+	g.refreshCells()
+}
+
+// DragEnd completes a selection or fill gesture.
+func (i *sheetGridInteract) DragEnd() {
+	g := i.grid
+	if g.filling {
+		g.filling = false
+		if f := g.OnFillRange; f != nil && g.fillDst != g.selection.Normalized() {
+			f(g.selection.Normalized(), g.fillDst)
+		}
+		g.refreshCells()
+		return
+	}
+
+	g.selecting = false
+	g.SelectRange(g.selection)
+}
+
+// onFillHandle returns whether the given content position lies on the fill handle.
+func (i *sheetGridInteract) onFillHandle(pos fyne.Position) bool {
+	g := i.grid
+	size := g.cellSize()
+	pad := g.Theme().Size(theme.SizeNamePadding)
+	sel := g.selection.Normalized()
+	corner := fyne.NewPos(float32(sel.EndCol+1)*size.Width, float32(sel.EndRow+1)*size.Height)
+	return pos.X >= corner.X-pad && pos.X <= corner.X+pad &&
+		pos.Y >= corner.Y-pad && pos.Y <= corner.Y+pad
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,90 @@
+package widget
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+)
+
+func createSheetGrid() *SheetGrid {
+	grid := NewSheetGrid(
+		func() (int, int) { return 1000000, 1000 },
+		func(row, col int) string {
+			return fmt.Sprintf("%d,%d", row, col)
+		})
+	grid.CellSize = fyne.NewSize(50, 20)
+	return grid
+}
+
+func TestSheetGrid_Tap(t *testing.T) {
+	grid := createSheetGrid()
+	selected := SheetGridRange{StartRow: -1}
+	grid.OnSelectionChanged = func(sel SheetGridRange) {
+		selected = sel
+	}
+	w := test.NewTempWindow(t, grid)
+	w.Resize(fyne.NewSize(300, 200))
+
+	interact := grid.content.Layout.(*sheetGridLayout).interact
+	interact.Tapped(&fyne.PointEvent{Position: fyne.NewPos(60, 30)})
+
+	want := SheetGridRange{StartRow: 1, StartCol: 1, EndRow: 1, EndCol: 1}
+	assert.Equal(t, want, grid.Selection())
+	assert.Equal(t, want, selected)
+}
+
+func TestSheetGrid_DragSelect(t *testing.T) {
+	grid := createSheetGrid()
+	w := test.NewTempWindow(t, grid)
+	w.Resize(fyne.NewSize(300, 200))
+
+	interact := grid.content.Layout.(*sheetGridLayout).interact
+	interact.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(25, 10)},
+		Dragged:    fyne.Delta{DX: 5}})
+	interact.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(75, 30)},
+		Dragged:    fyne.Delta{DX: 50, DY: 20}})
+	interact.DragEnd()
+
+	assert.Equal(t, SheetGridRange{EndRow: 1, EndCol: 1}, grid.Selection())
+}
+
+func TestSheetGrid_FillHandle(t *testing.T) {
+	grid := createSheetGrid()
+	src, dst := SheetGridRange{}, SheetGridRange{}
+	grid.OnFillRange = func(s, d SheetGridRange) {
+		src, dst = s, d
+	}
+	w := test.NewTempWindow(t, grid)
+	w.Resize(fyne.NewSize(300, 200))
+	grid.SelectRange(SheetGridRange{EndRow: 1, EndCol: 1})
+
+	// start the drag on the fill handle at the selection corner and pull down-right
+	interact := grid.content.Layout.(*sheetGridLayout).interact
+	interact.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: fyne.NewPos(110, 45)},
+		Dragged:    fyne.Delta{DX: 10, DY: 5}})
+	interact.DragEnd()
+
+	assert.Equal(t, SheetGridRange{EndRow: 1, EndCol: 1}, src)
+	assert.Equal(t, SheetGridRange{EndRow: 2, EndCol: 2}, dst)
+}
+
+func TestSheetGrid_ScrollToCell(t *testing.T) {
+	grid := createSheetGrid()
+	w := test.NewTempWindow(t, grid)
+	w.Resize(fyne.NewSize(300, 200))
+
+	grid.ScrollToCell(500000, 500)
+	assert.Greater(t, grid.scroller.Offset.Y, float32(0))
+	assert.Greater(t, grid.scroller.Offset.X, float32(0))
+
+	grid.ScrollToCell(0, 0)
+	assert.Zero(t, grid.scroller.Offset.Y)
+	assert.Zero(t, grid.scroller.Offset.X)
+}
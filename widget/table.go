@@ -23,6 +23,7 @@ var _ desktop.Cursorable = (*Table)(nil)
 var _ fyne.Draggable = (*Table)(nil)
 var _ fyne.Focusable = (*Table)(nil)
 var _ desktop.Hoverable = (*Table)(nil)
+var _ fyne.Shortcutable = (*Table)(nil)
 var _ fyne.Tappable = (*Table)(nil)
 var _ fyne.Widget = (*Table)(nil)
 
@@ -32,6 +33,12 @@ type TableCellID struct {
 	Col int
 }
 
+// tableColumnLimits holds the interactive resize constraints of one column,
+// a zero bound leaves that side unconstrained.
+type tableColumnLimits struct {
+	min, max float32
+}
+
 // Table widget is a grid of items that can be scrolled and a cell selected.
 // Its performance is provided by caching cell templates created with CreateCell and re-using them with UpdateCell.
 // The size of the content rows/columns is returned by the Length callback.
@@ -72,12 +79,16 @@ type Table struct {
 
 	// StickyRowCount specifies how many data rows should not scroll when the content moves.
 	// If `ShowHeaderRow` us `true` then the stuck row will appear immediately underneath.
+	// Combined with `StickyColumnCount` this freezes spreadsheet-style panes that stay
+	// pinned while the body scrolls on both axes.
 	//
 	// Since: 2.4
 	StickyRowCount int
 
 	// StickyColumnCount specifies how many data columns should not scroll when the content moves.
 	// If `ShowHeaderColumn` us `true` then the stuck column will appear immediately next to the header.
+	// Combined with `StickyRowCount` this freezes spreadsheet-style panes that stay
+	// pinned while the body scrolls on both axes.
 	//
 	// Since: 2.4
 	StickyColumnCount int
@@ -87,11 +98,73 @@ type Table struct {
 	// Since: 2.5
 	HideSeparators bool
 
+	// OnSortChanged is called when the user taps a column header to change
+	// sorting, cycling through ascending, descending and unsorted.
+	// Setting it makes the header row interactive, showing an arrow indicator
+	// on the sorted column. The callback is responsible for reordering the
+	// data, the table only tracks the requested column and direction.
+	//
+	// Since: 2.6
+	OnSortChanged func(col int, order TableSort) `json:"-"`
+
+	// OnColumnResized is called when the user finishes dragging a header
+	// divider to resize a column, so apps can persist the new width.
+	//
+	// Since: 2.6
+	OnColumnResized func(col int, width float32) `json:"-"`
+
+	// OnActivated is called when the user presses Return or Enter on the
+	// cell that has keyboard focus, for triggering a default action.
+	//
+	// Since: 2.6
+	OnActivated func(id TableCellID) `json:"-"`
+
+	// SelectionBehavior configures whether a tap selects the single tapped
+	// cell, its whole row or its whole column. Row and column selections are
+	// reported through OnSelected with the other axis of the id set to -1.
+	//
+	// Since: 2.6
+	SelectionBehavior TableSelectionBehavior
+
+	// CellTextForCopy returns the text of the given cell, setting it enables
+	// copying the selected range to the clipboard as tab separated text.
+	//
+	// Since: 2.6
+	CellTextForCopy func(id TableCellID) string `json:"-"`
+
+	// OnPasteRange is called when text is pasted over the table, with the
+	// selected cell as the top-left corner of the target range and the
+	// clipboard parsed into rows of values. It is up to the app to apply the
+	// values to its data.
+	//
+	// Since: 2.6
+	OnPasteRange func(start TableCellID, values [][]string) `json:"-"`
+
+	// StyleCell allows per-cell appearance overrides such as a background
+	// color, selection color or text emphasis, without rebuilding templates.
+	// It is called after UpdateCell whenever a cell is bound, with a zero
+	// ItemStyle to fill in; fields left unset keep the themed look.
+	// Background colors are not drawn behind cells in the sticky rows or columns.
+	//
+	// Since: 2.6
+	StyleCell func(id TableCellID, template fyne.CanvasObject, style *ItemStyle) `json:"-"`
+
+	sortColumn                int
+	sortOrder                 TableSort
 	currentFocus              TableCellID
 	focused                   bool
 	selectedCell, hoveredCell *TableCellID
 	cells                     *tableCells
 	columnWidths, rowHeights  map[int]float32
+	columnWidthLimits         map[int]tableColumnLimits
+	createEditor              func(id TableCellID) fyne.CanvasObject
+	commitEdit                func(id TableCellID, editor fyne.CanvasObject)
+	editingCell               *TableCellID
+	editor                    fyne.CanvasObject
+	editCanvas                fyne.Canvas
+	editPrevKey               func(*fyne.KeyEvent)
+	typeAhead                 typeAhead
+	typeAheadCell             fyne.CanvasObject
 	moveCallback              func()
 	offset                    fyne.Position
 	content                   *widget.Scroll
@@ -187,6 +260,7 @@ func (t *Table) Dragged(e *fyne.DragEvent) {
 	row := t.dragRow
 	startPos := t.dragStartPos
 	startSize := t.dragStartSize
+	limit, hasLimit := t.columnWidthLimits[col]
 	t.propertyLock.Unlock()
 
 	if col != noCellMatch {
@@ -194,6 +268,14 @@ func (t *Table) Dragged(e *fyne.DragEvent) {
 		if newSize < min.Width {
 			newSize = min.Width
 		}
+		if hasLimit {
+			if limit.min > 0 && newSize < limit.min {
+				newSize = limit.min
+			}
+			if limit.max > 0 && newSize > limit.max {
+				newSize = limit.max
+			}
+		}
 		t.SetColumnWidth(t.dragCol, newSize)
 	}
 	if row != noCellMatch {
@@ -206,8 +288,15 @@ func (t *Table) Dragged(e *fyne.DragEvent) {
 }
 
 func (t *Table) DragEnd() {
+	col := t.dragCol
 	t.dragCol = noCellMatch
 	t.dragRow = noCellMatch
+
+	if f := t.OnColumnResized; f != nil && col != noCellMatch {
+		if width, ok := t.columnWidths[col]; ok {
+			f(col, width)
+		}
+	}
 }
 
 // FocusGained is called after this table has gained focus.
@@ -263,6 +352,28 @@ func (t *Table) RefreshItem(id TableCellID) {
 	r.(*tableCellsRenderer).refreshForID(id)
 }
 
+// ThemeInvalidated re-measures the header and cell templates when the table is
+// refreshed, as the sizes derived from them depend on the theme and rendering
+// scale.
+//
+// Since: 2.6
+func (t *Table) ThemeInvalidated() {
+	t.propertyLock.Lock()
+	t.headerSize = t.createHeader().MinSize()
+	if t.columnWidths != nil {
+		if v, ok := t.columnWidths[-1]; ok {
+			t.headerSize.Width = v
+		}
+	}
+	if t.rowHeights != nil {
+		if v, ok := t.rowHeights[-1]; ok {
+			t.headerSize.Height = v
+		}
+	}
+	t.cellSize = t.templateSize()
+	t.propertyLock.Unlock()
+}
+
 // Select will mark the specified cell as selected.
 func (t *Table) Select(id TableCellID) {
 	if t.Length == nil {
@@ -273,6 +384,7 @@ func (t *Table) Select(id TableCellID) {
 	if id.Row >= rows || id.Col >= cols {
 		return
 	}
+	id = t.normalizeSelection(id)
 
 	if t.selectedCell != nil && *t.selectedCell == id {
 		return
@@ -282,7 +394,14 @@ func (t *Table) Select(id TableCellID) {
 	}
 	t.selectedCell = &id
 
-	t.ScrollTo(id)
+	scrollTo := id
+	if scrollTo.Col < 0 { // whole row selected, keep the horizontal position
+		scrollTo.Col = t.currentFocus.Col
+	}
+	if scrollTo.Row < 0 { // whole column selected, keep the vertical position
+		scrollTo.Row = t.currentFocus.Row
+	}
+	t.ScrollTo(scrollTo)
 
 	if f := t.OnSelected; f != nil {
 		f(id)
@@ -318,6 +437,21 @@ func (t *Table) SetColumnWidth(id int, width float32) {
 	t.Refresh()
 }
 
+// SetColumnWidthConstraints limits how narrow or wide the specified column can be made
+// when the user drags its header divider. Pass 0 for either bound to leave it
+// unconstrained, the template cell minimum width always applies.
+// The constraints only apply to interactive resizing, not to SetColumnWidth.
+//
+// Since: 2.6
+func (t *Table) SetColumnWidthConstraints(id int, min, max float32) {
+	t.propertyLock.Lock()
+	if t.columnWidthLimits == nil {
+		t.columnWidthLimits = make(map[int]tableColumnLimits)
+	}
+	t.columnWidthLimits[id] = tableColumnLimits{min: min, max: max}
+	t.propertyLock.Unlock()
+}
+
 // SetRowHeight supports changing the height of the specified row. Rows normally take the height of the template
 // cell returned from the CreateCell callback. The height parameter uses the same units as a fyne.Size type and refers
 // to the internal content height not including the divider size.
@@ -367,6 +501,22 @@ func (t *Table) TypedKey(event *fyne.KeyEvent) {
 	switch event.Name {
 	case fyne.KeySpace:
 		t.Select(t.currentFocus)
+	case fyne.KeyReturn, fyne.KeyEnter:
+		if f := t.OnActivated; f != nil {
+			f(t.currentFocus)
+		}
+	case fyne.KeyHome:
+		t.focusCell(TableCellID{Row: t.currentFocus.Row, Col: 0})
+	case fyne.KeyEnd:
+		if f := t.Length; f != nil {
+			if _, cols := f(); cols > 0 {
+				t.focusCell(TableCellID{Row: t.currentFocus.Row, Col: cols - 1})
+			}
+		}
+	case fyne.KeyPageDown:
+		t.movePageFocus(t.pageRows())
+	case fyne.KeyPageUp:
+		t.movePageFocus(-t.pageRows())
 	case fyne.KeyDown:
 		if f := t.Length; f != nil {
 			rows, _ := f()
@@ -409,10 +559,114 @@ func (t *Table) TypedKey(event *fyne.KeyEvent) {
 }
 
 // TypedRune is called if a text event happens while this Table is focused.
+// Typing jumps the keyboard focus to the next cell in the focused column
+// whose text starts with the typed prefix.
 //
 // Implements: fyne.Focusable
-func (t *Table) TypedRune(_ rune) {
-	// intentionally left blank
+func (t *Table) TypedRune(r rune) {
+	prefix := t.typeAhead.add(r)
+	rows := 0
+	if f := t.Length; f != nil {
+		rows, _ = f()
+	}
+	if rows == 0 || t.CreateCell == nil || t.UpdateCell == nil {
+		return
+	}
+	if t.typeAheadCell == nil {
+		t.typeAheadCell = createItemAndApplyThemeScope(t.CreateCell, t)
+	}
+
+	for i := 0; i < rows; i++ {
+		id := TableCellID{Row: (t.currentFocus.Row + i) % rows, Col: t.currentFocus.Col}
+		t.UpdateCell(id, t.typeAheadCell)
+		if typeAheadMatches(t.typeAheadCell, prefix) {
+			t.focusCell(id)
+			return
+		}
+	}
+}
+
+// TypedShortcut is called if a shortcut event happens while this Table is
+// focused. Copy places the selected range on the clipboard when
+// CellTextForCopy is set, paste hands clipboard values to OnPasteRange, and
+// Ctrl+Home and Ctrl+End jump to the table corners.
+//
+// Implements: fyne.Shortcutable
+//
+// Since: 2.6
+func (t *Table) TypedShortcut(s fyne.Shortcut) {
+	switch sc := s.(type) {
+	case *fyne.ShortcutCopy:
+		t.copySelection(sc.Clipboard)
+		return
+	case *fyne.ShortcutPaste:
+		t.pasteSelection(sc.Clipboard)
+		return
+	}
+
+	cs, ok := s.(*desktop.CustomShortcut)
+	if !ok || cs.Modifier != fyne.KeyModifierControl {
+		return
+	}
+
+	switch cs.KeyName {
+	case fyne.KeyHome:
+		t.focusCell(TableCellID{})
+	case fyne.KeyEnd:
+		if f := t.Length; f != nil {
+			if rows, cols := f(); rows > 0 && cols > 0 {
+				t.focusCell(TableCellID{Row: rows - 1, Col: cols - 1})
+			}
+		}
+	}
+}
+
+// focusCell moves the keyboard focus to the given cell, scrolling to keep it
+// visible.
+func (t *Table) focusCell(id TableCellID) {
+	if id == t.currentFocus {
+		return
+	}
+
+	t.RefreshItem(t.currentFocus)
+	t.currentFocus = id
+	t.ScrollTo(id)
+	t.RefreshItem(id)
+}
+
+// movePageFocus shifts the keyboard focus by delta rows, clamping to the
+// table content.
+func (t *Table) movePageFocus(delta int) {
+	rows := 0
+	if f := t.Length; f != nil {
+		rows, _ = f()
+	}
+	if rows == 0 {
+		return
+	}
+
+	target := t.currentFocus.Row + delta
+	if target < 0 {
+		target = 0
+	} else if target > rows-1 {
+		target = rows - 1
+	}
+	t.focusCell(TableCellID{Row: target, Col: t.currentFocus.Col})
+}
+
+// pageRows returns how many rows fit in the current viewport, to support
+// paged navigation.
+func (t *Table) pageRows() int {
+	if t.content == nil || t.cellSize.Height <= 0 {
+		return 1
+	}
+
+	padding := t.Theme().Size(theme.SizeNamePadding)
+	count := int(t.content.Size().Height / (t.cellSize.Height + padding))
+	if count < 1 {
+		return 1
+	}
+	return count
 }
 
 // Unselect will mark the cell provided by id as unselected.
@@ -589,6 +843,13 @@ func (t *Table) Tapped(e *fyne.PointEvent) {
 		return
 	}
 
+	if t.ShowHeaderRow && t.OnSortChanged != nil && e.Position.Y < t.headerSize.Height {
+		if col := t.columnAt(e.Position); col >= 0 {
+			t.tapColumnHeader(col)
+		}
+		return
+	}
+
 	col := t.columnAt(e.Position)
 	if col == noCellMatch {
 		return // out of col range
@@ -823,7 +1084,7 @@ func (t *Table) updateHeader(id TableCellID, o fyne.CanvasObject) {
 			ids = append([]rune{'A' - 1 + rune(pre%26)}, ids...)
 			pre = (pre - pre%26) / 26
 		}
-		l.SetText(string(ids))
+		l.SetText(string(ids) + t.sortIndicator(id.Col))
 	} else if id.Col < 0 {
 		l.SetText(strconv.Itoa(id.Row + 1))
 	} else {
@@ -1107,19 +1368,8 @@ func (t *tableRenderer) MinSize() fyne.Size {
 
 func (t *tableRenderer) Refresh() {
 	th := t.t.Theme()
+	t.t.ThemeInvalidated()
 	t.t.propertyLock.Lock()
-	t.t.headerSize = t.t.createHeader().MinSize()
-	if t.t.columnWidths != nil {
-		if v, ok := t.t.columnWidths[-1]; ok {
-			t.t.headerSize.Width = v
-		}
-	}
-	if t.t.rowHeights != nil {
-		if v, ok := t.t.rowHeights[-1]; ok {
-			t.t.headerSize.Height = v
-		}
-	}
-	t.t.cellSize = t.t.templateSize()
 	t.calculateHeaderSizes(th)
 	t.t.propertyLock.Unlock()
 
@@ -1178,6 +1428,7 @@ func (c *tableCells) CreateRenderer() fyne.WidgetRenderer {
 
 	r := &tableCellsRenderer{cells: c, pool: &syncPool{}, headerPool: &syncPool{},
 		visible: make(map[TableCellID]fyne.CanvasObject), headers: make(map[TableCellID]fyne.CanvasObject),
+		styles: make(map[TableCellID]ItemStyle), backgrounds: make(map[TableCellID]*canvas.Rectangle),
 		headRowBG: canvas.NewRectangle(th.Color(theme.ColorNameHeaderBackground, v)), headColBG: canvas.NewRectangle(theme.Color(theme.ColorNameHeaderBackground)),
 		headRowStickyBG: canvas.NewRectangle(th.Color(theme.ColorNameHeaderBackground, v)), headColStickyBG: canvas.NewRectangle(theme.Color(theme.ColorNameHeaderBackground)),
 		marker: marker, hover: hover}
@@ -1200,6 +1451,8 @@ type tableCellsRenderer struct {
 	cells            *tableCells
 	pool, headerPool pool
 	visible, headers map[TableCellID]fyne.CanvasObject
+	styles           map[TableCellID]ItemStyle
+	backgrounds      map[TableCellID]*canvas.Rectangle
 	hover, marker    *canvas.Rectangle
 	dividers         []fyne.CanvasObject
 
@@ -1357,6 +1610,14 @@ func (r *tableCellsRenderer) refreshForID(toDraw TableCellID) {
 		separatorThickness, th, v)
 	cells = append(cells, inline...)
 
+	if editor := r.cells.t.editor; editor != nil {
+		if cell, ok := r.visible[*r.cells.t.editingCell]; ok {
+			editor.Move(cell.Position())
+			editor.Resize(cell.Size())
+			cells = append(cells, editor)
+		}
+	}
+
 	offX -= r.cells.t.content.Offset.X
 	cellYOffset = r.cells.t.stuckYOff
 	for row := 0; row < stickRows; row++ {
@@ -1403,12 +1664,38 @@ func (r *tableCellsRenderer) refreshForID(toDraw TableCellID) {
 			updateCell(id, cell)
 		}
 	}
+	if styleCell := r.cells.t.StyleCell; styleCell != nil {
+		for id, cell := range visible {
+			if toDraw != allTableCellsID && toDraw != id {
+				continue
+			}
+
+			style := ItemStyle{}
+			styleCell(id, cell, &style)
+			style.applyText(cell)
+			r.styles[id] = style
+		}
+	}
+	for id := range r.styles {
+		if _, ok := visible[id]; !ok {
+			delete(r.styles, id)
+		}
+	}
+	if backgrounds := r.refreshBackgrounds(visible, stickRows, stickCols); len(backgrounds) > 0 {
+		r.SetObjects(append(backgrounds, cells...))
+	}
 	for id, head := range headers {
 		r.cells.t.updateHeader(id, head)
 	}
 
 	r.moveIndicators()
-	r.marker.FillColor = th.Color(theme.ColorNameSelection, v)
+	markerColor := th.Color(theme.ColorNameSelection, v)
+	if sel := r.cells.t.selectedCell; sel != nil {
+		if style, ok := r.styles[*sel]; ok && style.SelectionColor != nil {
+			markerColor = style.SelectionColor
+		}
+	}
+	r.marker.FillColor = markerColor
 	r.marker.CornerRadius = th.Size(theme.SizeNameSelectionRadius)
 	r.marker.Refresh()
 	r.hover.FillColor = th.Color(theme.ColorNameHover, v)
@@ -1416,6 +1703,37 @@ func (r *tableCellsRenderer) refreshForID(toDraw TableCellID) {
 	r.hover.Refresh()
 }
 
+// refreshBackgrounds lays out a background rectangle behind every visible cell
+// whose StyleCell callback set a BackgroundColor, releasing the rectangles of
+// cells that scrolled away or lost their color. Cells in the sticky rows and
+// columns are skipped as they draw in separate layers above this renderer.
+func (r *tableCellsRenderer) refreshBackgrounds(visible map[TableCellID]fyne.CanvasObject, stickRows, stickCols int) []fyne.CanvasObject {
+	var backgrounds []fyne.CanvasObject
+	for id, style := range r.styles {
+		if style.BackgroundColor == nil || id.Row < stickRows || id.Col < stickCols {
+			continue
+		}
+
+		bg, ok := r.backgrounds[id]
+		if !ok {
+			bg = canvas.NewRectangle(style.BackgroundColor)
+			r.backgrounds[id] = bg
+		}
+		bg.FillColor = style.BackgroundColor
+		cell := visible[id]
+		bg.Move(cell.Position())
+		bg.Resize(cell.Size())
+		bg.Refresh()
+		backgrounds = append(backgrounds, bg)
+	}
+	for id := range r.backgrounds {
+		if style, ok := r.styles[id]; !ok || style.BackgroundColor == nil {
+			delete(r.backgrounds, id)
+		}
+	}
+	return backgrounds
+}
+
 func (r *tableCellsRenderer) moveIndicators() {
 	rows, cols := 0, 0
 	if f := r.cells.t.Length; f != nil {
@@ -1531,56 +1849,60 @@ func (r *tableCellsRenderer) moveIndicators() {
 }
 
 func (r *tableCellsRenderer) moveMarker(marker fyne.CanvasObject, row, col int, offX, offY float32, minCol, minRow int, widths, heights map[int]float32) {
-	if col == -1 || row == -1 {
+	if col == -1 && row == -1 {
 		marker.Hide()
 		marker.Refresh()
 		return
 	}
 
-	xPos := offX
-	stickCols := r.cells.t.StickyColumnCount
-	if col < stickCols {
-		if r.cells.t.ShowHeaderColumn {
-			xPos = r.cells.t.stuckXOff
-		} else {
-			xPos = 0
-		}
-		minCol = 0
-	}
-
+	size := r.cells.t.size.Load()
 	padding := r.cells.t.Theme().Size(theme.SizeNamePadding)
+	stickCols := r.cells.t.StickyColumnCount
+	stickRows := r.cells.t.StickyRowCount
 
-	for i := minCol; i < col; i++ {
-		xPos += widths[i]
-		xPos += padding
-	}
-	x1 := xPos
-	if col >= stickCols {
-		x1 -= r.cells.t.content.Offset.X
+	x1, x2 := float32(0), size.Width // a col of -1 marks the whole row
+	if col >= 0 {
+		xPos := offX
+		if col < stickCols {
+			if r.cells.t.ShowHeaderColumn {
+				xPos = r.cells.t.stuckXOff
+			} else {
+				xPos = 0
+			}
+			minCol = 0
+		}
+		for i := minCol; i < col; i++ {
+			xPos += widths[i]
+			xPos += padding
+		}
+		x1 = xPos
+		if col >= stickCols {
+			x1 -= r.cells.t.content.Offset.X
+		}
+		x2 = x1 + widths[col]
 	}
-	x2 := x1 + widths[col]
 
-	yPos := offY
-	stickRows := r.cells.t.StickyRowCount
-	if row < stickRows {
-		if r.cells.t.ShowHeaderRow {
-			yPos = r.cells.t.stuckYOff
-		} else {
-			yPos = 0
+	y1, y2 := float32(0), size.Height // a row of -1 marks the whole column
+	if row >= 0 {
+		yPos := offY
+		if row < stickRows {
+			if r.cells.t.ShowHeaderRow {
+				yPos = r.cells.t.stuckYOff
+			} else {
+				yPos = 0
+			}
+			minRow = 0
 		}
-		minRow = 0
-	}
-	for i := minRow; i < row; i++ {
-		yPos += heights[i]
-		yPos += padding
-	}
-	y1 := yPos
-	if row >= stickRows {
-		y1 -= r.cells.t.content.Offset.Y
+		for i := minRow; i < row; i++ {
+			yPos += heights[i]
+			yPos += padding
+		}
+		y1 = yPos
+		if row >= stickRows {
+			y1 -= r.cells.t.content.Offset.Y
+		}
+		y2 = y1 + heights[row]
 	}
-	y2 := y1 + heights[row]
-
-	size := r.cells.t.size.Load()
 	if x2 < 0 || x1 > size.Width || y2 < 0 || y1 > size.Height {
 		marker.Hide()
 	} else {
@@ -0,0 +1,76 @@
+package widget
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+// copySelection writes the selected cell range as tab separated text to the
+// given clipboard. Whole row or column selections copy every cell of the row
+// or column, a single cell selection copies just its text.
+func (t *Table) copySelection(cb fyne.Clipboard) {
+	text := t.CellTextForCopy
+	if text == nil || t.selectedCell == nil || t.Length == nil || cb == nil {
+		return
+	}
+
+	rows, cols := t.Length()
+	r1, r2 := t.selectedCell.Row, t.selectedCell.Row
+	if r1 == -1 { // whole column selected
+		r1, r2 = 0, rows-1
+	}
+	c1, c2 := t.selectedCell.Col, t.selectedCell.Col
+	if c1 == -1 { // whole row selected
+		c1, c2 = 0, cols-1
+	}
+
+	var sb strings.Builder
+	for row := r1; row <= r2; row++ {
+		if row > r1 {
+			sb.WriteByte('\n')
+		}
+		for col := c1; col <= c2; col++ {
+			if col > c1 {
+				sb.WriteByte('\t')
+			}
+			sb.WriteString(text(TableCellID{Row: row, Col: col}))
+		}
+	}
+	cb.SetContent(sb.String())
+}
+
+// pasteSelection parses the clipboard as rows of tab (or comma) separated
+// values and passes them to OnPasteRange with the selected cell as the
+// top-left corner of the target range.
+func (t *Table) pasteSelection(cb fyne.Clipboard) {
+	paste := t.OnPasteRange
+	if paste == nil || t.selectedCell == nil || cb == nil {
+		return
+	}
+
+	content := cb.Content()
+	if content == "" {
+		return
+	}
+
+	start := *t.selectedCell
+	if start.Row == -1 {
+		start.Row = 0
+	}
+	if start.Col == -1 {
+		start.Col = 0
+	}
+
+	separator := "\t"
+	if !strings.Contains(content, "\t") {
+		separator = ","
+	}
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	values := make([][]string, len(lines))
+	for i, line := range lines {
+		values[i] = strings.Split(strings.TrimSuffix(line, "\r"), separator)
+	}
+
+	paste(start, values)
+}
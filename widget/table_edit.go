@@ -0,0 +1,179 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// Declare conformity with the DoubleTappable interface.
+var _ fyne.DoubleTappable = (*Table)(nil)
+
+// SetEditable configures in-place editing of table cells.
+// Double tapping a cell calls createEditor to build an editor widget for it,
+// such as an Entry, Select or Check - returning nil marks the cell read-only.
+// When an edit finishes commitEdit is called with the editor so the app can
+// store the value, pressing Escape reverts instead.
+// Tab moves editing to the next editable cell in the row and Return commits
+// and moves to the cell below, like a spreadsheet.
+//
+// Since: 2.6
+func (t *Table) SetEditable(createEditor func(id TableCellID) fyne.CanvasObject,
+	commitEdit func(id TableCellID, editor fyne.CanvasObject)) {
+	t.createEditor = createEditor
+	t.commitEdit = commitEdit
+}
+
+// DoubleTapped handles double tap events, opening an editor over the tapped
+// cell when editing has been configured with SetEditable.
+//
+// Since: 2.6
+func (t *Table) DoubleTapped(e *fyne.PointEvent) {
+	if t.createEditor == nil {
+		return
+	}
+
+	col := t.columnAt(e.Position)
+	row := t.rowAt(e.Position)
+	if col < 0 || row < 0 { // negative values are header cells
+		return
+	}
+
+	t.EditCell(TableCellID{Row: row, Col: col})
+}
+
+// EditCell opens an editor over the given cell, committing any edit already
+// in progress. It does nothing if editing is not configured with SetEditable
+// or the cell is marked read-only by the createEditor callback.
+//
+// Since: 2.6
+func (t *Table) EditCell(id TableCellID) {
+	if t.createEditor == nil {
+		return
+	}
+
+	t.CommitEdit()
+	t.openEditor(id)
+}
+
+// CommitEdit closes the active cell editor, passing it to the commitEdit
+// callback so the edited value can be stored. It does nothing if no cell is
+// being edited.
+//
+// Since: 2.6
+func (t *Table) CommitEdit() {
+	t.finishEdit(true)
+}
+
+// CancelEdit closes the active cell editor without committing, reverting the
+// edit. It does nothing if no cell is being edited.
+//
+// Since: 2.6
+func (t *Table) CancelEdit() {
+	t.finishEdit(false)
+}
+
+// openEditor starts an edit session on the given cell, reporting whether the
+// createEditor callback provided an editor for it.
+func (t *Table) openEditor(id TableCellID) bool {
+	editor := t.createEditor(id)
+	if editor == nil {
+		return false
+	}
+
+	t.editingCell = &id
+	t.editor = editor
+	t.ScrollTo(id)
+	t.hookEditKeys()
+	t.Refresh()
+
+	if c := t.editCanvas; c != nil {
+		if focusable, ok := editor.(fyne.Focusable); ok {
+			c.Focus(focusable)
+		}
+	}
+	return true
+}
+
+// finishEdit ends the active edit session, committing the value if requested.
+func (t *Table) finishEdit(commit bool) {
+	if t.editingCell == nil {
+		return
+	}
+
+	id := *t.editingCell
+	editor := t.editor
+	t.editingCell = nil
+	t.editor = nil
+	if c := t.editCanvas; c != nil {
+		c.SetOnTypedKey(t.editPrevKey)
+		t.editCanvas = nil
+		t.editPrevKey = nil
+	}
+
+	if commit && t.commitEdit != nil {
+		t.commitEdit(id, editor)
+	}
+	t.Refresh()
+}
+
+// hookEditKeys intercepts key events on the table's canvas for the duration
+// of an edit session, so Tab, Return and Escape can control it no matter
+// which editor widget holds focus.
+func (t *Table) hookEditKeys() {
+	c := fyne.CurrentApp().Driver().CanvasForObject(t.super())
+	if c == nil {
+		return
+	}
+
+	t.editCanvas = c
+	t.editPrevKey = c.OnTypedKey()
+	previous := t.editPrevKey
+	c.SetOnTypedKey(func(ev *fyne.KeyEvent) {
+		switch ev.Name {
+		case fyne.KeyEscape:
+			t.CancelEdit()
+		case fyne.KeyTab:
+			t.editNext(false)
+		case fyne.KeyReturn, fyne.KeyEnter:
+			t.editNext(true)
+		default:
+			if previous != nil {
+				previous(ev)
+			}
+		}
+	})
+}
+
+// editNext commits the active edit and opens the next editable cell, the one
+// below when down is set or the next one along the row otherwise, wrapping to
+// the following row. The session simply ends when no editable cell remains.
+func (t *Table) editNext(down bool) {
+	if t.editingCell == nil {
+		return
+	}
+
+	id := *t.editingCell
+	t.CommitEdit()
+
+	rows, cols := 0, 0
+	if f := t.Length; f != nil {
+		rows, cols = t.Length()
+	}
+	for {
+		if down {
+			id.Row++
+		} else {
+			id.Col++
+			if id.Col >= cols {
+				id.Col = 0
+				id.Row++
+			}
+		}
+		if id.Row >= rows {
+			return
+		}
+
+		if t.openEditor(id) {
+			return
+		}
+	}
+}
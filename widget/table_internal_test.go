@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"image/color"
 	"testing"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
@@ -12,6 +13,7 @@ import (
 	"fyne.io/fyne/v2/theme"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTable_Empty(t *testing.T) {
@@ -83,6 +85,21 @@ func TestTable_ChangeTheme(t *testing.T) {
 	assert.Equal(t, NewLabel("placeholder").MinSize(), content.Objects()[0].(*Label).Size())
 }
 
+func TestTable_ThemeInvalidated(t *testing.T) {
+	table := NewTable(
+		func() (int, int) { return 3, 3 },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(TableCellID, fyne.CanvasObject) {})
+	w := test.NewWindow(table)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 200))
+
+	size := table.cellSize
+	table.cellSize = fyne.NewSize(1, 1) // as left stale by a theme size or scale change
+	table.Refresh()
+	assert.Equal(t, size, table.cellSize, "refresh should re-measure the cell template")
+}
+
 func TestTable_Filled(t *testing.T) {
 	test.NewTempApp(t)
 	test.ApplyTheme(t, test.Theme())
@@ -381,6 +398,48 @@ func TestTable_Refresh(t *testing.T) {
 	assert.Equal(t, "replaced", cellRenderer.(*tableCellsRenderer).Objects()[6].(*Label).Text)
 }
 
+func TestTable_StyleCell(t *testing.T) {
+	bold := fyne.TextStyle{Bold: true}
+	highlight := color.NRGBA{R: 0xff, A: 0x22}
+	selection := color.NRGBA{G: 0xff, A: 0x66}
+	styled := TableCellID{1, 1}
+	table := NewTable(
+		func() (int, int) { return 3, 3 },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(id TableCellID, obj fyne.CanvasObject) {
+			obj.(*Label).SetText(fmt.Sprintf("%d,%d", id.Row, id.Col))
+		})
+	table.StyleCell = func(id TableCellID, template fyne.CanvasObject, style *ItemStyle) {
+		if id != styled {
+			return
+		}
+		style.BackgroundColor = highlight
+		style.SelectionColor = selection
+		style.TextStyle = &bold
+	}
+	w := test.NewTempWindow(t, table)
+	w.Resize(fyne.NewSize(200, 200))
+
+	cellRenderer := test.TempWidgetRenderer(t, table.content.Content.(*tableCells)).(*tableCellsRenderer)
+	table.Refresh()
+
+	assert.Equal(t, bold, cellRenderer.visible[styled].(*Label).TextStyle)
+	assert.False(t, cellRenderer.visible[TableCellID{0, 0}].(*Label).TextStyle.Bold)
+	if assert.Len(t, cellRenderer.backgrounds, 1) {
+		bg := cellRenderer.backgrounds[styled]
+		assert.Equal(t, highlight, bg.FillColor)
+		assert.Equal(t, cellRenderer.visible[styled].Position(), bg.Position())
+		assert.Equal(t, bg, cellRenderer.Objects()[0], "backgrounds draw beneath the cells")
+	}
+
+	table.Select(styled)
+	table.Refresh()
+	assert.Equal(t, selection, cellRenderer.marker.FillColor)
+	table.UnselectAll()
+	table.Refresh()
+	assert.Equal(t, theme.Color(theme.ColorNameSelection), cellRenderer.marker.FillColor)
+}
+
 func TestTable_ScrollTo(t *testing.T) {
 	test.NewTempApp(t)
 
@@ -916,3 +975,328 @@ func areaContainsLabel(list []fyne.CanvasObject, text string) bool {
 	}
 	return false
 }
+
+func TestTable_ColumnSort(t *testing.T) {
+	table := NewTableWithHeaders(
+		func() (int, int) { return 3, 3 },
+		func() fyne.CanvasObject { return NewLabel("") },
+		func(id TableCellID, template fyne.CanvasObject) {
+			template.(*Label).SetText(fmt.Sprintf("%d,%d", id.Row, id.Col))
+		})
+
+	type sortChange struct {
+		col   int
+		order TableSort
+	}
+	var changes []sortChange
+	table.OnSortChanged = func(col int, order TableSort) {
+		changes = append(changes, sortChange{col, order})
+	}
+	w := test.NewTempWindow(t, table)
+	w.Resize(fyne.NewSize(300, 200))
+
+	// tapping a header cycles ascending, descending, unsorted
+	table.tapColumnHeader(1)
+	col, order := table.ColumnSort()
+	assert.Equal(t, 1, col)
+	assert.Equal(t, TableSortAscending, order)
+
+	table.tapColumnHeader(1)
+	_, order = table.ColumnSort()
+	assert.Equal(t, TableSortDescending, order)
+
+	table.tapColumnHeader(1)
+	_, order = table.ColumnSort()
+	assert.Equal(t, TableSortNone, order)
+
+	// moving to another column starts ascending again
+	table.tapColumnHeader(0)
+	col, order = table.ColumnSort()
+	assert.Equal(t, 0, col)
+	assert.Equal(t, TableSortAscending, order)
+
+	assert.Equal(t, []sortChange{
+		{1, TableSortAscending}, {1, TableSortDescending}, {1, TableSortNone},
+		{0, TableSortAscending}}, changes)
+}
+
+func TestTable_ColumnSort_Indicator(t *testing.T) {
+	table := NewTableWithHeaders(
+		func() (int, int) { return 3, 3 },
+		func() fyne.CanvasObject { return NewLabel("") },
+		func(id TableCellID, template fyne.CanvasObject) {})
+	table.OnSortChanged = func(int, TableSort) {}
+	w := test.NewTempWindow(t, table)
+	w.Resize(fyne.NewSize(300, 200))
+
+	header := NewLabel("")
+	table.SetColumnSort(1, TableSortAscending)
+	table.updateHeader(TableCellID{Row: -1, Col: 1}, header)
+	assert.Equal(t, "B ▲", header.Text)
+
+	table.SetColumnSort(1, TableSortDescending)
+	table.updateHeader(TableCellID{Row: -1, Col: 1}, header)
+	assert.Equal(t, "B ▼", header.Text)
+
+	table.updateHeader(TableCellID{Row: -1, Col: 0}, header)
+	assert.Equal(t, "A", header.Text)
+
+	table.SetColumnSort(1, TableSortNone)
+	table.updateHeader(TableCellID{Row: -1, Col: 1}, header)
+	assert.Equal(t, "B", header.Text)
+}
+
+func TestTable_ColumnSort_TapHeader(t *testing.T) {
+	table := NewTableWithHeaders(
+		func() (int, int) { return 3, 3 },
+		func() fyne.CanvasObject { return NewLabel("") },
+		func(id TableCellID, template fyne.CanvasObject) {})
+	sorted := -1
+	table.OnSortChanged = func(col int, order TableSort) {
+		sorted = col
+	}
+	w := test.NewTempWindow(t, table)
+	w.Resize(fyne.NewSize(300, 200))
+
+	headerHeight := table.headerSize.Height
+	x, width := table.findX(0)
+	table.Tapped(&fyne.PointEvent{Position: fyne.NewPos(table.stuckXOff+x+width/2, headerHeight/2)})
+	assert.Equal(t, 0, sorted)
+	assert.Nil(t, table.selectedCell, "a header tap should not select a cell")
+}
+
+func TestTable_ResizeColumn_Drag(t *testing.T) {
+	table := NewTableWithHeaders(
+		func() (int, int) { return 3, 3 },
+		func() fyne.CanvasObject { return NewLabel("placeholder") },
+		func(id TableCellID, template fyne.CanvasObject) {})
+	table.SetColumnWidthConstraints(1, 100, 150)
+	resizedCol := -1
+	resizedWidth := float32(0)
+	table.OnColumnResized = func(col int, width float32) {
+		resizedCol = col
+		resizedWidth = width
+	}
+	w := test.NewTempWindow(t, table)
+	w.Resize(fyne.NewSize(300, 200))
+
+	table.dragCol = 1
+	table.dragStartPos = fyne.NewPos(100, 10)
+	table.dragStartSize = 120
+
+	table.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(110, 10)}})
+	assert.Equal(t, float32(130), table.columnWidths[1])
+
+	table.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(300, 10)}})
+	assert.Equal(t, float32(150), table.columnWidths[1], "width clamps to the maximum constraint")
+
+	table.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(50, 10)}})
+	assert.Equal(t, float32(100), table.columnWidths[1], "width clamps to the minimum constraint")
+
+	table.DragEnd()
+	assert.Equal(t, 1, resizedCol)
+	assert.Equal(t, float32(100), resizedWidth)
+	assert.Equal(t, noCellMatch, table.dragCol)
+}
+
+func TestTable_EditCell(t *testing.T) {
+	data := [][]string{{"a", "b", "c"}, {"d", "e", "f"}}
+	table := NewTable(
+		func() (int, int) { return 2, 3 },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(id TableCellID, template fyne.CanvasObject) {
+			template.(*Label).SetText(data[id.Row][id.Col])
+		})
+	table.SetEditable(
+		func(id TableCellID) fyne.CanvasObject {
+			if id.Col == 2 {
+				return nil // read-only column
+			}
+			entry := NewEntry()
+			entry.SetText(data[id.Row][id.Col])
+			return entry
+		},
+		func(id TableCellID, editor fyne.CanvasObject) {
+			data[id.Row][id.Col] = editor.(*Entry).Text
+		})
+	w := test.NewTempWindow(t, table)
+	w.Resize(fyne.NewSize(300, 200))
+
+	table.EditCell(TableCellID{Row: 0, Col: 0})
+	require.NotNil(t, table.editingCell)
+	table.editor.(*Entry).SetText("edited")
+
+	table.editNext(false) // Tab commits and moves along the row
+	assert.Equal(t, "edited", data[0][0])
+	assert.Equal(t, TableCellID{Row: 0, Col: 1}, *table.editingCell)
+
+	table.editor.(*Entry).SetText("second")
+	table.editNext(false) // the read-only column is skipped, wrapping to the next row
+	assert.Equal(t, "second", data[0][1])
+	assert.Equal(t, TableCellID{Row: 1, Col: 0}, *table.editingCell)
+
+	table.editor.(*Entry).SetText("dropped")
+	table.CancelEdit() // Escape reverts the value
+	assert.Nil(t, table.editingCell)
+	assert.Equal(t, "d", data[1][0])
+}
+
+func TestTable_EditCell_DoubleTapAndKeys(t *testing.T) {
+	value := "before"
+	table := NewTable(
+		func() (int, int) { return 2, 2 },
+		func() fyne.CanvasObject { return NewLabel("template") },
+		func(id TableCellID, template fyne.CanvasObject) {})
+	table.SetEditable(
+		func(id TableCellID) fyne.CanvasObject {
+			entry := NewEntry()
+			entry.SetText(value)
+			return entry
+		},
+		func(id TableCellID, editor fyne.CanvasObject) {
+			value = editor.(*Entry).Text
+		})
+	w := test.NewTempWindow(t, table)
+	w.Resize(fyne.NewSize(200, 200))
+
+	table.DoubleTapped(&fyne.PointEvent{Position: fyne.NewPos(10, 10)})
+	require.NotNil(t, table.editingCell)
+	assert.Equal(t, TableCellID{Row: 0, Col: 0}, *table.editingCell)
+
+	table.editor.(*Entry).SetText("after")
+	w.Canvas().OnTypedKey()(&fyne.KeyEvent{Name: fyne.KeyReturn}) // Return commits and moves down
+	assert.Equal(t, "after", value)
+	require.NotNil(t, table.editingCell)
+	assert.Equal(t, TableCellID{Row: 1, Col: 0}, *table.editingCell)
+
+	w.Canvas().OnTypedKey()(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	assert.Nil(t, table.editingCell)
+	assert.Nil(t, w.Canvas().OnTypedKey(), "the key hook is removed when editing ends")
+}
+
+func TestTable_KeyboardNavigation_HomeEndActivate(t *testing.T) {
+	table := NewTable(
+		func() (int, int) { return 8, 5 },
+		func() fyne.CanvasObject { return NewLabel("placeholder") },
+		func(TableCellID, fyne.CanvasObject) {})
+	activated := TableCellID{Row: -1, Col: -1}
+	table.OnActivated = func(id TableCellID) {
+		activated = id
+	}
+	w := test.NewTempWindow(t, table)
+	w.Resize(fyne.NewSize(300, 200))
+
+	table.currentFocus = TableCellID{Row: 2, Col: 2}
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEnd})
+	assert.Equal(t, TableCellID{Row: 2, Col: 4}, table.currentFocus)
+
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeyHome})
+	assert.Equal(t, TableCellID{Row: 2, Col: 0}, table.currentFocus)
+
+	table.TypedShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyEnd, Modifier: fyne.KeyModifierControl})
+	assert.Equal(t, TableCellID{Row: 7, Col: 4}, table.currentFocus)
+
+	table.TypedShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyHome, Modifier: fyne.KeyModifierControl})
+	assert.Equal(t, TableCellID{}, table.currentFocus)
+
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, TableCellID{}, activated)
+}
+
+func TestTable_TypeAhead(t *testing.T) {
+	values := []string{"apple", "banana", "blueberry", "cherry"}
+	table := NewTable(
+		func() (int, int) { return len(values), 1 },
+		func() fyne.CanvasObject { return NewLabel("placeholder") },
+		func(id TableCellID, template fyne.CanvasObject) {
+			template.(*Label).SetText(values[id.Row])
+		})
+	w := test.NewTempWindow(t, table)
+	w.Resize(fyne.NewSize(200, 200))
+
+	table.TypedRune('b')
+	assert.Equal(t, TableCellID{Row: 1, Col: 0}, table.currentFocus)
+
+	table.TypedRune('l') // extends the prefix to "bl" within the timeout
+	assert.Equal(t, TableCellID{Row: 2, Col: 0}, table.currentFocus)
+
+	table.typeAhead.lastKey = time.Now().Add(-2 * typeAheadTimeout)
+	table.TypedRune('c') // a new search starts after a pause
+	assert.Equal(t, TableCellID{Row: 3, Col: 0}, table.currentFocus)
+}
+
+func TestTable_SelectionBehavior(t *testing.T) {
+	table := NewTable(
+		func() (int, int) { return 3, 3 },
+		func() fyne.CanvasObject { return NewLabel("placeholder") },
+		func(TableCellID, fyne.CanvasObject) {})
+	table.SelectionBehavior = TableSelectionRow
+	var selected []TableCellID
+	table.OnSelected = func(id TableCellID) {
+		selected = append(selected, id)
+	}
+	w := test.NewTempWindow(t, table)
+	w.Resize(fyne.NewSize(200, 200))
+
+	table.Select(TableCellID{Row: 1, Col: 1})
+	require.Len(t, selected, 1)
+	assert.Equal(t, TableCellID{Row: 1, Col: -1}, selected[0])
+	assert.Equal(t, TableCellID{Row: 1, Col: -1}, *table.selectedCell)
+
+	table.Select(TableCellID{Row: 1, Col: 2}) // still the same row, no change
+	assert.Len(t, selected, 1)
+
+	table.SelectionBehavior = TableSelectionColumn
+	table.Select(TableCellID{Row: 0, Col: 2})
+	require.Len(t, selected, 2)
+	assert.Equal(t, TableCellID{Row: -1, Col: 2}, selected[1])
+
+	table.SelectionBehavior = TableSelectionCell
+	table.Select(TableCellID{Row: 2, Col: 0})
+	require.Len(t, selected, 3)
+	assert.Equal(t, TableCellID{Row: 2, Col: 0}, selected[2])
+}
+
+func TestTable_CopyPasteSelection(t *testing.T) {
+	table := NewTable(
+		func() (int, int) { return 3, 3 },
+		func() fyne.CanvasObject { return NewLabel("placeholder") },
+		func(TableCellID, fyne.CanvasObject) {})
+	table.CellTextForCopy = func(id TableCellID) string {
+		return fmt.Sprintf("%d-%d", id.Row, id.Col)
+	}
+	var pastedStart TableCellID
+	var pastedValues [][]string
+	table.OnPasteRange = func(start TableCellID, values [][]string) {
+		pastedStart = start
+		pastedValues = values
+	}
+	w := test.NewTempWindow(t, table)
+	w.Resize(fyne.NewSize(200, 200))
+	clipboard := test.NewClipboard()
+
+	table.Select(TableCellID{Row: 1, Col: 1})
+	table.TypedShortcut(&fyne.ShortcutCopy{Clipboard: clipboard})
+	assert.Equal(t, "1-1", clipboard.Content())
+
+	table.SelectionBehavior = TableSelectionRow
+	table.Select(TableCellID{Row: 0, Col: 0})
+	table.TypedShortcut(&fyne.ShortcutCopy{Clipboard: clipboard})
+	assert.Equal(t, "0-0\t0-1\t0-2", clipboard.Content())
+
+	table.SelectionBehavior = TableSelectionColumn
+	table.Select(TableCellID{Row: 0, Col: 2})
+	table.TypedShortcut(&fyne.ShortcutCopy{Clipboard: clipboard})
+	assert.Equal(t, "0-2\n1-2\n2-2", clipboard.Content())
+
+	table.SelectionBehavior = TableSelectionCell
+	table.Select(TableCellID{Row: 1, Col: 0})
+	clipboard.SetContent("a\tb\nc\td\n")
+	table.TypedShortcut(&fyne.ShortcutPaste{Clipboard: clipboard})
+	assert.Equal(t, TableCellID{Row: 1, Col: 0}, pastedStart)
+	assert.Equal(t, [][]string{{"a", "b"}, {"c", "d"}}, pastedValues)
+
+	clipboard.SetContent("x,y") // comma separated values also paste
+	table.TypedShortcut(&fyne.ShortcutPaste{Clipboard: clipboard})
+	assert.Equal(t, [][]string{{"x", "y"}}, pastedValues)
+}
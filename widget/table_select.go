@@ -0,0 +1,37 @@
+package widget
+
+// TableSelectionBehavior describes what a tap in a Table selects.
+//
+// Since: 2.6
+type TableSelectionBehavior int
+
+const (
+	// TableSelectionCell selects the single tapped cell, the default.
+	//
+	// Since: 2.6
+	TableSelectionCell TableSelectionBehavior = iota
+
+	// TableSelectionRow selects the whole row of the tapped cell.
+	// Selected ids are reported with a Col value of -1.
+	//
+	// Since: 2.6
+	TableSelectionRow
+
+	// TableSelectionColumn selects the whole column of the tapped cell.
+	// Selected ids are reported with a Row value of -1.
+	//
+	// Since: 2.6
+	TableSelectionColumn
+)
+
+// normalizeSelection widens the given cell to a whole row or column
+// according to the table's SelectionBehavior.
+func (t *Table) normalizeSelection(id TableCellID) TableCellID {
+	switch t.SelectionBehavior {
+	case TableSelectionRow:
+		id.Col = -1
+	case TableSelectionColumn:
+		id.Row = -1
+	}
+	return id
+}
@@ -0,0 +1,81 @@
+package widget
+
+// TableSort describes how a table column is sorted.
+//
+// Since: 2.6
+type TableSort int
+
+const (
+	// TableSortNone means a column is not sorted.
+	//
+	// Since: 2.6
+	TableSortNone TableSort = iota
+
+	// TableSortAscending means a column is sorted from the smallest value up.
+	//
+	// Since: 2.6
+	TableSortAscending
+
+	// TableSortDescending means a column is sorted from the largest value down.
+	//
+	// Since: 2.6
+	TableSortDescending
+)
+
+// SetColumnSort updates which column the header row shows a sort indicator
+// for, and its direction. Pass TableSortNone to remove the indicator.
+// The sorting of the data itself is up to the application, usually from an
+// OnSortChanged callback.
+//
+// Since: 2.6
+func (t *Table) SetColumnSort(col int, order TableSort) {
+	t.sortColumn = col
+	t.sortOrder = order
+	t.Refresh()
+}
+
+// ColumnSort returns the column the header row shows a sort indicator for
+// and its direction, TableSortNone means no column is sorted.
+//
+// Since: 2.6
+func (t *Table) ColumnSort() (col int, order TableSort) {
+	return t.sortColumn, t.sortOrder
+}
+
+// tapColumnHeader cycles the sort of the tapped column through ascending,
+// descending and unsorted, notifying OnSortChanged.
+func (t *Table) tapColumnHeader(col int) {
+	f := t.OnSortChanged
+	if f == nil {
+		return
+	}
+
+	order := TableSortAscending
+	if t.sortColumn == col {
+		switch t.sortOrder {
+		case TableSortAscending:
+			order = TableSortDescending
+		case TableSortDescending:
+			order = TableSortNone
+		}
+	}
+
+	t.SetColumnSort(col, order)
+	f(col, order)
+}
+
+// sortIndicator returns the arrow appended to the given column's default
+// header content, or an empty string when the column is not sorted.
+func (t *Table) sortIndicator(col int) string {
+	if col != t.sortColumn {
+		return ""
+	}
+
+	switch t.sortOrder {
+	case TableSortAscending:
+		return " ▲"
+	case TableSortDescending:
+		return " ▼"
+	}
+	return ""
+}
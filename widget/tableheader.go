@@ -0,0 +1,460 @@
+package widget
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+)
+
+// tableFilterDateFormat is the layout filter popovers parse dates with.
+const tableFilterDateFormat = "2006-01-02"
+
+// TableColumnType describes the kind of data a TableHeader column holds, so
+// its filter popover can offer the matching controls.
+//
+// Since: 2.6
+type TableColumnType int
+
+const (
+	// TableColumnText columns filter on a contained substring.
+	//
+	// Since: 2.6
+	TableColumnText TableColumnType = iota
+
+	// TableColumnNumber columns filter on a numeric range.
+	//
+	// Since: 2.6
+	TableColumnNumber
+
+	// TableColumnDate columns filter on a date range.
+	//
+	// Since: 2.6
+	TableColumnDate
+)
+
+// TableHeaderColumn describes one column shown by a TableHeader.
+//
+// Since: 2.6
+type TableHeaderColumn struct {
+	// Title is shown on the column's sort toggle.
+	Title string
+	// Type selects the filter controls offered for the column.
+	Type TableColumnType
+}
+
+// TableFilter is the active filter of one TableHeader column, only the
+// fields matching the column type are used.
+//
+// Since: 2.6
+type TableFilter struct {
+	// Col is the column this filter applies to.
+	Col int
+	// Type is the type of the filtered column.
+	Type TableColumnType
+
+	// Text is the substring text columns must contain, case insensitively.
+	Text string
+	// Min and Max bound number columns when HasMin or HasMax are set.
+	Min, Max       float64
+	HasMin, HasMax bool
+	// From and To bound date columns when non-zero.
+	From, To time.Time
+}
+
+// Matches returns whether the given cell value passes this filter, parsing it
+// according to the column type. Unparseable values do not match.
+func (f *TableFilter) Matches(value string) bool {
+	switch f.Type {
+	case TableColumnNumber:
+		n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return false
+		}
+		return (!f.HasMin || n >= f.Min) && (!f.HasMax || n <= f.Max)
+	case TableColumnDate:
+		d, err := time.Parse(tableFilterDateFormat, strings.TrimSpace(value))
+		if err != nil {
+			return false
+		}
+		return (f.From.IsZero() || !d.Before(f.From)) && (f.To.IsZero() || !d.After(f.To))
+	}
+
+	return strings.Contains(strings.ToLower(value), strings.ToLower(f.Text))
+}
+
+// active returns whether the filter restricts its column at all.
+func (f *TableFilter) active() bool {
+	switch f.Type {
+	case TableColumnNumber:
+		return f.HasMin || f.HasMax
+	case TableColumnDate:
+		return !f.From.IsZero() || !f.To.IsZero()
+	}
+	return f.Text != ""
+}
+
+// summary returns the text shown on the filter's chip.
+func (f *TableFilter) summary(title string) string {
+	switch f.Type {
+	case TableColumnNumber:
+		if f.HasMin && f.HasMax {
+			return fmt.Sprintf("%s: %v – %v", title, f.Min, f.Max)
+		} else if f.HasMin {
+			return fmt.Sprintf("%s: ≥ %v", title, f.Min)
+		}
+		return fmt.Sprintf("%s: ≤ %v", title, f.Max)
+	case TableColumnDate:
+		from, to := "", ""
+		if !f.From.IsZero() {
+			from = f.From.Format(tableFilterDateFormat)
+		}
+		if !f.To.IsZero() {
+			to = f.To.Format(tableFilterDateFormat)
+		}
+		if from != "" && to != "" {
+			return fmt.Sprintf("%s: %s – %s", title, from, to)
+		} else if from != "" {
+			return fmt.Sprintf("%s: from %s", title, from)
+		}
+		return fmt.Sprintf("%s: until %s", title, to)
+	}
+
+	return fmt.Sprintf("%s: contains %q", title, f.Text)
+}
+
+// Declare conformity with the Widget interface.
+var _ fyne.Widget = (*TableHeader)(nil)
+
+// TableHeader is a data-grid shell intended to sit above a Table, offering a
+// sort toggle and a type-aware filter popover per column and a chip row
+// summarising the active filters. It emits the combined sort and filter state
+// through OnChanged so the data layer can reorder and restrict its rows.
+//
+// Since: 2.6
+type TableHeader struct {
+	BaseWidget
+
+	// Columns describe the titles and data types of the header columns.
+	Columns []TableHeaderColumn
+	// OnChanged is called with the full sort and filter state whenever a sort
+	// toggle or filter changes.
+	OnChanged func(sortCol int, order TableSort, filters []TableFilter) `json:"-"`
+
+	sortColumn int
+	sortOrder  TableSort
+	filters    map[int]TableFilter
+}
+
+// NewTableHeader creates a header shell with the given columns.
+//
+// Since: 2.6
+func NewTableHeader(columns ...TableHeaderColumn) *TableHeader {
+	h := &TableHeader{Columns: columns, filters: make(map[int]TableFilter)}
+	h.ExtendBaseWidget(h)
+	return h
+}
+
+// Sort returns the currently sorted column and direction, TableSortNone
+// means no column is sorted.
+func (h *TableHeader) Sort() (col int, order TableSort) {
+	return h.sortColumn, h.sortOrder
+}
+
+// SetSort updates the sorted column and its indicator without firing OnChanged.
+func (h *TableHeader) SetSort(col int, order TableSort) {
+	h.sortColumn = col
+	h.sortOrder = order
+	h.Refresh()
+}
+
+// Filters returns the active filters in column order.
+func (h *TableHeader) Filters() []TableFilter {
+	filters := make([]TableFilter, 0, len(h.filters))
+	for col := range h.Columns {
+		if f, ok := h.filters[col]; ok {
+			filters = append(filters, f)
+		}
+	}
+	return filters
+}
+
+// SetFilter applies the given column filter, replacing any existing one.
+// Filters that do not restrict anything are removed, so this also clears.
+func (h *TableHeader) SetFilter(f TableFilter) {
+	if f.active() {
+		h.filters[f.Col] = f
+	} else {
+		delete(h.filters, f.Col)
+	}
+	h.Refresh()
+	h.emitChange()
+}
+
+// ClearFilter removes the filter of the given column.
+func (h *TableHeader) ClearFilter(col int) {
+	if _, ok := h.filters[col]; !ok {
+		return
+	}
+
+	delete(h.filters, col)
+	h.Refresh()
+	h.emitChange()
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (h *TableHeader) CreateRenderer() fyne.WidgetRenderer {
+	h.ExtendBaseWidget(h)
+	r := &tableHeaderRenderer{header: h}
+	r.chips = &fyne.Container{Layout: layout.NewHBoxLayout()}
+	r.rebuild()
+	return r
+}
+
+// toggleSort cycles the sort of the given column through ascending,
+// descending and unsorted, like the built-in Table header.
+func (h *TableHeader) toggleSort(col int) {
+	order := TableSortAscending
+	if h.sortColumn == col {
+		switch h.sortOrder {
+		case TableSortAscending:
+			order = TableSortDescending
+		case TableSortDescending:
+			order = TableSortNone
+		}
+	}
+
+	h.SetSort(col, order)
+	h.emitChange()
+}
+
+func (h *TableHeader) emitChange() {
+	if f := h.OnChanged; f != nil {
+		f(h.sortColumn, h.sortOrder, h.Filters())
+	}
+}
+
+func (h *TableHeader) sortIndicator(col int) string {
+	if col != h.sortColumn {
+		return ""
+	}
+	switch h.sortOrder {
+	case TableSortAscending:
+		return " ▲"
+	case TableSortDescending:
+		return " ▼"
+	}
+	return ""
+}
+
+// showFilterPopover pops up the filter editor of the given column below its
+// header button, with controls matching the column type.
+func (h *TableHeader) showFilterPopover(col int, near fyne.CanvasObject) {
+	c := fyne.CurrentApp().Driver().CanvasForObject(h.super())
+	if c == nil {
+		return
+	}
+
+	column := h.Columns[col]
+	current := h.filters[col]
+	var pop *PopUp
+	apply := func(f TableFilter) {
+		pop.Hide()
+		f.Col = col
+		f.Type = column.Type
+		h.SetFilter(f)
+	}
+
+	var fields []fyne.CanvasObject
+	var collect func() TableFilter
+	switch column.Type {
+	case TableColumnNumber:
+		min := NewEntry()
+		min.SetPlaceHolder("Minimum")
+		max := NewEntry()
+		max.SetPlaceHolder("Maximum")
+		if current.HasMin {
+			min.SetText(strconv.FormatFloat(current.Min, 'f', -1, 64))
+		}
+		if current.HasMax {
+			max.SetText(strconv.FormatFloat(current.Max, 'f', -1, 64))
+		}
+		fields = []fyne.CanvasObject{min, max}
+		collect = func() (f TableFilter) {
+			if n, err := strconv.ParseFloat(min.Text, 64); err == nil {
+				f.Min, f.HasMin = n, true
+			}
+			if n, err := strconv.ParseFloat(max.Text, 64); err == nil {
+				f.Max, f.HasMax = n, true
+			}
+			return f
+		}
+	case TableColumnDate:
+		from := NewEntry()
+		from.SetPlaceHolder(tableFilterDateFormat)
+		to := NewEntry()
+		to.SetPlaceHolder(tableFilterDateFormat)
+		if !current.From.IsZero() {
+			from.SetText(current.From.Format(tableFilterDateFormat))
+		}
+		if !current.To.IsZero() {
+			to.SetText(current.To.Format(tableFilterDateFormat))
+		}
+		fields = []fyne.CanvasObject{from, to}
+		collect = func() (f TableFilter) {
+			if d, err := time.Parse(tableFilterDateFormat, from.Text); err == nil {
+				f.From = d
+			}
+			if d, err := time.Parse(tableFilterDateFormat, to.Text); err == nil {
+				f.To = d
+			}
+			return f
+		}
+	default:
+		contains := NewEntry()
+		contains.SetPlaceHolder("Contains")
+		contains.SetText(current.Text)
+		contains.OnSubmitted = func(string) {
+			apply(TableFilter{Text: contains.Text})
+		}
+		fields = []fyne.CanvasObject{contains}
+		collect = func() TableFilter {
+			return TableFilter{Text: contains.Text}
+		}
+	}
+
+	clear := NewButton("Clear", func() {
+		apply(TableFilter{})
+	})
+	ok := NewButton("Apply", func() {
+		apply(collect())
+	})
+	ok.Importance = HighImportance
+	buttons := &fyne.Container{Layout: layout.NewHBoxLayout(),
+		Objects: []fyne.CanvasObject{layout.NewSpacer(), clear, ok}}
+
+	content := &fyne.Container{Layout: layout.NewVBoxLayout(),
+		Objects: append(fields, buttons)}
+	pop = NewPopUp(content, c)
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(near)
+	pop.ShowAtPosition(pos.AddXY(0, near.Size().Height))
+}
+
+var _ fyne.WidgetRenderer = (*tableHeaderRenderer)(nil)
+
+type tableHeaderRenderer struct {
+	header *TableHeader
+
+	sorts      []*Button
+	filterBtns []*Button
+	chips      *fyne.Container
+}
+
+// rebuild creates the sort toggle and filter button of every column.
+func (r *tableHeaderRenderer) rebuild() {
+	h := r.header
+	r.sorts = make([]*Button, len(h.Columns))
+	r.filterBtns = make([]*Button, len(h.Columns))
+	for i, col := range h.Columns {
+		index := i
+		r.sorts[i] = NewButton(col.Title, func() {
+			h.toggleSort(index)
+		})
+		r.filterBtns[i] = NewButtonWithIcon("", theme.SearchIcon(), func() {
+			h.showFilterPopover(index, r.filterBtns[index])
+		})
+	}
+	r.Refresh()
+}
+
+func (r *tableHeaderRenderer) Destroy() {
+}
+
+func (r *tableHeaderRenderer) Layout(size fyne.Size) {
+	h := r.header
+	cols := len(h.Columns)
+	if cols == 0 {
+		return
+	}
+
+	rowHeight := r.rowHeight()
+	colWidth := size.Width / float32(cols)
+	for i, sort := range r.sorts {
+		filter := r.filterBtns[i]
+		filterWidth := filter.MinSize().Width
+		x := float32(i) * colWidth
+
+		sort.Move(fyne.NewPos(x, 0))
+		sort.Resize(fyne.NewSize(colWidth-filterWidth, rowHeight))
+		filter.Move(fyne.NewPos(x+colWidth-filterWidth, 0))
+		filter.Resize(fyne.NewSize(filterWidth, rowHeight))
+	}
+
+	r.chips.Move(fyne.NewPos(0, rowHeight))
+	r.chips.Resize(fyne.NewSize(size.Width, r.chips.MinSize().Height))
+}
+
+func (r *tableHeaderRenderer) MinSize() fyne.Size {
+	min := fyne.NewSize(0, r.rowHeight())
+	for i, sort := range r.sorts {
+		min.Width += sort.MinSize().Width + r.filterBtns[i].MinSize().Width
+	}
+	if len(r.chips.Objects) > 0 {
+		chips := r.chips.MinSize()
+		min.Height += chips.Height
+		min.Width = fyne.Max(min.Width, chips.Width)
+	}
+	return min
+}
+
+func (r *tableHeaderRenderer) Objects() []fyne.CanvasObject {
+	objects := make([]fyne.CanvasObject, 0, len(r.sorts)*2+1)
+	for i, sort := range r.sorts {
+		objects = append(objects, sort, r.filterBtns[i])
+	}
+	return append(objects, r.chips)
+}
+
+func (r *tableHeaderRenderer) Refresh() {
+	h := r.header
+	if len(r.sorts) != len(h.Columns) {
+		r.rebuild()
+		return
+	}
+
+	for i, sort := range r.sorts {
+		sort.SetText(h.Columns[i].Title + h.sortIndicator(i))
+		if _, ok := h.filters[i]; ok {
+			r.filterBtns[i].Importance = HighImportance
+		} else {
+			r.filterBtns[i].Importance = MediumImportance
+		}
+		r.filterBtns[i].Refresh()
+	}
+
+	r.chips.Objects = nil
+	for _, f := range h.Filters() {
+		filter := f
+		chip := NewButtonWithIcon(f.summary(h.Columns[f.Col].Title), theme.CancelIcon(), func() {
+			h.ClearFilter(filter.Col)
+		})
+		chip.Importance = LowImportance
+		r.chips.Objects = append(r.chips.Objects, chip)
+	}
+	r.chips.Refresh()
+
+	r.Layout(h.Size())
+}
+
+func (r *tableHeaderRenderer) rowHeight() float32 {
+	height := float32(0)
+	for i, sort := range r.sorts {
+		height = fyne.Max(height, sort.MinSize().Height)
+		height = fyne.Max(height, r.filterBtns[i].MinSize().Height)
+	}
+	return height
+}
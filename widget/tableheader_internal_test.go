@@ -0,0 +1,99 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2/test"
+)
+
+func TestTableHeader_ToggleSort(t *testing.T) {
+	header := NewTableHeader(
+		TableHeaderColumn{Title: "Name"},
+		TableHeaderColumn{Title: "Amount", Type: TableColumnNumber})
+
+	var gotCol int
+	var gotOrder TableSort
+	changes := 0
+	header.OnChanged = func(col int, order TableSort, filters []TableFilter) {
+		gotCol, gotOrder = col, order
+		changes++
+	}
+
+	w := test.NewWindow(header)
+	defer w.Close()
+
+	r := test.WidgetRenderer(header).(*tableHeaderRenderer)
+	r.sorts[1].OnTapped()
+	assert.Equal(t, 1, gotCol)
+	assert.Equal(t, TableSortAscending, gotOrder)
+	assert.Equal(t, "Amount ▲", r.sorts[1].Text)
+
+	r.sorts[1].OnTapped()
+	assert.Equal(t, TableSortDescending, gotOrder)
+	assert.Equal(t, "Amount ▼", r.sorts[1].Text)
+
+	r.sorts[1].OnTapped()
+	assert.Equal(t, TableSortNone, gotOrder)
+	assert.Equal(t, "Amount", r.sorts[1].Text)
+	assert.Equal(t, 3, changes)
+
+	// switching column restarts the cycle at ascending
+	r.sorts[0].OnTapped()
+	assert.Equal(t, 0, gotCol)
+	assert.Equal(t, TableSortAscending, gotOrder)
+}
+
+func TestTableHeader_FilterChips(t *testing.T) {
+	header := NewTableHeader(
+		TableHeaderColumn{Title: "Name"},
+		TableHeaderColumn{Title: "Amount", Type: TableColumnNumber})
+
+	var gotFilters []TableFilter
+	header.OnChanged = func(col int, order TableSort, filters []TableFilter) {
+		gotFilters = filters
+	}
+
+	w := test.NewWindow(header)
+	defer w.Close()
+
+	header.SetFilter(TableFilter{Col: 0, Text: "fyne"})
+	header.SetFilter(TableFilter{Col: 1, Type: TableColumnNumber, Min: 10, HasMin: true})
+	assert.Len(t, gotFilters, 2)
+	assert.Equal(t, "fyne", gotFilters[0].Text)
+
+	r := test.WidgetRenderer(header).(*tableHeaderRenderer)
+	assert.Len(t, r.chips.Objects, 2)
+	assert.Equal(t, "Name: contains \"fyne\"", r.chips.Objects[0].(*Button).Text)
+	assert.Equal(t, "Amount: ≥ 10", r.chips.Objects[1].(*Button).Text)
+
+	// tapping a chip removes its filter
+	r.chips.Objects[0].(*Button).OnTapped()
+	assert.Len(t, gotFilters, 1)
+	assert.Len(t, r.chips.Objects, 1)
+
+	// an empty filter clears instead of adding
+	header.SetFilter(TableFilter{Col: 1, Type: TableColumnNumber})
+	assert.Empty(t, gotFilters)
+	assert.Empty(t, r.chips.Objects)
+}
+
+func TestTableFilter_Matches(t *testing.T) {
+	text := &TableFilter{Text: "Fyne"}
+	assert.True(t, text.Matches("made with FYNE"))
+	assert.False(t, text.Matches("something else"))
+
+	number := &TableFilter{Type: TableColumnNumber, Min: 5, HasMin: true, Max: 10, HasMax: true}
+	assert.True(t, number.Matches("7.5"))
+	assert.False(t, number.Matches("12"))
+	assert.False(t, number.Matches("not a number"))
+
+	date := &TableFilter{Type: TableColumnDate,
+		From: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2026, time.June, 30, 0, 0, 0, 0, time.UTC)}
+	assert.True(t, date.Matches("2026-03-15"))
+	assert.False(t, date.Matches("2025-12-31"))
+	assert.False(t, date.Matches("soon"))
+}
@@ -0,0 +1,56 @@
+package widget
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// toolTipDelay is how long a pointer must hover over an item before its tooltip shows.
+const toolTipDelay = 600 * time.Millisecond
+
+// toolTipOffset moves the tooltip away from the pointer so it is not immediately obscured.
+const toolTipOffset = 12
+
+// toolTipHandler manages the delayed display of rich tooltip content over a widget.
+type toolTipHandler struct {
+	anim  *fyne.Animation
+	popup *PopUp
+}
+
+// showAfterDelay schedules tooltip content to pop up near the given absolute position,
+// once the hover delay has passed. Any pending or visible tooltip is dismissed first.
+func (h *toolTipHandler) showAfterDelay(owner fyne.CanvasObject, content func() fyne.CanvasObject, pos fyne.Position) {
+	h.dismiss()
+
+	h.anim = fyne.NewAnimation(toolTipDelay, func(f float32) {
+		if f < 1 {
+			return
+		}
+
+		c := fyne.CurrentApp().Driver().CanvasForObject(owner)
+		if c == nil {
+			return
+		}
+		obj := content()
+		if obj == nil {
+			return
+		}
+
+		h.popup = NewPopUp(obj, c)
+		h.popup.ShowAtPosition(pos.AddXY(toolTipOffset, toolTipOffset))
+	})
+	h.anim.Start()
+}
+
+// dismiss cancels any pending tooltip and hides one that is currently shown.
+func (h *toolTipHandler) dismiss() {
+	if h.anim != nil {
+		h.anim.Stop()
+		h.anim = nil
+	}
+	if h.popup != nil {
+		h.popup.Hide()
+		h.popup = nil
+	}
+}
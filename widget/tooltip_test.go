@@ -0,0 +1,51 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_ItemToolTip(t *testing.T) {
+	list := createList(10)
+	list.ItemToolTip = func(id ListItemID) fyne.CanvasObject {
+		return NewLabel("tip")
+	}
+
+	w := test.NewWindow(list)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 200))
+	list.Refresh() // ensure the tooltip callback is picked up by visible items
+
+	item := list.scroller.Content.(*fyne.Container).Layout.(*listLayout).children[0].(*listItem)
+	item.MouseIn(&desktop.MouseEvent{})
+	// the test driver completes the hover delay immediately
+	assert.NotNil(t, item.toolTipHandler.popup)
+	assert.True(t, item.toolTipHandler.popup.Visible())
+
+	item.MouseOut()
+	assert.Nil(t, item.toolTipHandler.popup)
+}
+
+func TestGridWrap_ItemToolTip(t *testing.T) {
+	gw := createGridWrap(10)
+	gw.ItemToolTip = func(id GridWrapItemID) fyne.CanvasObject {
+		return NewLabel("tip")
+	}
+
+	w := test.NewWindow(gw)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 200))
+	gw.Refresh()
+
+	item := gw.scroller.Content.(*fyne.Container).Objects[0].(*gridWrapItem)
+	item.MouseIn(&desktop.MouseEvent{})
+	assert.NotNil(t, item.toolTipHandler.popup)
+
+	item.Tapped(&fyne.PointEvent{})
+	assert.Nil(t, item.toolTipHandler.popup)
+}
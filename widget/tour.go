@@ -0,0 +1,275 @@
+package widget
+
+import (
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+)
+
+// tourPreferencePrefix is prepended to a Tour ID to build its preference key.
+const tourPreferencePrefix = "fyne.tour."
+
+// TourStep is one stop of a Tour, highlighting a target object with an
+// explanatory callout bubble.
+//
+// Since: 2.6
+type TourStep struct {
+	// Target is the object highlighted by this step.
+	// When nil the whole canvas is dimmed and the callout is centered.
+	Target fyne.CanvasObject
+	// Title is shown emphasized at the top of the callout.
+	Title string
+	// Text is the body of the callout, describing the target.
+	Text string
+}
+
+// Tour walks the user through a sequence of steps, dimming the canvas around
+// each step's target and describing it in a callout bubble with next and skip
+// controls. A completed or skipped tour records its ID in the application
+// preferences so it is shown only once.
+//
+// Since: 2.6
+type Tour struct {
+	// Steps are the stops of the tour, shown in order.
+	Steps []TourStep
+	// OnCompleted is called when the last step is dismissed with the next button.
+	OnCompleted func() `json:"-"`
+	// OnSkipped is called when the tour is dismissed early with the skip button.
+	OnSkipped func() `json:"-"`
+
+	id      string
+	current int
+	overlay *Overlay
+	content *tourOverlay
+	canvas  fyne.Canvas
+}
+
+// NewTour creates a tour with the given steps.
+// The ID identifies the tour in the application preferences, where its
+// completion state is stored.
+//
+// Since: 2.6
+func NewTour(id string, steps ...TourStep) *Tour {
+	return &Tour{id: id, Steps: steps}
+}
+
+// Completed returns whether this tour was already finished or skipped.
+func (t *Tour) Completed() bool {
+	return fyne.CurrentApp().Preferences().Bool(tourPreferencePrefix + t.id)
+}
+
+// Reset clears the stored completion state so the tour can be shown again.
+func (t *Tour) Reset() {
+	fyne.CurrentApp().Preferences().RemoveValue(tourPreferencePrefix + t.id)
+}
+
+// Start shows the tour on the given canvas, unless it was already completed
+// or has no steps.
+func (t *Tour) Start(c fyne.Canvas) {
+	if t.overlay != nil || t.Completed() || len(t.Steps) == 0 {
+		return
+	}
+
+	t.current = 0
+	t.canvas = c
+	t.content = &tourOverlay{tour: t}
+	t.content.ExtendBaseWidget(t.content)
+	t.overlay = ShowOverlay(t.content, c, OverlayOptions{Modal: true})
+	t.overlay.Resize(c.Size())
+}
+
+// Next advances the tour to its next step, completing it when the current
+// step is the last.
+func (t *Tour) Next() {
+	if t.overlay == nil {
+		return
+	}
+	if t.current++; t.current < len(t.Steps) {
+		t.content.Refresh()
+		return
+	}
+
+	t.dismiss()
+	if f := t.OnCompleted; f != nil {
+		f()
+	}
+}
+
+// Skip dismisses the tour early, it still records the tour as completed so it
+// is not shown again.
+func (t *Tour) Skip() {
+	if t.overlay == nil {
+		return
+	}
+
+	t.dismiss()
+	if f := t.OnSkipped; f != nil {
+		f()
+	}
+}
+
+func (t *Tour) dismiss() {
+	fyne.CurrentApp().Preferences().SetBool(tourPreferencePrefix+t.id, true)
+	t.overlay.Dismiss()
+	t.overlay = nil
+	t.content = nil
+	t.canvas = nil
+}
+
+func (t *Tour) step() TourStep {
+	return t.Steps[t.current]
+}
+
+// targetRect returns position and size of the current step's target on the canvas.
+func (t *Tour) targetRect() (fyne.Position, fyne.Size, bool) {
+	target := t.step().Target
+	if target == nil || !target.Visible() {
+		return fyne.Position{}, fyne.Size{}, false
+	}
+
+	return fyne.CurrentApp().Driver().AbsolutePositionForObject(target), target.Size(), true
+}
+
+// Declare conformity with the Widget interface.
+var _ fyne.Widget = (*tourOverlay)(nil)
+
+// tourOverlay fills the canvas for a running tour, dimming everything but the
+// current target and placing the callout bubble next to it.
+type tourOverlay struct {
+	BaseWidget
+	tour *Tour
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (o *tourOverlay) CreateRenderer() fyne.WidgetRenderer {
+	r := &tourOverlayRenderer{overlay: o}
+	for i := range r.shades {
+		r.shades[i] = canvas.NewRectangle(color.Transparent)
+	}
+	r.outline = canvas.NewRectangle(color.Transparent)
+	r.bubble = canvas.NewRectangle(color.Transparent)
+	r.title = NewLabel("")
+	r.title.TextStyle.Bold = true
+	r.text = NewLabel("")
+	r.text.Wrapping = fyne.TextWrapWord
+	r.progress = NewLabel("")
+	r.skip = NewButton("Skip", o.tour.Skip)
+	r.skip.Importance = LowImportance
+	r.next = NewButton("Next", o.tour.Next)
+	r.next.Importance = HighImportance
+
+	buttons := &fyne.Container{Layout: layout.NewHBoxLayout(), Objects: []fyne.CanvasObject{
+		r.progress, layout.NewSpacer(), r.skip, r.next}}
+	r.callout = &fyne.Container{Layout: layout.NewVBoxLayout(), Objects: []fyne.CanvasObject{
+		r.title, r.text, buttons}}
+
+	r.Refresh()
+	return r
+}
+
+var _ fyne.WidgetRenderer = (*tourOverlayRenderer)(nil)
+
+type tourOverlayRenderer struct {
+	overlay *tourOverlay
+
+	shades   [4]*canvas.Rectangle // clockwise from the top, leaving a hole at the target
+	outline  *canvas.Rectangle
+	bubble   *canvas.Rectangle
+	title    *Label
+	text     *Label
+	progress *Label
+	skip     *Button
+	next     *Button
+	callout  *fyne.Container
+}
+
+func (r *tourOverlayRenderer) Destroy() {
+}
+
+func (r *tourOverlayRenderer) Layout(size fyne.Size) {
+	th := r.overlay.Theme()
+	pad := th.Size(theme.SizeNamePadding)
+
+	hole := fyne.Position{}
+	holeSize := fyne.Size{}
+	if pos, targetSize, ok := r.overlay.tour.targetRect(); ok {
+		hole = pos.SubtractXY(pad, pad)
+		holeSize = targetSize.AddWidthHeight(2*pad, 2*pad)
+	}
+
+	r.shades[0].Move(fyne.NewPos(0, 0))
+	r.shades[0].Resize(fyne.NewSize(size.Width, hole.Y))
+	r.shades[1].Move(fyne.NewPos(hole.X+holeSize.Width, hole.Y))
+	r.shades[1].Resize(fyne.NewSize(size.Width-hole.X-holeSize.Width, holeSize.Height))
+	r.shades[2].Move(fyne.NewPos(0, hole.Y+holeSize.Height))
+	r.shades[2].Resize(fyne.NewSize(size.Width, size.Height-hole.Y-holeSize.Height))
+	r.shades[3].Move(fyne.NewPos(0, hole.Y))
+	r.shades[3].Resize(fyne.NewSize(hole.X, holeSize.Height))
+	r.outline.Move(hole)
+	r.outline.Resize(holeSize)
+
+	bubbleSize := r.callout.MinSize().Max(fyne.NewSize(size.Width/3, 0))
+	bubbleSize = bubbleSize.Min(size.SubtractWidthHeight(2*pad, 2*pad))
+	bubblePos := fyne.NewPos((size.Width-bubbleSize.Width)/2, (size.Height-bubbleSize.Height)/2)
+	if _, _, ok := r.overlay.tour.targetRect(); ok {
+		bubblePos.X = fyne.Min(
+			fyne.Max(hole.X, pad),
+			size.Width-bubbleSize.Width-pad)
+		if below := hole.Y + holeSize.Height + pad; below+bubbleSize.Height <= size.Height {
+			bubblePos.Y = below // below the target if it fits, otherwise above
+		} else {
+			bubblePos.Y = fyne.Max(hole.Y-pad-bubbleSize.Height, pad)
+		}
+	}
+	r.bubble.Move(bubblePos)
+	r.bubble.Resize(bubbleSize)
+	r.callout.Move(bubblePos.AddXY(pad, pad))
+	r.callout.Resize(bubbleSize.SubtractWidthHeight(2*pad, 2*pad))
+}
+
+func (r *tourOverlayRenderer) MinSize() fyne.Size {
+	return r.callout.MinSize()
+}
+
+func (r *tourOverlayRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{
+		r.shades[0], r.shades[1], r.shades[2], r.shades[3],
+		r.outline, r.bubble, r.callout,
+	}
+}
+
+func (r *tourOverlayRenderer) Refresh() {
+	th := r.overlay.Theme()
+	v := fyne.CurrentApp().Settings().ThemeVariant()
+	tour := r.overlay.tour
+
+	shade := diffTint(th.Color(theme.ColorNameShadow, v), 0x99)
+	for _, s := range r.shades {
+		s.FillColor = shade
+		s.Refresh()
+	}
+	r.outline.StrokeColor = th.Color(theme.ColorNamePrimary, v)
+	r.outline.StrokeWidth = 2
+	r.outline.CornerRadius = th.Size(theme.SizeNameSelectionRadius)
+	r.bubble.FillColor = th.Color(theme.ColorNameOverlayBackground, v)
+	r.bubble.CornerRadius = th.Size(theme.SizeNameSelectionRadius)
+
+	step := tour.step()
+	r.title.SetText(step.Title)
+	r.text.SetText(step.Text)
+	r.progress.SetText(fmt.Sprintf("%d of %d", tour.current+1, len(tour.Steps)))
+	if tour.current == len(tour.Steps)-1 {
+		r.next.SetText("Done")
+	} else {
+		r.next.SetText("Next")
+	}
+
+	r.outline.Refresh()
+	r.bubble.Refresh()
+	r.Layout(r.overlay.Size())
+	canvas.Refresh(r.overlay.super())
+}
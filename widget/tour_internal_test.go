@@ -0,0 +1,86 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/internal/cache"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+)
+
+func setupTour(t *testing.T) (*Tour, *Button, fyne.Window) {
+	target := NewButton("Target", nil)
+	w := test.NewTempWindow(t, target)
+	w.Resize(fyne.NewSize(300, 300))
+
+	tour := NewTour("test"+t.Name(),
+		TourStep{Target: target, Title: "First", Text: "The target button"},
+		TourStep{Title: "Second", Text: "A canvas wide step"})
+	return tour, target, w
+}
+
+func TestTour_CompleteAndPersist(t *testing.T) {
+	tour, _, w := setupTour(t)
+	completed := false
+	tour.OnCompleted = func() { completed = true }
+
+	tour.Start(w.Canvas())
+	assert.NotNil(t, w.Canvas().Overlays().Top())
+
+	r := cache.Renderer(tour.content).(*tourOverlayRenderer)
+	assert.Equal(t, "First", r.title.Text)
+	assert.Equal(t, "1 of 2", r.progress.Text)
+	assert.Equal(t, "Next", r.next.Text)
+
+	tour.Next()
+	r = cache.Renderer(tour.content).(*tourOverlayRenderer)
+	assert.Equal(t, "Second", r.title.Text)
+	assert.Equal(t, "Done", r.next.Text)
+
+	tour.Next()
+	assert.True(t, completed)
+	assert.Nil(t, w.Canvas().Overlays().Top())
+	assert.True(t, tour.Completed())
+
+	// a completed tour does not show again
+	tour.Start(w.Canvas())
+	assert.Nil(t, w.Canvas().Overlays().Top())
+
+	tour.Reset()
+	assert.False(t, tour.Completed())
+}
+
+func TestTour_Skip(t *testing.T) {
+	tour, _, w := setupTour(t)
+	skipped := false
+	tour.OnSkipped = func() { skipped = true }
+
+	tour.Start(w.Canvas())
+	tour.Skip()
+	assert.True(t, skipped)
+	assert.Nil(t, w.Canvas().Overlays().Top())
+	assert.True(t, tour.Completed(), "skipping still records the tour as seen")
+}
+
+func TestTour_HolePunch(t *testing.T) {
+	tour, target, w := setupTour(t)
+	tour.Start(w.Canvas())
+
+	r := cache.Renderer(tour.content).(*tourOverlayRenderer)
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(target)
+	pad := tour.content.Theme().Size(theme.SizeNamePadding)
+
+	assert.Equal(t, pos.Y-pad, r.shades[0].Size().Height, "top shade should stop above the target")
+	assert.Equal(t, pos.SubtractXY(pad, pad), r.outline.Position())
+	assert.Equal(t, target.Size().AddWidthHeight(2*pad, 2*pad), r.outline.Size())
+
+	// the second step has no target so the whole canvas is dimmed
+	tour.Next()
+	r = cache.Renderer(tour.content).(*tourOverlayRenderer)
+	assert.Equal(t, float32(0), r.shades[0].Size().Height)
+	assert.Equal(t, w.Canvas().Size().Height, r.shades[2].Size().Height)
+	tour.Skip()
+}
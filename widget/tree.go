@@ -2,6 +2,7 @@ package widget
 
 import (
 	"fmt"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
@@ -35,6 +36,15 @@ type Tree struct {
 	// Since: 2.5
 	HideSeparators bool
 
+	// SelectionMode sets how many nodes may be selected at once, the default
+	// SelectionSingle matches the behaviour of previous releases.
+	// With SelectionMultiple, Ctrl (or Super) clicking a node toggles it in
+	// and out of the selection and Shift clicking extends the selection to a
+	// range of the visible nodes.
+	//
+	// Since: 2.6
+	SelectionMode SelectionMode
+
 	ChildUIDs      func(uid TreeNodeID) (c []TreeNodeID)                     `json:"-"` // Return a sorted slice of Children TreeNodeIDs for the given Node TreeNodeID
 	CreateNode     func(branch bool) (o fyne.CanvasObject)                   `json:"-"` // Return a CanvasObject that can represent a Branch (if branch is true), or a Leaf (if branch is false)
 	IsBranch       func(uid TreeNodeID) (ok bool)                            `json:"-"` // Return true if the given TreeNodeID represents a Branch
@@ -44,14 +54,57 @@ type Tree struct {
 	OnUnselected   func(uid TreeNodeID)                                      `json:"-"` // Called when the Node with the given TreeNodeID is unselected.
 	UpdateNode     func(uid TreeNodeID, branch bool, node fyne.CanvasObject) `json:"-"` // Called to update the given CanvasObject to represent the data at the given TreeNodeID
 
-	branchMinSize fyne.Size
-	currentFocus  TreeNodeID
-	focused       bool
-	leafMinSize   fyne.Size
-	offset        fyne.Position
-	open          map[TreeNodeID]bool
-	scroller      *widget.Scroll
-	selected      []TreeNodeID
+	// StyleNode allows per-node appearance overrides such as a background
+	// color, selection color or text emphasis, without rebuilding templates.
+	// It is called alongside UpdateNode whenever a node is bound, with a zero
+	// ItemStyle to fill in; fields left unset keep the themed look.
+	//
+	// Since: 2.6
+	StyleNode func(uid TreeNodeID, node fyne.CanvasObject, style *ItemStyle) `json:"-"`
+
+	// OnNodeMoved is called when a node is dragged onto a new location, with
+	// the new parent and the index the node should take in its children.
+	// Setting it shows a drag handle on every node, dropping onto a branch
+	// moves a node to the end of that branch and dropping onto the top or
+	// bottom half of a leaf inserts it before or after that leaf.
+	// The callback is responsible for updating the backing data.
+	//
+	// Since: 2.6
+	OnNodeMoved func(uid, newParent TreeNodeID, index int) `json:"-"`
+
+	// IsBranchLoaded returns whether the children of the given branch are
+	// already available from ChildUIDs. Returning false shows a loading
+	// placeholder row below the branch when it is opened, until LoadBranch
+	// reports the children have been fetched.
+	//
+	// Since: 2.6
+	IsBranchLoaded func(uid TreeNodeID) (ok bool) `json:"-"`
+	// LoadBranch is called when a branch whose children are not loaded is
+	// opened, so they can be fetched on demand. Call done once ChildUIDs can
+	// return the children, from the main goroutine, to replace the loading
+	// placeholder with the new rows.
+	//
+	// Since: 2.6
+	LoadBranch func(uid TreeNodeID, done func()) `json:"-"`
+
+	branchMinSize       fyne.Size
+	currentFocus        TreeNodeID
+	selectionAnchor     TreeNodeID
+	pendingSelectionMod fyne.KeyModifier
+	draggingNode        TreeNodeID
+	dropTarget          TreeNodeID
+	dropParent          TreeNodeID
+	dropIndex           int
+	dragHoverBranch     TreeNodeID
+	dragHoverStart      time.Time
+	focused             bool
+	leafMinSize         fyne.Size
+	offset              fyne.Position
+	filtered            map[TreeNodeID]bool
+	loading             map[TreeNodeID]bool
+	open                map[TreeNodeID]bool
+	scroller            *widget.Scroll
+	selected            []TreeNodeID
 }
 
 // NewTree returns a new performant tree widget defined by the passed functions.
@@ -230,6 +283,7 @@ func (t *Tree) OpenBranch(uid TreeNodeID) {
 	t.propertyLock.Lock()
 	t.open[uid] = true
 	t.propertyLock.Unlock()
+	t.loadBranchIfNeeded(uid)
 	if f := t.OnBranchOpened; f != nil {
 		f(uid)
 	}
@@ -402,14 +456,17 @@ func (t *Tree) TypedRune(_ rune) {
 
 // Unselect marks the specified node to be not selected.
 func (t *Tree) Unselect(uid TreeNodeID) {
-	if len(t.selected) == 0 || t.selected[0] != uid {
-		return
-	}
+	for i, s := range t.selected {
+		if s != uid {
+			continue
+		}
 
-	t.selected = nil
-	t.Refresh()
-	if f := t.OnUnselected; f != nil {
-		f(uid)
+		t.selected = append(t.selected[:i:i], t.selected[i+1:]...)
+		t.Refresh()
+		if f := t.OnUnselected; f != nil {
+			f(uid)
+		}
+		return
 	}
 }
 
@@ -507,8 +564,13 @@ func (t *Tree) walk(uid, parent TreeNodeID, depth int, onNode func(TreeNodeID, T
 		if isBranch(uid) {
 			onNode(uid, parent, true, depth)
 			if t.IsBranchOpen(uid) {
-				if childUIDs := t.ChildUIDs; childUIDs != nil {
+				if !t.isBranchLoaded(uid) {
+					onNode(treeLoadingNodeID(uid), uid, false, depth+1)
+				} else if childUIDs := t.ChildUIDs; childUIDs != nil {
 					for _, c := range childUIDs(uid) {
+						if t.filtered != nil && !t.filtered[c] {
+							continue
+						}
 						t.walk(c, uid, depth+1, onNode)
 					}
 				}
@@ -590,6 +652,7 @@ func (c *treeContent) CreateRenderer() fyne.WidgetRenderer {
 		treeContent:  c,
 		branches:     make(map[string]*branch),
 		leaves:       make(map[string]*leaf),
+		loadingRows:  make(map[string]*leaf),
 		branchPool:   &syncPool{},
 		leafPool:     &syncPool{},
 	}
@@ -614,6 +677,7 @@ type treeContentRenderer struct {
 	objects     []fyne.CanvasObject
 	branches    map[string]*branch
 	leaves      map[string]*leaf
+	loadingRows map[string]*leaf
 	branchPool  pool
 	leafPool    pool
 }
@@ -626,6 +690,7 @@ func (r *treeContentRenderer) Layout(size fyne.Size) {
 	r.objects = nil
 	branches := make(map[string]*branch)
 	leaves := make(map[string]*leaf)
+	loadingRows := make(map[string]*leaf)
 
 	pad := th.Size(theme.SizeNamePadding)
 	offsetY := r.treeContent.tree.offset.Y
@@ -690,10 +755,20 @@ func (r *treeContentRenderer) Layout(size fyne.Size) {
 						f(uid, true, b.Content())
 					}
 					b.update(uid, depth)
+					b.refreshStyle()
 				}
 				branches[uid] = b
 				n = b
 				r.objects = append(r.objects, b)
+			} else if isTreeLoadingNode(uid) {
+				l, ok := r.loadingRows[uid]
+				if !ok {
+					l = newLoadingLeaf(r.treeContent.tree)
+					l.update(uid, depth)
+				}
+				loadingRows[uid] = l
+				n = l
+				r.objects = append(r.objects, l)
 			} else {
 				l, ok := r.leaves[uid]
 				if !ok {
@@ -702,6 +777,7 @@ func (r *treeContentRenderer) Layout(size fyne.Size) {
 						f(uid, false, l.Content())
 					}
 					l.update(uid, depth)
+					l.refreshStyle()
 				}
 				leaves[uid] = l
 				n = l
@@ -735,9 +811,17 @@ func (r *treeContentRenderer) Layout(size fyne.Size) {
 			r.leafPool.Release(l)
 		}
 	}
+	for uid, l := range r.loadingRows {
+		if _, ok := loadingRows[uid]; !ok {
+			if a, ok := l.Content().(*Activity); ok {
+				a.Stop()
+			}
+		}
+	}
 
 	r.branches = branches
 	r.leaves = leaves
+	r.loadingRows = loadingRows
 }
 
 func (r *treeContentRenderer) MinSize() (min fyne.Size) {
@@ -836,6 +920,7 @@ func (r *treeContentRenderer) getLeaf() (l *leaf) {
 }
 
 var _ desktop.Hoverable = (*treeNode)(nil)
+var _ desktop.Mouseable = (*treeNode)(nil)
 var _ fyne.CanvasObject = (*treeNode)(nil)
 var _ fyne.Tappable = (*treeNode)(nil)
 
@@ -844,6 +929,7 @@ type treeNode struct {
 	tree     *Tree
 	uid      string
 	depth    int
+	style    ItemStyle
 	hovered  bool
 	icon     fyne.CanvasObject
 	isBranch bool
@@ -889,12 +975,24 @@ func (n *treeNode) MouseOut() {
 	n.partialRefresh()
 }
 
+// MouseDown is called when a desktop mouse button is pressed over the widget,
+// recording the modifier so the following tap can extend the selection.
+func (n *treeNode) MouseDown(ev *desktop.MouseEvent) {
+	if ev.Button == desktop.MouseButtonPrimary {
+		n.tree.pendingSelectionMod = ev.Modifier
+	}
+}
+
+// MouseUp is called when a desktop mouse button is released over the widget
+func (n *treeNode) MouseUp(*desktop.MouseEvent) {
+}
+
 func (n *treeNode) Tapped(*fyne.PointEvent) {
 	if n.tree.currentFocus != "" {
 		n.tree.RefreshItem(n.tree.currentFocus)
 	}
 
-	n.tree.Select(n.uid)
+	n.tree.selectTapped(n.uid)
 	if !fyne.CurrentDevice().IsMobile() {
 		canvas := fyne.CurrentApp().Driver().CanvasForObject(n.tree)
 		if canvas != nil {
@@ -911,6 +1009,20 @@ func (n *treeNode) partialRefresh() {
 	}
 }
 
+// refreshStyle queries the StyleNode callback for the appearance overrides of
+// this node, refreshing the background if they changed.
+func (n *treeNode) refreshStyle() {
+	style := ItemStyle{}
+	if f := n.tree.StyleNode; f != nil && n.content != nil {
+		f(n.uid, n.content, &style)
+		style.applyText(n.content)
+	}
+	if n.style != style {
+		n.style = style
+		n.partialRefresh()
+	}
+}
+
 func (n *treeNode) update(uid string, depth int) {
 	n.uid = uid
 	n.depth = depth
@@ -926,6 +1038,7 @@ type treeNodeRenderer struct {
 	widget.BaseRenderer
 	treeNode   *treeNode
 	background *canvas.Rectangle
+	handle     *treeDragHandle
 }
 
 func (r *treeNodeRenderer) Layout(size fyne.Size) {
@@ -941,9 +1054,15 @@ func (r *treeNodeRenderer) Layout(size fyne.Size) {
 	}
 	x += iconSize
 	x += pad
+	width := size.Width
+	if r.handle != nil {
+		width -= iconSize + pad
+		r.handle.Move(fyne.NewPos(width, y))
+		r.handle.Resize(fyne.NewSize(iconSize, size.Height))
+	}
 	if r.treeNode.content != nil {
 		r.treeNode.content.Move(fyne.NewPos(x, y))
-		r.treeNode.content.Resize(fyne.NewSize(size.Width-x, size.Height))
+		r.treeNode.content.Resize(fyne.NewSize(width-x, size.Height))
 	}
 }
 
@@ -967,6 +1086,9 @@ func (r *treeNodeRenderer) Objects() (objects []fyne.CanvasObject) {
 	if r.treeNode.icon != nil {
 		objects = append(objects, r.treeNode.icon)
 	}
+	if r.handle != nil {
+		objects = append(objects, r.handle)
+	}
 	return
 }
 
@@ -976,6 +1098,7 @@ func (r *treeNodeRenderer) Refresh() {
 			f(r.treeNode.uid, r.treeNode.isBranch, c)
 		}
 	}
+	r.treeNode.refreshStyle()
 	r.partialRefresh()
 }
 
@@ -986,13 +1109,26 @@ func (r *treeNodeRenderer) partialRefresh() {
 	if r.treeNode.icon != nil {
 		r.treeNode.icon.Refresh()
 	}
+	if r.treeNode.tree.OnNodeMoved != nil && r.handle == nil {
+		r.handle = newTreeDragHandle(r.treeNode)
+	}
 	r.background.CornerRadius = th.Size(theme.SizeNameSelectionRadius)
-	if len(r.treeNode.tree.selected) > 0 && r.treeNode.uid == r.treeNode.tree.selected[0] {
-		r.background.FillColor = th.Color(theme.ColorNameSelection, v)
+	if r.treeNode.tree.draggingNode != "" && r.treeNode.tree.dropTarget == r.treeNode.uid {
+		r.background.FillColor = th.Color(theme.ColorNameFocus, v)
+		r.background.Show()
+	} else if r.treeNode.tree.isNodeSelected(r.treeNode.uid) {
+		selection := th.Color(theme.ColorNameSelection, v)
+		if c := r.treeNode.style.SelectionColor; c != nil {
+			selection = c
+		}
+		r.background.FillColor = selection
 		r.background.Show()
 	} else if r.treeNode.hovered || (r.treeNode.tree.focused && r.treeNode.tree.currentFocus == r.treeNode.uid) {
 		r.background.FillColor = th.Color(theme.ColorNameHover, v)
 		r.background.Show()
+	} else if c := r.treeNode.style.BackgroundColor; c != nil {
+		r.background.FillColor = c
+		r.background.Show()
 	} else {
 		r.background.Hide()
 	}
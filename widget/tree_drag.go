@@ -0,0 +1,166 @@
+package widget
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// treeDragExpandDelay is how long a closed branch must be hovered during a
+// drag before it opens automatically.
+const treeDragExpandDelay = 600 * time.Millisecond
+
+// nodeDragged tracks a node drag, updating the drop location and highlight
+// under the pointer and auto-expanding closed branches that are hovered.
+func (t *Tree) nodeDragged(uid TreeNodeID, absPos fyne.Position) {
+	t.draggingNode = uid
+	target, parent, index, branch := t.dropLocationAt(absPos)
+
+	if target != t.dropTarget {
+		old := t.dropTarget
+		t.dropTarget = target
+		if old != "" {
+			t.RefreshItem(old)
+		}
+		if target != "" {
+			t.RefreshItem(target)
+		}
+	}
+	t.dropParent = parent
+	t.dropIndex = index
+
+	if !branch || t.IsBranchOpen(target) {
+		t.dragHoverBranch = ""
+		return
+	}
+	if target != t.dragHoverBranch {
+		t.dragHoverBranch = target
+		t.dragHoverStart = time.Now()
+	} else if time.Since(t.dragHoverStart) >= treeDragExpandDelay {
+		t.OpenBranch(target)
+	}
+}
+
+// nodeDragEnded completes a node drag, reporting the move to OnNodeMoved
+// unless the node was dropped on itself or inside its own subtree.
+func (t *Tree) nodeDragEnded() {
+	uid, target := t.draggingNode, t.dropTarget
+	parent, index := t.dropParent, t.dropIndex
+	t.draggingNode = ""
+	t.dropTarget = ""
+	t.dragHoverBranch = ""
+	if target != "" {
+		t.RefreshItem(target)
+	}
+
+	if uid == "" || target == "" || uid == target {
+		return
+	}
+	if uid == parent || t.isDescendant(uid, parent) {
+		return
+	}
+	if f := t.OnNodeMoved; f != nil {
+		f(uid, parent, index)
+	}
+}
+
+// dropLocationAt returns the node under the given absolute position along with
+// the parent and child index a node dropped there would move to.
+// Dropping on a branch moves the node to the end of its children, dropping on
+// the top or bottom half of a leaf inserts before or after it.
+func (t *Tree) dropLocationAt(absPos fyne.Position) (target, parent TreeNodeID, index int, branch bool) {
+	treeAbs := fyne.CurrentApp().Driver().AbsolutePositionForObject(t.super())
+	pos := absPos.Y - treeAbs.Y + t.offset.Y
+
+	pad := t.Theme().Size(theme.SizeNamePadding)
+	childIndex := make(map[TreeNodeID]int)
+	y := float32(0)
+	t.walkAll(func(id, p TreeNodeID, isBranch bool, _ int) {
+		i := childIndex[p]
+		childIndex[p]++
+
+		// Root node is not rendered unless it has been customized
+		if t.Root == "" && id == "" {
+			return
+		}
+		m := t.leafMinSize
+		if isBranch {
+			m = t.branchMinSize
+		}
+		// If this is not the first item, add a separator
+		if y > 0 {
+			y += pad
+		}
+
+		if target == "" && pos >= y && pos < y+m.Height {
+			target = id
+			branch = isBranch
+			if isBranch {
+				parent = id
+				if f := t.ChildUIDs; f != nil {
+					index = len(f(id))
+				}
+			} else {
+				parent = p
+				index = i
+				if pos >= y+m.Height/2 {
+					index++
+				}
+			}
+		}
+		y += m.Height
+	})
+	return
+}
+
+// isDescendant returns true if uid is nested anywhere below ancestor.
+func (t *Tree) isDescendant(ancestor, uid TreeNodeID) bool {
+	childUIDs := t.ChildUIDs
+	if childUIDs == nil {
+		return false
+	}
+
+	for _, c := range childUIDs(ancestor) {
+		if c == uid || t.isDescendant(c, uid) {
+			return true
+		}
+	}
+	return false
+}
+
+// Declare conformity with interfaces.
+var _ fyne.Widget = (*treeDragHandle)(nil)
+var _ fyne.Draggable = (*treeDragHandle)(nil)
+
+// treeDragHandle is the grip shown at the trailing edge of tree rows when
+// OnNodeMoved is set, so nodes can be dragged without blocking drag scrolling.
+type treeDragHandle struct {
+	BaseWidget
+	node *treeNode
+}
+
+func newTreeDragHandle(node *treeNode) *treeDragHandle {
+	h := &treeDragHandle{node: node}
+	h.ExtendBaseWidget(h)
+	return h
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (h *treeDragHandle) CreateRenderer() fyne.WidgetRenderer {
+	return NewSimpleRenderer(NewIcon(theme.MenuIcon()))
+}
+
+// Dragged is called when the pointer drags the handle, moving its tree node.
+//
+// Implements: fyne.Draggable
+func (h *treeDragHandle) Dragged(e *fyne.DragEvent) {
+	h.node.tree.nodeDragged(h.node.uid, e.AbsolutePosition)
+}
+
+// DragEnd is called when the drag of the handle stops, dropping its tree node.
+//
+// Implements: fyne.Draggable
+func (h *treeDragHandle) DragEnd() {
+	h.node.tree.nodeDragEnded()
+}
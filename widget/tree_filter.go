@@ -0,0 +1,139 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Filter limits the tree to nodes the given predicate matches, along with
+// their ancestors, which are expanded so every match is visible.
+// Passing nil removes the filter and shows all nodes again.
+//
+// Since: 2.6
+func (t *Tree) Filter(match func(uid TreeNodeID) bool) {
+	if match == nil {
+		t.filtered = nil
+		t.Refresh()
+		return
+	}
+
+	t.ensureOpenMap()
+	filtered := make(map[TreeNodeID]bool)
+	t.applyFilter(t.Root, match, filtered)
+	filtered[t.Root] = true
+	t.filtered = filtered
+	t.Refresh()
+}
+
+// applyFilter marks the given node as visible if it or any descendant matches,
+// opening branches that are kept only for their descendants.
+func (t *Tree) applyFilter(uid TreeNodeID, match func(uid TreeNodeID) bool, filtered map[TreeNodeID]bool) bool {
+	kept := match(uid)
+	if isBranch := t.IsBranch; isBranch != nil && isBranch(uid) {
+		if childUIDs := t.ChildUIDs; childUIDs != nil {
+			for _, c := range childUIDs(uid) {
+				if t.applyFilter(c, match, filtered) && uid != t.Root {
+					kept = true
+					t.propertyLock.Lock()
+					t.open[uid] = true
+					t.propertyLock.Unlock()
+				}
+			}
+		}
+	}
+
+	if kept {
+		filtered[uid] = true
+	}
+	return kept
+}
+
+// ScrollToAndSelect opens every branch above the given node, selects it and
+// smoothly scrolls the tree to reveal it.
+//
+// Since: 2.6
+func (t *Tree) ScrollToAndSelect(uid TreeNodeID) {
+	t.ensureOpenMap()
+	for _, parent := range t.pathTo(uid) {
+		t.propertyLock.Lock()
+		t.open[parent] = true
+		t.propertyLock.Unlock()
+		if t.filtered != nil {
+			t.filtered[parent] = true
+		}
+	}
+	if t.filtered != nil {
+		t.filtered[uid] = true
+	}
+	t.Refresh()
+
+	if len(t.selected) == 0 || t.selected[0] != uid {
+		if f := t.OnUnselected; f != nil && len(t.selected) > 0 {
+			f(t.selected[0])
+		}
+		t.selected = []TreeNodeID{uid}
+		if f := t.OnSelected; f != nil {
+			f(uid)
+		}
+	}
+	t.animateScrollTo(uid)
+}
+
+// pathTo returns the branches between the root and the given node, excluding both.
+func (t *Tree) pathTo(uid TreeNodeID) []TreeNodeID {
+	var path []TreeNodeID
+	var descend func(node TreeNodeID, trail []TreeNodeID) bool
+	descend = func(node TreeNodeID, trail []TreeNodeID) bool {
+		if node == uid {
+			path = append([]TreeNodeID{}, trail...)
+			return true
+		}
+		childUIDs := t.ChildUIDs
+		if isBranch := t.IsBranch; childUIDs == nil || isBranch == nil || !isBranch(node) {
+			return false
+		}
+		for _, c := range childUIDs(node) {
+			if descend(c, append(trail, node)) {
+				return true
+			}
+		}
+		return false
+	}
+	descend(t.Root, nil)
+
+	if len(path) > 0 && path[0] == t.Root {
+		path = path[1:]
+	}
+	return path
+}
+
+// animateScrollTo scrolls the node into view like ScrollTo, easing the offset
+// over a short animation instead of jumping.
+func (t *Tree) animateScrollTo(uid TreeNodeID) {
+	if t.scroller == nil {
+		return
+	}
+	y, size, ok := t.offsetAndSize(uid)
+	if !ok {
+		return
+	}
+
+	from := t.scroller.Offset.Y
+	to := from
+	if y < from {
+		to = y
+	} else if y+size.Height > from+t.scroller.Size().Height {
+		to = y + size.Height - t.scroller.Size().Height
+	}
+	if to == from {
+		return
+	}
+
+	anim := fyne.NewAnimation(canvas.DurationShort, func(f float32) {
+		t.scroller.Offset.Y = from + (to-from)*f
+		t.offsetUpdated(t.scroller.Offset)
+		t.Refresh()
+	})
+	anim.Curve = fyne.AnimationEaseOut
+	anim.Start()
+}
@@ -1,6 +1,7 @@
 package widget
 
 import (
+	"fmt"
 	"image/color"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/internal/cache"
 	"fyne.io/fyne/v2/test"
 	"fyne.io/fyne/v2/theme"
 
@@ -936,6 +938,41 @@ func TestTreeNodeRenderer_BackgroundColor_Hovered(t *testing.T) {
 	})
 }
 
+func TestTree_StyleNode(t *testing.T) {
+	bold := fyne.TextStyle{Bold: true}
+	highlight := color.NRGBA{R: 0xff, A: 0x22}
+	selection := color.NRGBA{G: 0xff, A: 0x66}
+	data := make(map[string][]string)
+	addTreePath(data, "A", "B")
+	tree := NewTreeWithStrings(data)
+	tree.StyleNode = func(uid TreeNodeID, node fyne.CanvasObject, style *ItemStyle) {
+		if uid != "B" {
+			return
+		}
+		style.BackgroundColor = highlight
+		style.SelectionColor = selection
+		style.TextStyle = &bold
+	}
+	tree.Resize(fyne.NewSize(treeSize, treeSize))
+	tree.OpenAllBranches()
+
+	plain := getBranch(t, tree, "A")
+	pr := test.TempWidgetRenderer(t, plain).(*treeNodeRenderer)
+	styled := getLeaf(t, tree, "B")
+	sr := test.TempWidgetRenderer(t, styled).(*treeNodeRenderer)
+
+	assert.False(t, pr.background.Visible())
+	assert.False(t, plain.content.(*Label).TextStyle.Bold)
+	assert.True(t, sr.background.Visible())
+	assert.Equal(t, highlight, sr.background.FillColor)
+	assert.Equal(t, bold, styled.content.(*Label).TextStyle)
+
+	tree.Select("B")
+	assert.Equal(t, selection, sr.background.FillColor)
+	tree.Unselect("B")
+	assert.Equal(t, highlight, sr.background.FillColor)
+}
+
 // addTreePath adds the given path to the given parent->children map
 func addTreePath(data map[string][]string, path ...string) {
 	parent := ""
@@ -954,3 +991,285 @@ func addTreePath(data map[string][]string, path ...string) {
 		parent = p
 	}
 }
+
+func TestTree_SelectionMultiple_Toggle(t *testing.T) {
+	data := make(map[string][]string)
+	addTreePath(data, "A", "B", "C")
+	addTreePath(data, "D", "E")
+	tree := NewTreeWithStrings(data)
+	tree.SelectionMode = SelectionMultiple
+	tree.OpenAllBranches()
+	tree.Refresh() // Force layout
+
+	var unselected []TreeNodeID
+	tree.OnUnselected = func(uid TreeNodeID) {
+		unselected = append(unselected, uid)
+	}
+
+	tree.selectWithModifier("A", 0)
+	assert.Equal(t, []TreeNodeID{"A"}, tree.selected)
+
+	tree.selectWithModifier("D", fyne.KeyModifierControl)
+	assert.Equal(t, []TreeNodeID{"A", "D"}, tree.selected)
+
+	tree.selectWithModifier("D", fyne.KeyModifierSuper)
+	assert.Equal(t, []TreeNodeID{"A"}, tree.selected)
+	assert.Equal(t, []TreeNodeID{"D"}, unselected)
+
+	// a plain tap collapses the selection back to a single node
+	tree.selectWithModifier("B", fyne.KeyModifierControl)
+	tree.selectWithModifier("E", 0)
+	assert.Equal(t, []TreeNodeID{"E"}, tree.selected)
+}
+
+func TestTree_SelectionMultiple_Range(t *testing.T) {
+	data := make(map[string][]string)
+	addTreePath(data, "A", "B", "C")
+	addTreePath(data, "D", "E")
+	addTreePath(data, "F")
+	tree := NewTreeWithStrings(data)
+	tree.SelectionMode = SelectionMultiple
+	tree.OpenAllBranches()
+	tree.Refresh() // Force layout
+
+	tree.selectWithModifier("B", 0)
+	tree.selectWithModifier("E", fyne.KeyModifierShift)
+	assert.Equal(t, []TreeNodeID{"B", "C", "D", "E"}, tree.selected)
+
+	// extending in the other direction keeps the same anchor
+	tree.selectWithModifier("A", fyne.KeyModifierShift)
+	assert.Equal(t, []TreeNodeID{"A", "B"}, tree.selected)
+
+	// a closed branch hides its children from the range
+	tree.CloseBranch("B")
+	tree.selectWithModifier("E", fyne.KeyModifierShift)
+	assert.Equal(t, []TreeNodeID{"B", "D", "E"}, tree.selected)
+}
+
+func TestTree_SelectedBranchesAndLeaves(t *testing.T) {
+	data := make(map[string][]string)
+	addTreePath(data, "A", "B", "C")
+	addTreePath(data, "D", "E")
+	tree := NewTreeWithStrings(data)
+	tree.SelectionMode = SelectionMultiple
+	tree.OpenAllBranches()
+	tree.Refresh() // Force layout
+
+	tree.selectWithModifier("A", 0)
+	tree.selectWithModifier("C", fyne.KeyModifierControl)
+	tree.selectWithModifier("E", fyne.KeyModifierControl)
+
+	assert.Equal(t, []TreeNodeID{"A"}, tree.SelectedBranches())
+	assert.Equal(t, []TreeNodeID{"C", "E"}, tree.SelectedLeaves())
+}
+
+func TestTree_SelectTapped_ConsumesModifier(t *testing.T) {
+	data := make(map[string][]string)
+	addTreePath(data, "A")
+	addTreePath(data, "B")
+	tree := NewTreeWithStrings(data)
+	tree.SelectionMode = SelectionMultiple
+	tree.Refresh() // Force layout
+
+	tree.selectTapped("A")
+	tree.pendingSelectionMod = fyne.KeyModifierControl
+	tree.selectTapped("B")
+	assert.Equal(t, []TreeNodeID{"A", "B"}, tree.selected)
+
+	// the modifier only applies to the tap that follows the mouse event
+	tree.selectTapped("A")
+	assert.Equal(t, []TreeNodeID{"A"}, tree.selected)
+}
+
+func treeDragPos(tree *Tree, uid TreeNodeID, fraction float32) fyne.Position {
+	y, size, _ := tree.offsetAndSize(uid)
+	abs := fyne.CurrentApp().Driver().AbsolutePositionForObject(tree)
+	return fyne.NewPos(abs.X+10, abs.Y+y-tree.offset.Y+size.Height*fraction)
+}
+
+func TestTree_DragNode(t *testing.T) {
+	data := make(map[string][]string)
+	addTreePath(data, "A", "B")
+	addTreePath(data, "A", "C")
+	addTreePath(data, "D", "E")
+	addTreePath(data, "F")
+	tree := NewTreeWithStrings(data)
+	tree.OpenAllBranches()
+
+	type move struct {
+		uid, parent TreeNodeID
+		index       int
+	}
+	var moved []move
+	tree.OnNodeMoved = func(uid, newParent TreeNodeID, index int) {
+		moved = append(moved, move{uid, newParent, index})
+	}
+
+	w := test.NewTempWindow(t, tree)
+	w.Resize(fyne.NewSize(200, 300))
+	tree.Refresh()
+
+	// dropping on a branch appends to its children
+	tree.nodeDragged("F", treeDragPos(tree, "A", 0.5))
+	assert.Equal(t, TreeNodeID("A"), tree.dropTarget)
+	tree.nodeDragEnded()
+	assert.Equal(t, []move{{"F", "A", 2}}, moved)
+
+	// dropping on the top half of a leaf inserts before it
+	tree.nodeDragged("C", treeDragPos(tree, "E", 0.25))
+	tree.nodeDragEnded()
+	assert.Equal(t, move{"C", "D", 0}, moved[1])
+
+	// dropping on the bottom half of a leaf inserts after it
+	tree.nodeDragged("C", treeDragPos(tree, "E", 0.75))
+	tree.nodeDragEnded()
+	assert.Equal(t, move{"C", "D", 1}, moved[2])
+
+	// a node cannot be moved into its own subtree or onto itself
+	tree.nodeDragged("A", treeDragPos(tree, "B", 0.25))
+	tree.nodeDragEnded()
+	tree.nodeDragged("A", treeDragPos(tree, "A", 0.5))
+	tree.nodeDragEnded()
+	assert.Len(t, moved, 3)
+	assert.Empty(t, tree.draggingNode)
+	assert.Empty(t, tree.dropTarget)
+}
+
+func TestTree_DragNode_AutoExpand(t *testing.T) {
+	data := make(map[string][]string)
+	addTreePath(data, "A", "B")
+	addTreePath(data, "D", "E")
+	addTreePath(data, "F")
+	tree := NewTreeWithStrings(data)
+	tree.OpenBranch("A")
+	tree.OnNodeMoved = func(uid, newParent TreeNodeID, index int) {}
+
+	w := test.NewTempWindow(t, tree)
+	w.Resize(fyne.NewSize(200, 300))
+	tree.Refresh()
+
+	tree.nodeDragged("F", treeDragPos(tree, "D", 0.5))
+	assert.Equal(t, TreeNodeID("D"), tree.dragHoverBranch)
+	assert.False(t, tree.IsBranchOpen("D"))
+
+	tree.dragHoverStart = time.Now().Add(-time.Second)
+	tree.nodeDragged("F", treeDragPos(tree, "D", 0.5))
+	assert.True(t, tree.IsBranchOpen("D"))
+	tree.nodeDragEnded()
+}
+
+func TestTree_LazyLoading(t *testing.T) {
+	data := map[string][]string{"": {"A", "B"}, "A": {}, "B": {"C"}}
+	loaded := map[TreeNodeID]bool{"B": true}
+	var loadRequests []TreeNodeID
+	var finish func()
+
+	tree := NewTreeWithStrings(data)
+	tree.IsBranchLoaded = func(uid TreeNodeID) bool {
+		return uid == "" || loaded[uid]
+	}
+	tree.LoadBranch = func(uid TreeNodeID, done func()) {
+		loadRequests = append(loadRequests, uid)
+		finish = done
+	}
+	w := test.NewTempWindow(t, tree)
+	w.Resize(fyne.NewSize(200, 300))
+
+	// already loaded branches open without a load request
+	tree.OpenBranch("B")
+	assert.Empty(t, loadRequests)
+
+	tree.OpenBranch("A")
+	assert.Equal(t, []TreeNodeID{"A"}, loadRequests)
+
+	var visible []TreeNodeID
+	tree.walkAll(func(uid, _ TreeNodeID, _ bool, _ int) {
+		visible = append(visible, uid)
+	})
+	assert.Equal(t, []TreeNodeID{"", "A", treeLoadingNodeID("A"), "B", "C"}, visible)
+
+	r := cache.Renderer(tree.scroller.Content.(*treeContent)).(*treeContentRenderer)
+	r.Layout(tree.scroller.Content.Size())
+	row, ok := r.loadingRows[treeLoadingNodeID("A")]
+	assert.True(t, ok, "an activity row should be shown below the loading branch")
+	assert.IsType(t, &Activity{}, row.Content())
+
+	// opening again must not request another load while one is running
+	tree.CloseBranch("A")
+	tree.OpenBranch("A")
+	assert.Len(t, loadRequests, 1)
+
+	data["A"] = []string{"D"}
+	loaded["A"] = true
+	finish()
+
+	visible = nil
+	tree.walkAll(func(uid, _ TreeNodeID, _ bool, _ int) {
+		visible = append(visible, uid)
+	})
+	assert.Equal(t, []TreeNodeID{"", "A", "D", "B", "C"}, visible)
+	r.Layout(tree.scroller.Content.Size())
+	assert.Empty(t, r.loadingRows)
+}
+
+func TestTree_Filter(t *testing.T) {
+	data := make(map[string][]string)
+	addTreePath(data, "A", "B")
+	addTreePath(data, "D", "E", "F")
+	addTreePath(data, "G")
+	tree := NewTreeWithStrings(data)
+	w := test.NewTempWindow(t, tree)
+	w.Resize(fyne.NewSize(200, 300))
+
+	tree.Filter(func(uid TreeNodeID) bool { return uid == "F" })
+
+	var visible []TreeNodeID
+	tree.walkAll(func(uid, _ TreeNodeID, _ bool, _ int) {
+		visible = append(visible, uid)
+	})
+	assert.Equal(t, []TreeNodeID{"", "D", "E", "F"}, visible)
+	assert.True(t, tree.IsBranchOpen("D"), "ancestors of a match should be expanded")
+	assert.True(t, tree.IsBranchOpen("E"))
+
+	// a matching branch is kept without revealing its children
+	tree.Filter(func(uid TreeNodeID) bool { return uid == "A" })
+	visible = nil
+	tree.walkAll(func(uid, _ TreeNodeID, _ bool, _ int) {
+		visible = append(visible, uid)
+	})
+	assert.Equal(t, []TreeNodeID{"", "A"}, visible)
+
+	tree.Filter(nil)
+	visible = nil
+	tree.walkAll(func(uid, _ TreeNodeID, _ bool, _ int) {
+		visible = append(visible, uid)
+	})
+	assert.Equal(t, []TreeNodeID{"", "A", "D", "E", "F", "G"}, visible,
+		"clearing the filter shows all nodes, branch A was never opened")
+}
+
+func TestTree_ScrollToAndSelect(t *testing.T) {
+	data := make(map[string][]string)
+	for i := 0; i < 20; i++ {
+		addTreePath(data, fmt.Sprintf("%02d", i))
+	}
+	addTreePath(data, "B", "C", "D")
+	tree := NewTreeWithStrings(data)
+	w := test.NewTempWindow(t, tree)
+	w.Resize(fyne.NewSize(200, 120))
+
+	var selected []TreeNodeID
+	tree.OnSelected = func(uid TreeNodeID) {
+		selected = append(selected, uid)
+	}
+
+	tree.ScrollToAndSelect("D")
+	assert.True(t, tree.IsBranchOpen("B"), "ancestors should be opened to reveal the node")
+	assert.True(t, tree.IsBranchOpen("C"))
+	assert.Equal(t, []TreeNodeID{"D"}, selected)
+	assert.Equal(t, []TreeNodeID{"D"}, tree.selected)
+
+	y, size, ok := tree.offsetAndSize("D")
+	assert.True(t, ok)
+	assert.Equal(t, y+size.Height-tree.scroller.Size().Height, tree.scroller.Offset.Y)
+}
@@ -0,0 +1,50 @@
+package widget
+
+import "strings"
+
+// treeLoadingNodeSuffix marks the placeholder row shown below a branch whose
+// children are still being loaded.
+const treeLoadingNodeSuffix = "._LOADING"
+
+func treeLoadingNodeID(uid TreeNodeID) TreeNodeID {
+	return uid + treeLoadingNodeSuffix
+}
+
+func isTreeLoadingNode(uid TreeNodeID) bool {
+	return strings.HasSuffix(uid, treeLoadingNodeSuffix)
+}
+
+// isBranchLoaded returns whether the children of the given branch are
+// available, branches are always loaded when no IsBranchLoaded is set.
+func (t *Tree) isBranchLoaded(uid TreeNodeID) bool {
+	if f := t.IsBranchLoaded; f != nil {
+		return f(uid)
+	}
+	return true
+}
+
+// loadBranchIfNeeded asks LoadBranch to fetch the children of an opened
+// branch that is not yet loaded, showing a placeholder row until the load is
+// reported complete.
+func (t *Tree) loadBranchIfNeeded(uid TreeNodeID) {
+	load := t.LoadBranch
+	if load == nil || t.isBranchLoaded(uid) || t.loading[uid] {
+		return
+	}
+
+	if t.loading == nil {
+		t.loading = make(map[TreeNodeID]bool)
+	}
+	t.loading[uid] = true
+	load(uid, func() {
+		delete(t.loading, uid)
+		t.Refresh()
+	})
+}
+
+// newLoadingLeaf returns the placeholder row shown while a branch is loading.
+func newLoadingLeaf(t *Tree) *leaf {
+	activity := NewActivity()
+	activity.Start()
+	return newLeaf(t, activity)
+}
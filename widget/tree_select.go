@@ -0,0 +1,141 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// SelectedBranches returns the branch nodes in the current selection.
+//
+// Since: 2.6
+func (t *Tree) SelectedBranches() []TreeNodeID {
+	var branches []TreeNodeID
+	for _, uid := range t.selected {
+		if f := t.IsBranch; f != nil && f(uid) {
+			branches = append(branches, uid)
+		}
+	}
+	return branches
+}
+
+// SelectedLeaves returns the leaf nodes in the current selection.
+//
+// Since: 2.6
+func (t *Tree) SelectedLeaves() []TreeNodeID {
+	var leaves []TreeNodeID
+	for _, uid := range t.selected {
+		if f := t.IsBranch; f == nil || !f(uid) {
+			leaves = append(leaves, uid)
+		}
+	}
+	return leaves
+}
+
+// selectTapped applies a tap on the given node to the selection, consuming
+// any modifier recorded by a preceding desktop mouse event.
+func (t *Tree) selectTapped(uid TreeNodeID) {
+	mod := t.pendingSelectionMod
+	t.pendingSelectionMod = 0
+	t.selectWithModifier(uid, mod)
+}
+
+// selectWithModifier selects the given node, extending or toggling the
+// current selection in SelectionMultiple mode depending on the modifier held.
+func (t *Tree) selectWithModifier(uid TreeNodeID, mod fyne.KeyModifier) {
+	if t.SelectionMode != SelectionMultiple {
+		t.Select(uid)
+		return
+	}
+
+	switch {
+	case mod&fyne.KeyModifierShift != 0 && t.selectionAnchor != "":
+		t.selectRange(t.selectionAnchor, uid)
+	case mod&(fyne.KeyModifierControl|fyne.KeyModifierSuper) != 0:
+		t.selectionAnchor = uid
+		t.toggleSelected(uid)
+	default:
+		t.selectionAnchor = uid
+		t.replaceSelection([]TreeNodeID{uid})
+		t.ScrollTo(uid)
+	}
+}
+
+// selectRange selects every open node between the two given nodes inclusive,
+// in the order they are displayed.
+func (t *Tree) selectRange(from, to TreeNodeID) {
+	start, end := -1, -1
+	var order []TreeNodeID
+	t.walkAll(func(uid, _ TreeNodeID, _ bool, _ int) {
+		if uid == from {
+			start = len(order)
+		}
+		if uid == to {
+			end = len(order)
+		}
+		order = append(order, uid)
+	})
+	if start < 0 || end < 0 {
+		t.replaceSelection([]TreeNodeID{to})
+		return
+	}
+	if start > end {
+		start, end = end, start
+	}
+
+	t.replaceSelection(order[start : end+1])
+}
+
+// toggleSelected flips membership of the selection for the given node.
+func (t *Tree) toggleSelected(uid TreeNodeID) {
+	for i, s := range t.selected {
+		if s == uid {
+			t.selected = append(t.selected[:i:i], t.selected[i+1:]...)
+			t.Refresh()
+			if f := t.OnUnselected; f != nil {
+				f(uid)
+			}
+			return
+		}
+	}
+
+	t.selected = append(t.selected, uid)
+	t.Refresh()
+	if f := t.OnSelected; f != nil {
+		f(uid)
+	}
+}
+
+// replaceSelection swaps the current selection for the given nodes, firing
+// the selection callbacks only for nodes that changed state.
+func (t *Tree) replaceSelection(sel []TreeNodeID) {
+	old := t.selected
+	t.selected = sel
+	t.Refresh()
+
+	if f := t.OnUnselected; f != nil {
+		for _, uid := range old {
+			if !containsTreeNodeID(sel, uid) {
+				f(uid)
+			}
+		}
+	}
+	if f := t.OnSelected; f != nil {
+		for _, uid := range sel {
+			if !containsTreeNodeID(old, uid) {
+				f(uid)
+			}
+		}
+	}
+}
+
+func (t *Tree) isNodeSelected(uid TreeNodeID) bool {
+	return containsTreeNodeID(t.selected, uid)
+}
+
+func containsTreeNodeID(ids []TreeNodeID, uid TreeNodeID) bool {
+	for _, id := range ids {
+		if id == uid {
+			return true
+		}
+	}
+	return false
+}
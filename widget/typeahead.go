@@ -0,0 +1,37 @@
+package widget
+
+import (
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// typeAheadTimeout is how long after the last keystroke a type-ahead search
+// buffer is kept before a new search starts.
+const typeAheadTimeout = time.Second
+
+// typeAhead accumulates the runes typed on a collection widget so keyboard
+// users can jump to an entry by starting to type its text.
+type typeAhead struct {
+	buffer  string
+	lastKey time.Time
+}
+
+// add appends the given rune to the search buffer, starting over when typing
+// paused, and returns the lower-cased prefix to search for.
+func (a *typeAhead) add(r rune) string {
+	now := time.Now()
+	if now.Sub(a.lastKey) > typeAheadTimeout {
+		a.buffer = ""
+	}
+	a.lastKey = now
+	a.buffer += strings.ToLower(string(r))
+	return a.buffer
+}
+
+// typeAheadMatches reports whether the rendered text of the given cell
+// template starts with the search prefix.
+func typeAheadMatches(template fyne.CanvasObject, prefix string) bool {
+	return strings.HasPrefix(strings.ToLower(exportObjectText(template)), prefix)
+}
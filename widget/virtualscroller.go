@@ -0,0 +1,341 @@
+package widget
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/internal/cache"
+	"fyne.io/fyne/v2/internal/widget"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Declare conformity with interfaces.
+var _ fyne.Widget = (*VirtualScroller)(nil)
+
+// VirtualScroller is a base widget for building custom virtualized views such as
+// timelines, calendars and log viewers. It uses the same recycling approach as List -
+// only the items within the viewport exist as canvas objects, and items scrolled out
+// of view are returned to an ObjectPool for reuse. Unlike List it makes no assumption
+// about item geometry; per-item heights can be supplied with the ItemHeight callback.
+//
+// Since: 2.6
+type VirtualScroller struct {
+	BaseWidget
+
+	// Length returns the number of items in the view.
+	Length func() int `json:"-"`
+	// CreateItem returns a new template object, used to populate the item pool.
+	CreateItem func() fyne.CanvasObject `json:"-"`
+	// UpdateItem is called to update an item to display the content at the given index.
+	UpdateItem func(id int, item fyne.CanvasObject) `json:"-"`
+	// ItemHeight returns the height of the specified item, or can be left nil if all
+	// items take the minimum height of the CreateItem template.
+	ItemHeight func(id int) float32 `json:"-"`
+
+	// HideSeparators hides the separators between items.
+	HideSeparators bool
+
+	scroller   *widget.Scroll
+	content    *fyne.Container
+	pool       *ObjectPool
+	itemMin    fyne.Size
+	offsetY    float32
+	visible    map[int]fyne.CanvasObject
+	separators []fyne.CanvasObject
+}
+
+// NewVirtualScroller creates a virtualized vertical scroller where item content is
+// provided by the given callbacks.
+//
+// Since: 2.6
+func NewVirtualScroller(length func() int, createItem func() fyne.CanvasObject, updateItem func(int, fyne.CanvasObject)) *VirtualScroller {
+	v := &VirtualScroller{Length: length, CreateItem: createItem, UpdateItem: updateItem}
+	v.ExtendBaseWidget(v)
+	return v
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (v *VirtualScroller) CreateRenderer() fyne.WidgetRenderer {
+	v.ExtendBaseWidget(v)
+
+	if f := v.CreateItem; f != nil && v.itemMin.IsZero() {
+		v.itemMin = createItemAndApplyThemeScope(f, v).MinSize()
+	}
+	if v.pool == nil {
+		v.pool = NewObjectPool(v.CreateItem)
+		v.pool.SetThemeScope(v)
+	}
+	v.visible = make(map[int]fyne.CanvasObject)
+
+	layout := &virtualScrollLayout{v: v}
+	v.content = &fyne.Container{Layout: layout}
+	v.scroller = widget.NewVScroll(v.content)
+	v.scroller.OnScrolled = layout.offsetUpdated
+	v.content.Resize(v.content.MinSize())
+
+	return &virtualScrollerRenderer{widget.NewBaseRenderer([]fyne.CanvasObject{v.scroller}), v}
+}
+
+// MinSize returns the size that this widget should not shrink below.
+func (v *VirtualScroller) MinSize() fyne.Size {
+	v.ExtendBaseWidget(v)
+	return v.BaseWidget.MinSize()
+}
+
+// Resize is called when this scroller should change size. We refresh to ensure items are drawn.
+func (v *VirtualScroller) Resize(s fyne.Size) {
+	v.BaseWidget.Resize(s)
+	if v.scroller == nil {
+		return
+	}
+
+	v.content.Layout.(*virtualScrollLayout).updateVisible(true)
+}
+
+// RefreshItem refreshes a single item, specified by the index passed in.
+func (v *VirtualScroller) RefreshItem(id int) {
+	if v.scroller == nil {
+		return
+	}
+
+	item, ok := v.visible[id]
+	if !ok {
+		return
+	}
+	if f := v.UpdateItem; f != nil {
+		f(id, item)
+	}
+}
+
+// GetScrollOffset returns the current scroll offset position.
+func (v *VirtualScroller) GetScrollOffset() float32 {
+	return v.offsetY
+}
+
+// ScrollToOffset scrolls the view to the given offset position.
+func (v *VirtualScroller) ScrollToOffset(offset float32) {
+	if v.scroller == nil {
+		return
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	contentHeight := v.contentMinSize().Height
+	if v.Size().Height >= contentHeight {
+		return // content fully visible - no need to scroll
+	}
+	if offset > contentHeight {
+		offset = contentHeight
+	}
+
+	v.scroller.Offset.Y = offset
+	v.content.Layout.(*virtualScrollLayout).offsetUpdated(v.scroller.Offset)
+	v.Refresh()
+}
+
+// itemHeight returns the height of the item at the given index.
+func (v *VirtualScroller) itemHeight(id int) float32 {
+	if f := v.ItemHeight; f != nil {
+		return f(id)
+	}
+	return v.itemMin.Height
+}
+
+func (v *VirtualScroller) contentMinSize() fyne.Size {
+	if v.Length == nil {
+		return fyne.NewSize(0, 0)
+	}
+	items := v.Length()
+	separatorThickness := v.Theme().Size(theme.SizeNamePadding)
+
+	if v.ItemHeight == nil {
+		return fyne.NewSize(v.itemMin.Width,
+			(v.itemMin.Height+separatorThickness)*float32(items)-separatorThickness)
+	}
+
+	height := float32(0)
+	for id := 0; id < items; id++ {
+		height += v.itemHeight(id)
+	}
+	return fyne.NewSize(v.itemMin.Width, height+separatorThickness*float32(items-1))
+}
+
+// Declare conformity with WidgetRenderer interface.
+var _ fyne.WidgetRenderer = (*virtualScrollerRenderer)(nil)
+
+type virtualScrollerRenderer struct {
+	widget.BaseRenderer
+
+	v *VirtualScroller
+}
+
+func (r *virtualScrollerRenderer) Layout(size fyne.Size) {
+	r.v.scroller.Resize(size)
+}
+
+func (r *virtualScrollerRenderer) MinSize() fyne.Size {
+	return r.v.scroller.MinSize().Max(r.v.itemMin)
+}
+
+func (r *virtualScrollerRenderer) Refresh() {
+	if f := r.v.CreateItem; f != nil {
+		r.v.itemMin = createItemAndApplyThemeScope(f, r.v).MinSize()
+	}
+	r.Layout(r.v.Size())
+	r.v.scroller.Refresh()
+	r.v.content.Layout.(*virtualScrollLayout).updateVisible(false)
+	canvas.Refresh(r.v.super())
+}
+
+// Declare conformity with Layout interface.
+var _ fyne.Layout = (*virtualScrollLayout)(nil)
+
+// virtualScrollLayout performs the visible-window calculation for VirtualScroller,
+// recycling items through the pool as the viewport moves.
+type virtualScrollLayout struct {
+	v *VirtualScroller
+}
+
+func (l *virtualScrollLayout) Layout([]fyne.CanvasObject, fyne.Size) {
+	l.updateVisible(true)
+}
+
+func (l *virtualScrollLayout) MinSize([]fyne.CanvasObject) fyne.Size {
+	return l.v.contentMinSize()
+}
+
+func (l *virtualScrollLayout) offsetUpdated(pos fyne.Position) {
+	if l.v.offsetY == pos.Y {
+		return
+	}
+	l.v.offsetY = pos.Y
+	l.updateVisible(true)
+}
+
+// visibleWindow returns the index range and top offset of the items within the viewport.
+func (l *virtualScrollLayout) visibleWindow(length int) (minRow, maxRow int, offY float32) {
+	viewHeight := l.v.scroller.Size().Height
+	if viewHeight <= 0 || length == 0 {
+		return 0, -1, 0
+	}
+	padding := l.v.Theme().Size(theme.SizeNamePadding)
+
+	if l.v.ItemHeight == nil {
+		paddedItemHeight := l.v.itemMin.Height + padding
+
+		offY = float32(math.Floor(float64(l.v.offsetY/paddedItemHeight))) * paddedItemHeight
+		minRow = int(math.Floor(float64(offY / paddedItemHeight)))
+		maxRow = int(math.Ceil(float64((offY + viewHeight) / paddedItemHeight)))
+
+		if minRow > length-1 {
+			minRow = length - 1
+		}
+		if minRow < 0 {
+			minRow = 0
+			offY = 0
+		}
+		if maxRow > length-1 {
+			maxRow = length - 1
+		}
+		return
+	}
+
+	rowOffset := float32(0)
+	maxRow = length - 1
+	for i := 0; i < length; i++ {
+		height := l.v.itemHeight(i)
+
+		if rowOffset+height+padding <= l.v.offsetY {
+			minRow = i + 1
+			offY = rowOffset + height + padding
+		} else if rowOffset >= l.v.offsetY+viewHeight {
+			maxRow = i - 1
+			break
+		}
+
+		rowOffset += height + padding
+	}
+	return
+}
+
+func (l *virtualScrollLayout) updateVisible(newOnly bool) {
+	length := 0
+	if f := l.v.Length; f != nil {
+		length = f()
+	}
+	if l.v.UpdateItem == nil {
+		fyne.LogError("Missing UpdateItem callback required for VirtualScroller", nil)
+	}
+
+	minRow, maxRow, offY := l.visibleWindow(length)
+	padding := l.v.Theme().Size(theme.SizeNamePadding)
+	width := l.v.Size().Width
+
+	for id, item := range l.v.visible {
+		if id < minRow || id > maxRow {
+			l.v.pool.Release(item)
+			delete(l.v.visible, id)
+		}
+	}
+
+	children := make([]fyne.CanvasObject, 0, maxRow-minRow+1)
+	y := offY
+	for id := minRow; id <= maxRow; id++ {
+		height := l.v.itemHeight(id)
+
+		item, ok := l.v.visible[id]
+		if !ok {
+			item = l.v.pool.Obtain()
+			if item == nil {
+				continue
+			}
+			l.v.visible[id] = item
+		}
+		item.Move(fyne.NewPos(0, y))
+		item.Resize(fyne.NewSize(width, height))
+		children = append(children, item)
+
+		if !ok || !newOnly {
+			if f := l.v.UpdateItem; f != nil {
+				f(id, item)
+			}
+		}
+		y += height + padding
+	}
+
+	l.updateSeparators(children)
+	l.v.content.Objects = append(children, l.v.separators...)
+}
+
+func (l *virtualScrollLayout) updateSeparators(children []fyne.CanvasObject) {
+	if l.v.HideSeparators || len(children) <= 1 {
+		l.v.separators = nil
+		return
+	}
+
+	if lenSep := len(l.v.separators); lenSep > len(children) {
+		l.v.separators = l.v.separators[:len(children)]
+	} else {
+		for i := lenSep; i < len(children); i++ {
+			sep := NewSeparator()
+			if cache.OverrideThemeMatchingScope(sep, l.v) {
+				sep.Refresh()
+			}
+
+			l.v.separators = append(l.v.separators, sep)
+		}
+	}
+
+	th := l.v.Theme()
+	separatorThickness := th.Size(theme.SizeNameSeparatorThickness)
+	dividerOff := (th.Size(theme.SizeNamePadding) + separatorThickness) / 2
+	for i, child := range children {
+		if i == 0 {
+			continue
+		}
+		l.v.separators[i].Move(fyne.NewPos(0, child.Position().Y-dividerOff))
+		l.v.separators[i].Resize(fyne.NewSize(l.v.Size().Width, separatorThickness))
+		l.v.separators[i].Show()
+	}
+}
@@ -0,0 +1,70 @@
+package widget
+
+import (
+	"fmt"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVirtualScroller(t *testing.T) {
+	v := NewVirtualScroller(
+		func() int { return 1000 },
+		func() fyne.CanvasObject { return NewLabel("Template") },
+		func(id int, item fyne.CanvasObject) {
+			item.(*Label).SetText(fmt.Sprintf("Item %d", id))
+		})
+
+	w := test.NewTempWindow(t, v)
+	w.Resize(fyne.NewSize(200, 200))
+
+	visible := len(v.visible)
+	assert.Greater(t, visible, 0)
+	assert.Less(t, visible, 100) // only the viewport is populated
+
+	item, ok := v.visible[0]
+	assert.True(t, ok)
+	assert.Equal(t, "Item 0", item.(*Label).Text)
+}
+
+func TestVirtualScroller_Scrolled(t *testing.T) {
+	v := NewVirtualScroller(
+		func() int { return 1000 },
+		func() fyne.CanvasObject { return NewLabel("Template") },
+		func(id int, item fyne.CanvasObject) {
+			item.(*Label).SetText(fmt.Sprintf("Item %d", id))
+		})
+
+	w := test.NewTempWindow(t, v)
+	w.Resize(fyne.NewSize(200, 200))
+
+	v.ScrollToOffset(v.contentMinSize().Height / 2)
+	_, topVisible := v.visible[0]
+	assert.False(t, topVisible)
+	assert.Greater(t, v.GetScrollOffset(), float32(0))
+
+	for id, item := range v.visible {
+		assert.Equal(t, fmt.Sprintf("Item %d", id), item.(*Label).Text)
+	}
+}
+
+func TestVirtualScroller_ItemHeight(t *testing.T) {
+	v := NewVirtualScroller(
+		func() int { return 10 },
+		func() fyne.CanvasObject { return NewLabel("Template") },
+		func(id int, item fyne.CanvasObject) {
+			item.(*Label).SetText(fmt.Sprintf("Item %d", id))
+		})
+	v.ItemHeight = func(id int) float32 {
+		return float32(20 + id*10)
+	}
+
+	w := test.NewTempWindow(t, v)
+	w.Resize(fyne.NewSize(200, 100))
+
+	assert.Equal(t, float32(20), v.visible[0].Size().Height)
+	assert.Equal(t, float32(30), v.visible[1].Size().Height)
+}
@@ -19,6 +19,17 @@ type BaseWidget struct {
 	position async.Position
 	Hidden   bool
 
+	// OnShown is called after this widget becomes visible through Show, so work such
+	// as timers, animations or polling can be resumed when actually needed.
+	//
+	// Since: 2.6
+	OnShown func() `json:"-"`
+	// OnHidden is called after this widget is hidden through Hide, allowing any
+	// background work to be paused while nothing is displayed.
+	//
+	// Since: 2.6
+	OnHidden func() `json:"-"`
+
 	impl         atomic.Pointer[fyne.Widget]
 	propertyLock sync.RWMutex
 	themeCache   fyne.Theme
@@ -98,6 +109,10 @@ func (w *BaseWidget) Show() {
 	w.Hidden = false
 	w.propertyLock.Unlock()
 
+	if f := w.OnShown; f != nil {
+		f()
+	}
+
 	impl := w.super()
 	if impl == nil {
 		return
@@ -115,6 +130,10 @@ func (w *BaseWidget) Hide() {
 	w.Hidden = true
 	w.propertyLock.Unlock()
 
+	if f := w.OnHidden; f != nil {
+		f()
+	}
+
 	impl := w.super()
 	if impl == nil {
 		return
@@ -133,10 +152,26 @@ func (w *BaseWidget) Refresh() {
 	w.themeCache = nil
 	w.propertyLock.Unlock()
 
+	if f, ok := impl.(ThemeInvalidator); ok {
+		f.ThemeInvalidated()
+	}
+
 	render := cache.Renderer(impl)
 	render.Refresh()
 }
 
+// ThemeInvalidator is implemented by widgets that cache measurements derived
+// from theme sizes or the rendering scale. ThemeInvalidated is called when the
+// widget is refreshed, after the cached theme has been dropped and before the
+// renderer runs, so stale measurements can be invalidated in time for the next
+// layout. Collection widgets use this to re-measure their rows when the theme
+// or scale changes.
+//
+// Since: 2.6
+type ThemeInvalidator interface {
+	ThemeInvalidated()
+}
+
 // Theme returns a cached Theme instance for this widget (or its extending widget).
 // This will be the app theme in most cases, or a widget specific theme if it is inside a ThemeOverride container.
 //
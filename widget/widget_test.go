@@ -90,3 +90,41 @@ func (t *testWidget) CreateRenderer() fyne.WidgetRenderer {
 func waitForBinding() {
 	time.Sleep(time.Millisecond * 100) // data resolves on background thread
 }
+
+type invalidatingWidget struct {
+	BaseWidget
+	invalidated int
+}
+
+func (i *invalidatingWidget) CreateRenderer() fyne.WidgetRenderer {
+	return NewSimpleRenderer(canvas.NewRectangle(color.Black))
+}
+
+func (i *invalidatingWidget) ThemeInvalidated() {
+	i.invalidated++
+}
+
+func TestBaseWidget_ThemeInvalidated(t *testing.T) {
+	w := &invalidatingWidget{}
+	w.ExtendBaseWidget(w)
+
+	w.Refresh()
+	assert.Equal(t, 1, w.invalidated)
+}
+
+func TestBaseWidget_OnShownHidden(t *testing.T) {
+	shown, hidden := 0, 0
+	label := NewLabel("Hello")
+	label.OnShown = func() { shown++ }
+	label.OnHidden = func() { hidden++ }
+
+	label.Hide()
+	assert.Equal(t, 1, hidden)
+	label.Hide() // already hidden, no event
+	assert.Equal(t, 1, hidden)
+
+	label.Show()
+	assert.Equal(t, 1, shown)
+	label.Show()
+	assert.Equal(t, 1, shown)
+}
@@ -1,5 +1,28 @@
 package fyne
 
+// CloseDecision is returned by a close guard set with [Window.SetCloseGuard]
+// to state how a close request should proceed.
+//
+// Since: 2.6
+type CloseDecision int
+
+const (
+	// CloseAllow lets the close request proceed and the window close.
+	//
+	// Since: 2.6
+	CloseAllow CloseDecision = iota
+	// CloseCancel rejects the close request, keeping the window open.
+	//
+	// Since: 2.6
+	CloseCancel
+	// CloseDefer keeps the window open while the guard finishes asynchronously,
+	// for example after showing a save dialog. The guard is responsible for
+	// calling Close() once the user has decided.
+	//
+	// Since: 2.6
+	CloseDefer
+)
+
 // Window describes a user interface window. Depending on the platform an app
 // may have many windows or just the one.
 type Window interface {
@@ -70,6 +93,15 @@ type Window interface {
 	// Since: 1.4
 	SetCloseIntercept(func())
 
+	// SetCloseGuard sets a function consulted when the user or system requests
+	// that this window close, including an app quit where the platform allows it.
+	// The returned CloseDecision can allow the close, cancel it, or defer it so
+	// an asynchronous flow such as a save dialog can decide later.
+	// If a guard is set it takes precedence over any close interceptor.
+	//
+	// Since: 2.6
+	SetCloseGuard(func() CloseDecision)
+
 	// SetOnDropped allows setting a window-wide callback to receive dropped items.
 	// The callback function is called with the absolute position of the drop and a
 	// slice of all of the dropped URIs.